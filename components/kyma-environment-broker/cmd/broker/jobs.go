@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/cloudprovider"
+	subscriptioncleanup "github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/job"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/cis"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/cleanup"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/deprovisionretrigger"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/environmentscleanup"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/trialcleanup"
+)
+
+const (
+	trialCleanupJob         = "trial-cleanup"
+	deprovisionRetriggerJob = "deprovision-retrigger"
+	environmentsCleanupJob  = "environments-cleanup"
+	subaccountCleanupJob    = "subaccount-cleanup"
+	subscriptionCleanupJob  = "subscription-cleanup"
+)
+
+// JobsConfig configures the periodic jobs run by KEB in place of the separate cron jobs
+type JobsConfig struct {
+	Enabled         bool          `envconfig:"default=false"`
+	PollingInterval time.Duration `envconfig:"default=30s"`
+	LeaderElection  jobs.LeaderElectionConfig
+
+	// Broker is the OSB API client used by the jobs to expire and deprovision instances
+	Broker broker.ClientConfig `envconfig:"optional"`
+
+	TrialCleanup struct {
+		Enabled          bool          `envconfig:"default=true"`
+		Schedule         string        `envconfig:"default=*/15 * * * *"`
+		DryRun           bool          `envconfig:"default=true"`
+		ExpirationPeriod time.Duration `envconfig:"default=336h"`
+		MaxAffected      int           `envconfig:"default=0"`
+	}
+	DeprovisionRetrigger struct {
+		Enabled  bool   `envconfig:"default=true"`
+		Schedule string `envconfig:"default=0 2 * * *"`
+		DryRun   bool   `envconfig:"default=true"`
+	}
+	EnvironmentsCleanup struct {
+		Enabled       bool          `envconfig:"default=true"`
+		Schedule      string        `envconfig:"default=0 0 * * *"`
+		MaxAge        time.Duration `envconfig:"default=24h"`
+		LabelSelector string        `envconfig:"default=owner.do-not-delete!=true"`
+		DryRun        bool          `envconfig:"default=false"`
+		MaxAffected   int           `envconfig:"default=0"`
+	}
+	SubaccountCleanup struct {
+		Enabled       bool       `envconfig:"default=false"`
+		Schedule      string     `envconfig:"default=0 1 * * *"`
+		ClientVersion string     `envconfig:"default=v2.0"`
+		CIS           cis.Config `envconfig:"optional"`
+	}
+	SubscriptionCleanup struct {
+		Enabled     bool   `envconfig:"default=true"`
+		Schedule    string `envconfig:"default=0 2 * * *"`
+		DryRun      bool   `envconfig:"default=false"`
+		MaxAffected int    `envconfig:"default=0"`
+		MaxAttempts int    `envconfig:"default=3"`
+		KeystoneURL string `envconfig:"optional"`
+	}
+}
+
+// newJobScheduler registers the enabled jobs, the scheduler must be started with Run
+func newJobScheduler(ctx context.Context, cfg JobsConfig, db storage.BrokerStorage, k8sCfg, gardenerClusterConfig *rest.Config,
+	gardenerClient dynamic.Interface, gardenerNamespace string, provisionerClient provisioner.Client, logs *logrus.Logger) (*jobs.Scheduler, error) {
+	k8sClient, err := kubernetes.NewForConfig(k8sCfg)
+	if err != nil {
+		return nil, fmt.Errorf("while creating kubernetes client: %w", err)
+	}
+	identity, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("while getting hostname: %w", err)
+	}
+	leader, err := jobs.NewLeader(cfg.LeaderElection, k8sClient, identity, logs.WithField("service", "jobsLeaderElection"))
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler := jobs.NewScheduler(db.JobRuns(), leader, cfg.PollingInterval, logs.WithField("service", "jobs"))
+	brokerClient := broker.NewClient(ctx, cfg.Broker)
+	shootClient := gardenerClient.Resource(gardener.ShootResource).Namespace(gardenerNamespace)
+
+	var registered []jobs.Job
+	if cfg.TrialCleanup.Enabled {
+		registered = append(registered, jobs.Job{
+			Name:     trialCleanupJob,
+			Schedule: cfg.TrialCleanup.Schedule,
+			Run: func(ctx context.Context, log logrus.FieldLogger) ([]string, error) {
+				svcCfg := trialcleanup.Config{
+					DryRun:           cfg.TrialCleanup.DryRun,
+					ExpirationPeriod: cfg.TrialCleanup.ExpirationPeriod,
+					MaxAffected:      cfg.TrialCleanup.MaxAffected,
+				}
+				report, err := trialcleanup.NewService(svcCfg, brokerClient, db.Instances(), log).PerformCleanup()
+				return logReport(log, report, err)
+			},
+		})
+	}
+	if cfg.DeprovisionRetrigger.Enabled {
+		registered = append(registered, jobs.Job{
+			Name:     deprovisionRetriggerJob,
+			Schedule: cfg.DeprovisionRetrigger.Schedule,
+			Run: func(ctx context.Context, log logrus.FieldLogger) ([]string, error) {
+				svcCfg := deprovisionretrigger.Config{DryRun: cfg.DeprovisionRetrigger.DryRun}
+				return deprovisionretrigger.NewService(svcCfg, brokerClient, db.Instances(), log).PerformCleanup()
+			},
+		})
+	}
+	if cfg.EnvironmentsCleanup.Enabled {
+		registered = append(registered, jobs.Job{
+			Name:     environmentsCleanupJob,
+			Schedule: cfg.EnvironmentsCleanup.Schedule,
+			Run: func(ctx context.Context, log logrus.FieldLogger) ([]string, error) {
+				svc := environmentscleanup.NewService(shootClient, brokerClient, provisionerClient, db.Instances(), logs,
+					cfg.EnvironmentsCleanup.MaxAge, cfg.EnvironmentsCleanup.LabelSelector)
+				svc.DryRun = cfg.EnvironmentsCleanup.DryRun
+				svc.MaxAffected = cfg.EnvironmentsCleanup.MaxAffected
+				report, err := svc.PerformCleanup()
+				return logReport(log, report, err)
+			},
+		})
+	}
+	if cfg.SubaccountCleanup.Enabled {
+		accountBrokerClient := broker.NewClient(ctx, cfg.Broker)
+		accountBrokerClient.UserAgent = broker.AccountCleanupJob
+		registered = append(registered, jobs.Job{
+			Name:     subaccountCleanupJob,
+			Schedule: cfg.SubaccountCleanup.Schedule,
+			Run: func(ctx context.Context, log logrus.FieldLogger) ([]string, error) {
+				var client cis.CisClient
+				switch cfg.SubaccountCleanup.ClientVersion {
+				case "v1.0":
+					client = cis.NewClientVer1(ctx, cfg.SubaccountCleanup.CIS, log)
+				case "v2.0":
+					client = cis.NewClient(ctx, cfg.SubaccountCleanup.CIS, log)
+				default:
+					return nil, fmt.Errorf("client version %s is not supported", cfg.SubaccountCleanup.ClientVersion)
+				}
+				return cis.NewSubAccountCleanupService(client, accountBrokerClient, db.Instances(), log).PerformCleanup()
+			},
+		})
+	}
+	if cfg.SubscriptionCleanup.Enabled {
+		gardenerK8sClient, err := kubernetes.NewForConfig(gardenerClusterConfig)
+		if err != nil {
+			return nil, fmt.Errorf("while creating Gardener kubernetes client: %w", err)
+		}
+		secretBindingClient := gardenerClient.Resource(gardener.SecretBindingResource).Namespace(gardenerNamespace)
+		registered = append(registered, jobs.Job{
+			Name:     subscriptionCleanupJob,
+			Schedule: cfg.SubscriptionCleanup.Schedule,
+			Run: func(ctx context.Context, log logrus.FieldLogger) ([]string, error) {
+				cleanerCfg := subscriptioncleanup.Config{
+					DryRun:      cfg.SubscriptionCleanup.DryRun,
+					MaxAffected: cfg.SubscriptionCleanup.MaxAffected,
+					MaxAttempts: cfg.SubscriptionCleanup.MaxAttempts,
+				}
+				report, err := subscriptioncleanup.NewCleaner(ctx, gardenerK8sClient, secretBindingClient, shootClient, cloudprovider.NewProviderFactory(cfg.SubscriptionCleanup.KeystoneURL), cleanerCfg).Release()
+				return logReport(log, report, err)
+			},
+		})
+	}
+
+	for _, job := range registered {
+		if err := scheduler.Register(job); err != nil {
+			return nil, err
+		}
+	}
+	return scheduler, nil
+}
+
+// logReport logs the cleanup report of the run and returns the resources changed by it
+func logReport(log logrus.FieldLogger, report *cleanup.Report, err error) ([]string, error) {
+	if report == nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if writeErr := report.Write(&buf, cleanup.FormatJSON); writeErr != nil {
+		log.Errorf("while writing cleanup report: %v", writeErr)
+	} else {
+		log.Infof("Cleanup report: %s", buf.String())
+	}
+	return report.Affected(), err
+}
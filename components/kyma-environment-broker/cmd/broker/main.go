@@ -25,7 +25,10 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
 	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/accountpool"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/appinfo"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/auth"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/avs"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	kebConfig "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
@@ -37,6 +40,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/health"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ias"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/jobs"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/kubeconfig"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/metrics"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
@@ -44,6 +48,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
 	orchestrate "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/handlers"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/manager"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/planvisibility"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/deprovisioning"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
@@ -132,6 +137,8 @@ type Config struct {
 
 	Broker          broker.Config
 	CatalogFilePath string
+	// CatalogWatchInterval is the interval of checking the catalog file for changes, 0 disables reloading the catalog
+	CatalogWatchInterval time.Duration `envconfig:"default=30s"`
 
 	Avs avs.Config
 	IAS ias.Config
@@ -168,6 +175,18 @@ type Config struct {
 	Profiler ProfilerConfig
 
 	Events events.Config
+
+	Audit audit.Config
+
+	// Authentication enables the built-in validation of the tokens of the admin endpoints
+	Authentication auth.Config
+
+	Jobs JobsConfig
+
+	PlanVisibility planvisibility.Config
+
+	HyperscalerAccounts       hyperscaler.InventoryConfig
+	SharedHyperscalerAccounts hyperscaler.SharedPoolConfig
 }
 
 type ProfilerConfig struct {
@@ -220,6 +239,8 @@ func main() {
 
 	cfg.OrchestrationConfig.KymaVersion = cfg.KymaVersion
 	cfg.OrchestrationConfig.KubernetesVersion = cfg.Provisioner.KubernetesVersion
+	cfg.Broker.KymaVersion = cfg.KymaVersion
+	cfg.Broker.KubernetesVersion = cfg.Provisioner.KubernetesVersion
 
 	// create logger
 	logger := lager.NewLogger("kyma-env-broker")
@@ -295,8 +316,9 @@ func main() {
 
 	gardenerNamespace := fmt.Sprintf("garden-%v", cfg.Gardener.Project)
 	gardenerAccountPool := hyperscaler.NewAccountPool(dynamicGardener, gardenerNamespace)
-	gardenerSharedPool := hyperscaler.NewSharedGardenerAccountPool(dynamicGardener, gardenerNamespace)
+	gardenerSharedPool := hyperscaler.NewSharedGardenerAccountPool(dynamicGardener, gardenerNamespace, cfg.SharedHyperscalerAccounts)
 	accountProvider := hyperscaler.NewAccountProvider(gardenerAccountPool, gardenerSharedPool)
+	accountInventory := hyperscaler.NewInventory(dynamicGardener, gardenerNamespace, cfg.HyperscalerAccounts)
 
 	regions, err := provider.ReadPlatformRegionMappingFromFile(cfg.TrialRegionMappingFilePath)
 	fatalOnError(err)
@@ -336,6 +358,7 @@ func main() {
 
 	// metrics collectors
 	metrics.RegisterAll(eventBroker, db.Operations(), db.Instances())
+	prometheus.MustRegister(metrics.NewHyperscalerAccountsCollector(accountInventory))
 	metrics.StartOpsMetricService(ctx, db.Operations(), logs)
 	//setup runtime overrides appender
 	runtimeOverrides := runtimeoverrides.NewRuntimeOverrides(ctx, cli)
@@ -361,13 +384,44 @@ func main() {
 		runtimeVerConfigurator, db.RuntimeStates(), componentsProvider, reconcilerClient, cfg, k8sClientProvider, cli, logs)
 
 	/***/
-	servicesConfig, err := broker.NewServicesConfigFromFile(cfg.CatalogFilePath)
-	fatalOnError(err)
+	catalogWatcher := broker.NewCatalogWatcher(cfg.CatalogFilePath, logs)
+	fatalOnError(catalogWatcher.Load())
+	if cfg.CatalogWatchInterval > 0 {
+		go catalogWatcher.Watch(ctx, cfg.CatalogWatchInterval)
+	}
+	servicesConfig := broker.ActiveCatalog().Services
 
 	// create server
 	router := mux.NewRouter()
 
-	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, logger, logs, inputFactory.GetPlanDefaults)
+	// record state-changing calls of the OSB, orchestration and kubeconfig endpoints, the authentication runs first
+	// so that the audit log gets the verified caller, the rejected calls are recorded by the authenticator
+	var auditRecorder *audit.Recorder
+	if cfg.Audit.Enabled {
+		auditRecorder = audit.NewRecorder(db.AuditLog(), logs.WithField("service", "audit"))
+	}
+	if cfg.Authentication.Enabled {
+		authenticator, err := auth.NewAuthenticator(cfg.Authentication, &http.Client{Timeout: cfg.Authentication.KeysFetchTimeout}, logs.WithField("service", "authenticator"))
+		fatalOnError(err)
+		if auditRecorder != nil {
+			authenticator.RecordRejected(auditRecorder.Middleware)
+		}
+		router.Use(authenticator.Middleware)
+	}
+	if auditRecorder != nil {
+		router.Use(auditRecorder.Middleware)
+	}
+
+	runtimeLister := orchestration.NewRuntimeLister(db.Instances(), db.Operations(), runtime.NewConverter(cfg.DefaultRequestRegion), logs)
+	runtimeResolver := orchestrationExt.NewGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, runtimeLister, logs)
+
+	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, db, runtimeOverrides, provisionerClient, eventBroker, inputFactory, nil, time.Minute, runtimeVerConfigurator, runtimeResolver, upgradeEvalManager, &cfg, internalEvalAssistant, reconcilerClient, notificationBuilder, logs, cli, 1)
+	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, db, provisionerClient, eventBroker, inputFactory,
+		nil, time.Minute, runtimeResolver, upgradeEvalManager, notificationBuilder, logs, cli, cfg, 1)
+
+	planVisibility := planvisibility.NewService(cfg.PlanVisibility, db.PlanVisibility(), logs)
+	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, kymaQueue, clusterQueue, logger, logs, inputFactory.GetPlanDefaults, planVisibility)
+	router.Handle("/info/catalog", appinfo.NewCatalogInfoHandler(catalogWatcher, httputil.NewResponseWriter(logs, cfg.DevelopmentMode)))
 
 	// create metrics endpoint
 	router.Handle("/metrics", promhttp.Handler())
@@ -377,13 +431,6 @@ func main() {
 	kcHandler := kubeconfig.NewHandler(db, kcBuilder, cfg.Kubeconfig.AllowOrigins, logs.WithField("service", "kubeconfigHandle"))
 	kcHandler.AttachRoutes(router)
 
-	runtimeLister := orchestration.NewRuntimeLister(db.Instances(), db.Operations(), runtime.NewConverter(cfg.DefaultRequestRegion), logs)
-	runtimeResolver := orchestrationExt.NewGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, runtimeLister, logs)
-
-	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, db, runtimeOverrides, provisionerClient, eventBroker, inputFactory, nil, time.Minute, runtimeVerConfigurator, runtimeResolver, upgradeEvalManager, &cfg, internalEvalAssistant, reconcilerClient, notificationBuilder, logs, cli, 1)
-	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, db, provisionerClient, eventBroker, inputFactory,
-		nil, time.Minute, runtimeResolver, upgradeEvalManager, notificationBuilder, logs, cli, cfg, 1)
-
 	// TODO: in case of cluster upgrade the same Azure Zones must be send to the Provisioner
 	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, kymaQueue, clusterQueue, cfg.MaxPaginationPage, logs)
 
@@ -416,6 +463,27 @@ func main() {
 	runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), cfg.MaxPaginationPage, cfg.DefaultRequestRegion)
 	runtimeHandler.AttachRoutes(router)
 
+	// create /audit
+	auditHandler := audit.NewHandler(db.AuditLog(), cfg.MaxPaginationPage, logs.WithField("service", "auditHandler"))
+	auditHandler.AttachRoutes(router)
+
+	// create /hyperscaler-accounts
+	accountPoolHandler := accountpool.NewHandler(accountInventory, gardenerSharedPool, logs.WithField("service", "accountPoolHandler"))
+	accountPoolHandler.AttachRoutes(router)
+
+	// create /plan-visibility
+	planVisibilityHandler := planvisibility.NewHandler(planVisibility, db.PlanVisibility(), logs.WithField("service", "planVisibilityHandler"))
+	planVisibilityHandler.AttachRoutes(router)
+
+	// create /jobs
+	if cfg.Jobs.Enabled {
+		jobScheduler, err := newJobScheduler(ctx, cfg.Jobs, db, k8sCfg, gardenerClusterConfig, dynamicGardener, gardenerNamespace, provisionerClient, logs)
+		fatalOnError(err)
+		go jobScheduler.Run(ctx)
+		jobsHandler := jobs.NewHandler(jobScheduler, db.JobRuns(), cfg.MaxPaginationPage, logs.WithField("service", "jobsHandler"))
+		jobsHandler.AttachRoutes(router)
+	}
+
 	router.StrictSlash(true).PathPrefix("/").Handler(http.StripPrefix("/", http.FileServer(http.Dir("/swagger"))))
 	svr := handlers.CustomLoggingHandler(os.Stdout, router, func(writer io.Writer, params handlers.LogFormatterParams) {
 		logs.Infof("Call handled: method=%s url=%s statusCode=%d size=%d", params.Request.Method, params.URL.Path, params.StatusCode, params.Size)
@@ -453,7 +521,7 @@ func isVersionFollowingSemanticVersioning(version string) bool {
 	return false
 }
 
-func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults) {
+func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue, kymaQueue, clusterQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults, planVisibility broker.PlanVisibility) {
 	suspensionCtxHandler := suspension.NewContextUpdateHandler(db.Operations(), provisionQueue, deprovisionQueue, logs)
 
 	defaultPlansConfig, err := servicesConfig.DefaultPlansConfig()
@@ -473,16 +541,16 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 
 	// create KymaEnvironmentBroker endpoints
 	kymaEnvBroker := &broker.KymaEnvironmentBroker{
-		broker.NewServices(cfg.Broker, servicesConfig, logs),
+		broker.NewServices(cfg.Broker, servicesConfig, logs, planVisibility),
 		broker.NewProvision(cfg.Broker, cfg.Gardener, db.Operations(), db.Instances(),
 			provisionQueue, planValidator, defaultPlansConfig, cfg.EnableOnDemandVersion,
-			planDefaults, whitelistedGlobalAccountIds, cfg.EuAccessRejectionMessage, logs, cfg.KymaDashboardConfig),
+			planDefaults, whitelistedGlobalAccountIds, cfg.EuAccessRejectionMessage, logs, cfg.KymaDashboardConfig, planVisibility),
 		broker.NewDeprovision(db.Instances(), db.Operations(), deprovisionQueue, logs),
 		broker.NewUpdate(cfg.Broker, db.Instances(), db.RuntimeStates(), db.Operations(),
 			suspensionCtxHandler, cfg.UpdateProcessingEnabled, cfg.UpdateSubAccountMovementEnabled, updateQueue,
-			planDefaults, logs, cfg.KymaDashboardConfig),
+			planDefaults, logs, cfg.KymaDashboardConfig, broker.NewMaintenanceUpgrader(db.Orchestrations(), db.RuntimeStates(), kymaQueue, clusterQueue), planVisibility),
 		broker.NewGetInstance(cfg.Broker, db.Instances(), db.Operations(), logs),
-		broker.NewLastOperation(db.Operations(), logs),
+		broker.NewLastOperation(db.Operations(), db.Orchestrations(), logs),
 		broker.NewBind(logs),
 		broker.NewUnbind(logs),
 		broker.NewGetBinding(logs),
@@ -1023,11 +1023,11 @@ func fixAccountProvider() *hyperscalerautomock.AccountProvider {
 	accountProvider.On("GardenerSecretName", mock.Anything, mock.Anything, mock.Anything).Return(
 		func(ht hyperscaler.Type, tn string, euaccess bool) string { return regularSubscription(ht) }, nil)
 
-	accountProvider.On("GardenerSharedSecretName", hyperscaler.Azure, mock.Anything).Return(
-		func(ht hyperscaler.Type, euaccess bool) string { return sharedSubscription(ht) }, nil)
+	accountProvider.On("GardenerSharedSecretName", hyperscaler.Azure, mock.Anything, mock.Anything).Return(
+		func(ht hyperscaler.Type, euaccess bool, region string) string { return sharedSubscription(ht) }, nil)
 
-	accountProvider.On("GardenerSharedSecretName", hyperscaler.AWS, mock.Anything).Return(
-		func(ht hyperscaler.Type, euaccess bool) string { return sharedSubscription(ht) }, nil)
+	accountProvider.On("GardenerSharedSecretName", hyperscaler.AWS, mock.Anything, mock.Anything).Return(
+		func(ht hyperscaler.Type, euaccess bool, region string) string { return sharedSubscription(ht) }, nil)
 
 	accountProvider.On("MarkUnusedGardenerSecretBindingAsDirty", hyperscaler.Azure, mock.Anything, mock.Anything).Return(nil)
 	accountProvider.On("MarkUnusedGardenerSecretBindingAsDirty", hyperscaler.AWS, mock.Anything, mock.Anything).Return(nil)
@@ -12,8 +12,17 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
 
+const awsDefaultRegion = "eu-central-1"
+
+// ec2Client is the part of the EC2 API used by the cleaner
+type ec2Client interface {
+	DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error)
+	DescribeVolumes(ctx context.Context, params *ec2.DescribeVolumesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+	DeleteVolume(ctx context.Context, params *ec2.DeleteVolumeInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVolumeOutput, error)
+}
+
 type awsResourceCleaner struct {
-	credentials awsCredentialsConfig
+	newClient func(region string) ec2Client
 }
 
 type awsCredentialsConfig struct {
@@ -22,40 +31,57 @@ type awsCredentialsConfig struct {
 }
 
 func NewAwsResourcesCleaner(secretData map[string][]byte) (ResourceCleaner, error) {
-	awsResourceCleaner := awsResourceCleaner{}
-	awsConfig, err := awsResourceCleaner.toAwsConfig(secretData)
+	awsConfig, err := toAwsConfig(secretData)
 	if err != nil {
 		return nil, err
 	}
 
-	awsResourceCleaner.credentials = awsConfig
-	return awsResourceCleaner, nil
+	return awsResourceCleaner{
+		newClient: func(region string) ec2Client {
+			return newAwsEC2Client(awsConfig, region)
+		},
+	}, nil
 }
 
 func (ac awsResourceCleaner) Do() error {
-	all_regions, err := ac.getAllRegions()
+	allRegions, err := ac.getAllRegions()
 	if err != nil {
 		return err
 	}
 
-	for _, region := range all_regions.Regions {
+	for _, region := range allRegions.Regions {
 		logrus.Printf("Switching to region %v", *region.RegionName)
-		ec2Client, err := ac.newAwsEC2Client(ac.credentials, *region.RegionName)
+		err = ac.deleteVolumes(ac.newClient(*region.RegionName))
 		if err != nil {
 			return err
 		}
+	}
 
-		err = ac.deleteVolumes(ec2Client)
+	return nil
+}
+
+func (ac awsResourceCleaner) Remaining() ([]string, error) {
+	allRegions, err := ac.getAllRegions()
+	if err != nil {
+		return nil, err
+	}
+
+	var remaining []string
+	for _, region := range allRegions.Regions {
+		volumes, err := ac.newClient(*region.RegionName).DescribeVolumes(context.TODO(), &ec2.DescribeVolumesInput{})
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("while listing volumes in region %s: %w", *region.RegionName, err)
+		}
+		for _, volume := range volumes.Volumes {
+			remaining = append(remaining, fmt.Sprintf("volume %s in %s", *volume.VolumeId, *region.RegionName))
 		}
 	}
 
-	return nil
+	return remaining, nil
 }
 
-func (ac awsResourceCleaner) deleteVolumes(ec2Client ec2.Client) error {
-	volumes, err := ec2Client.DescribeVolumes(context.TODO(), &ec2.DescribeVolumesInput{})
+func (ac awsResourceCleaner) deleteVolumes(client ec2Client) error {
+	volumes, err := client.DescribeVolumes(context.TODO(), &ec2.DescribeVolumesInput{})
 	if err != nil {
 		return err
 	}
@@ -68,9 +94,12 @@ func (ac awsResourceCleaner) deleteVolumes(ec2Client ec2.Client) error {
 
 	for _, volume := range volumes.Volumes {
 		logrus.Printf("Deleting volume with id %v", *volume.VolumeId)
-		ec2Client.DeleteVolume(context.TODO(), &ec2.DeleteVolumeInput{
+		_, err := client.DeleteVolume(context.TODO(), &ec2.DeleteVolumeInput{
 			VolumeId: volume.VolumeId,
 		})
+		if err != nil {
+			logrus.Errorf("failed to delete volume %s: %s", *volume.VolumeId, err)
+		}
 	}
 
 	return nil
@@ -78,12 +107,7 @@ func (ac awsResourceCleaner) deleteVolumes(ec2Client ec2.Client) error {
 
 func (ac awsResourceCleaner) getAllRegions() (ec2.DescribeRegionsOutput, error) {
 	allRegions := false
-	ec2Client, err := ac.newAwsEC2Client(ac.credentials, "eu-central-1")
-	if err != nil {
-		return ec2.DescribeRegionsOutput{}, err
-	}
-
-	regionOutput, err := ec2Client.DescribeRegions(context.TODO(), &ec2.DescribeRegionsInput{AllRegions: &allRegions})
+	regionOutput, err := ac.newClient(awsDefaultRegion).DescribeRegions(context.TODO(), &ec2.DescribeRegionsInput{AllRegions: &allRegions})
 	if err != nil {
 		return ec2.DescribeRegionsOutput{}, err
 	}
@@ -91,7 +115,7 @@ func (ac awsResourceCleaner) getAllRegions() (ec2.DescribeRegionsOutput, error)
 	return *regionOutput, nil
 }
 
-func (ac awsResourceCleaner) toAwsConfig(secretData map[string][]byte) (awsCredentialsConfig, error) {
+func toAwsConfig(secretData map[string][]byte) (awsCredentialsConfig, error) {
 	accessKeyID, exists := secretData["accessKeyID"]
 	if !exists {
 		return awsCredentialsConfig{}, fmt.Errorf("AccessKeyID was not provided in secret!")
@@ -108,9 +132,9 @@ func (ac awsResourceCleaner) toAwsConfig(secretData map[string][]byte) (awsCrede
 	}, nil
 }
 
-func (ac awsResourceCleaner) newAwsEC2Client(awsCredentialConfig awsCredentialsConfig, region string) (ec2.Client, error) {
-	return *ec2.New(ec2.Options{
+func newAwsEC2Client(awsCredentialConfig awsCredentialsConfig, region string) *ec2.Client {
+	return ec2.New(ec2.Options{
 		Region:      region,
 		Credentials: aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(awsCredentialConfig.accessKeyID, awsCredentialConfig.secretAccessKey, "")),
-	}), nil
+	})
 }
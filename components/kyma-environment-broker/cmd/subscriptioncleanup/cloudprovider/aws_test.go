@@ -0,0 +1,117 @@
+package cloudprovider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAwsResourceCleaner(t *testing.T) {
+	t.Run("should delete volumes in all regions", func(t *testing.T) {
+		// given
+		fake := newFakeEC2(map[string][]types.Volume{
+			"eu-central-1": {fixVolume("vol-1", types.VolumeStateAvailable)},
+			"us-east-1":    {fixVolume("vol-2", types.VolumeStateAvailable), fixVolume("vol-3", types.VolumeStateAvailable)},
+		})
+		cleaner := awsResourceCleaner{newClient: fake.client}
+
+		// when
+		err := cleaner.Do()
+		require.NoError(t, err)
+		remaining, err := cleaner.Remaining()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+	})
+
+	t.Run("should report the volumes which could not be deleted", func(t *testing.T) {
+		// given
+		fake := newFakeEC2(map[string][]types.Volume{
+			"eu-central-1": {fixVolume("vol-1", types.VolumeStateAvailable)},
+			"us-east-1":    {fixVolume("vol-2", types.VolumeStateAvailable)},
+		})
+		fake.failDelete["vol-2"] = true
+		cleaner := awsResourceCleaner{newClient: fake.client}
+
+		// when
+		err := cleaner.Do()
+		require.NoError(t, err)
+		remaining, err := cleaner.Remaining()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"volume vol-2 in us-east-1"}, remaining)
+	})
+
+	t.Run("should not delete volumes when one of them is in use", func(t *testing.T) {
+		// given
+		fake := newFakeEC2(map[string][]types.Volume{
+			"eu-central-1": {fixVolume("vol-1", types.VolumeStateAvailable), fixVolume("vol-2", types.VolumeStateInUse)},
+		})
+		cleaner := awsResourceCleaner{newClient: fake.client}
+
+		// when
+		err := cleaner.Do()
+
+		// then
+		assert.Error(t, err)
+		remaining, err := cleaner.Remaining()
+		require.NoError(t, err)
+		assert.Len(t, remaining, 2)
+	})
+}
+
+type fakeEC2 struct {
+	volumes    map[string][]types.Volume
+	failDelete map[string]bool
+}
+
+func newFakeEC2(volumes map[string][]types.Volume) *fakeEC2 {
+	return &fakeEC2{volumes: volumes, failDelete: map[string]bool{}}
+}
+
+func (f *fakeEC2) client(region string) ec2Client {
+	return &fakeEC2RegionClient{fake: f, region: region}
+}
+
+type fakeEC2RegionClient struct {
+	fake   *fakeEC2
+	region string
+}
+
+func (c *fakeEC2RegionClient) DescribeRegions(_ context.Context, _ *ec2.DescribeRegionsInput, _ ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	output := &ec2.DescribeRegionsOutput{}
+	for _, region := range []string{"eu-central-1", "us-east-1"} {
+		output.Regions = append(output.Regions, types.Region{RegionName: aws.String(region)})
+	}
+	return output, nil
+}
+
+func (c *fakeEC2RegionClient) DescribeVolumes(_ context.Context, _ *ec2.DescribeVolumesInput, _ ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	return &ec2.DescribeVolumesOutput{Volumes: append([]types.Volume{}, c.fake.volumes[c.region]...)}, nil
+}
+
+func (c *fakeEC2RegionClient) DeleteVolume(_ context.Context, params *ec2.DeleteVolumeInput, _ ...func(*ec2.Options)) (*ec2.DeleteVolumeOutput, error) {
+	if c.fake.failDelete[*params.VolumeId] {
+		return nil, fmt.Errorf("volume %s is locked", *params.VolumeId)
+	}
+	var volumes []types.Volume
+	for _, volume := range c.fake.volumes[c.region] {
+		if *volume.VolumeId != *params.VolumeId {
+			volumes = append(volumes, volume)
+		}
+	}
+	c.fake.volumes[c.region] = volumes
+	return &ec2.DeleteVolumeOutput{}, nil
+}
+
+func fixVolume(id string, state types.VolumeState) types.Volume {
+	return types.Volume{VolumeId: aws.String(id), State: state}
+}
@@ -11,8 +11,14 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// resourceGroupsClient is the part of the Azure resource groups API used by the cleaner
+type resourceGroupsClient interface {
+	ListNames(ctx context.Context) ([]string, error)
+	DeleteAndWait(ctx context.Context, name string) error
+}
+
 type azureResourceCleaner struct {
-	azureClient resources.GroupsClient
+	azureClient resourceGroupsClient
 }
 
 type config struct {
@@ -35,34 +41,70 @@ func NewAzureResourcesCleaner(secretData map[string][]byte) (ResourceCleaner, er
 	}
 
 	return &azureResourceCleaner{
-		azureClient: azureClient,
+		azureClient: &azureGroupsClient{client: azureClient},
 	}, nil
 }
 
 func (ac azureResourceCleaner) Do() error {
 	ctx := context.Background()
-	resourceGroups, err := ac.azureClient.List(ctx, "", nil)
+	resourceGroups, err := ac.azureClient.ListNames(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, resourceGroup := range resourceGroups.Values() {
-		if resourceGroup.Name != nil {
-			log.Infof("Deleting resource group '%s'", *resourceGroup.Name)
-			future, err := ac.azureClient.Delete(ctx, *resourceGroup.Name)
-			if err != nil {
-				log.Errorf("failed to init resource group '%s' deletion", *resourceGroup.Name)
-				continue
-			}
+	for _, resourceGroup := range resourceGroups {
+		log.Infof("Deleting resource group '%s'", resourceGroup)
+		err = ac.azureClient.DeleteAndWait(ctx, resourceGroup)
+		if err != nil {
+			log.Errorf("failed to remove resource group '%s', %s: ", resourceGroup, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (ac azureResourceCleaner) Remaining() ([]string, error) {
+	resourceGroups, err := ac.azureClient.ListNames(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("while listing resource groups: %w", err)
+	}
+
+	remaining := make([]string, 0, len(resourceGroups))
+	for _, resourceGroup := range resourceGroups {
+		remaining = append(remaining, fmt.Sprintf("resource group %s", resourceGroup))
+	}
+	return remaining, nil
+}
+
+type azureGroupsClient struct {
+	client resources.GroupsClient
+}
 
-			err = future.WaitForCompletionRef(ctx, ac.azureClient.Client)
-			if err != nil {
-				log.Errorf("failed to remove resource group '%s', %s: ", *resourceGroup.Name, err.Error())
+func (c *azureGroupsClient) ListNames(ctx context.Context) ([]string, error) {
+	var names []string
+	page, err := c.client.List(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for page.NotDone() {
+		for _, resourceGroup := range page.Values() {
+			if resourceGroup.Name != nil {
+				names = append(names, *resourceGroup.Name)
 			}
 		}
+		if err := page.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
 	}
+	return names, nil
+}
 
-	return nil
+func (c *azureGroupsClient) DeleteAndWait(ctx context.Context, name string) error {
+	future, err := c.client.Delete(ctx, name)
+	if err != nil {
+		return fmt.Errorf("while starting the deletion: %w", err)
+	}
+	return future.WaitForCompletionRef(ctx, c.client.Client)
 }
 
 func toConfig(secretData map[string][]byte) (config, error) {
@@ -0,0 +1,65 @@
+package cloudprovider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAzureResourceCleaner(t *testing.T) {
+	t.Run("should delete all resource groups", func(t *testing.T) {
+		// given
+		fake := &fakeResourceGroupsClient{groups: []string{"rg-1", "rg-2"}}
+		cleaner := azureResourceCleaner{azureClient: fake}
+
+		// when
+		err := cleaner.Do()
+		require.NoError(t, err)
+		remaining, err := cleaner.Remaining()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+	})
+
+	t.Run("should report the resource groups which could not be deleted", func(t *testing.T) {
+		// given
+		fake := &fakeResourceGroupsClient{groups: []string{"rg-1", "rg-2"}, failDelete: "rg-2"}
+		cleaner := azureResourceCleaner{azureClient: fake}
+
+		// when
+		err := cleaner.Do()
+		require.NoError(t, err)
+		remaining, err := cleaner.Remaining()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"resource group rg-2"}, remaining)
+	})
+}
+
+type fakeResourceGroupsClient struct {
+	groups     []string
+	failDelete string
+}
+
+func (f *fakeResourceGroupsClient) ListNames(_ context.Context) ([]string, error) {
+	return append([]string{}, f.groups...), nil
+}
+
+func (f *fakeResourceGroupsClient) DeleteAndWait(_ context.Context, name string) error {
+	if name == f.failDelete {
+		return fmt.Errorf("resource group %s is locked", name)
+	}
+	var groups []string
+	for _, group := range f.groups {
+		if group != name {
+			groups = append(groups, group)
+		}
+	}
+	f.groups = groups
+	return nil
+}
@@ -7,7 +7,10 @@ import (
 )
 
 type ResourceCleaner interface {
+	// Do deletes the resources left in the hyperscaler account
 	Do() error
+	// Remaining lists the resources still present in the hyperscaler account, an empty list means the account is clean
+	Remaining() ([]string, error)
 }
 
 //go:generate mockery --name=ProviderFactory
@@ -15,10 +18,13 @@ type ProviderFactory interface {
 	New(hyperscalerType model.HyperscalerType, secretData map[string][]byte) (ResourceCleaner, error)
 }
 
-type providerFactory struct{}
+type providerFactory struct {
+	keystoneURL string
+}
 
-func NewProviderFactory() ProviderFactory {
-	return &providerFactory{}
+// NewProviderFactory creates the factory, the keystoneURL is used for the OpenStack secrets without the authURL
+func NewProviderFactory(keystoneURL string) ProviderFactory {
+	return &providerFactory{keystoneURL: keystoneURL}
 }
 
 func (pf *providerFactory) New(hyperscalerType model.HyperscalerType, secretData map[string][]byte) (ResourceCleaner, error) {
@@ -35,6 +41,10 @@ func (pf *providerFactory) New(hyperscalerType model.HyperscalerType, secretData
 		{
 			return NewAwsResourcesCleaner(secretData)
 		}
+	case model.OpenStack:
+		{
+			return NewOpenStackResourcesCleaner(secretData, pf.keystoneURL)
+		}
 	default:
 		return nil, fmt.Errorf("unknown hyperscaler type")
 	}
@@ -10,3 +10,7 @@ func NewGCPeResourcesCleaner(secretData map[string][]byte) ResourceCleaner {
 func (rc gcpResourceCleaner) Do() error {
 	return nil
 }
+
+func (rc gcpResourceCleaner) Remaining() ([]string, error) {
+	return nil, nil
+}
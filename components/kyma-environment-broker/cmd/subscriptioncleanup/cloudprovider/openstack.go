@@ -0,0 +1,408 @@
+package cloudprovider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	openStackRequestTimeout       = 30 * time.Second
+	openStackDeletionPollInterval = 10 * time.Second
+	openStackDeletionTimeout      = 10 * time.Minute
+)
+
+type openStackResourceCleaner struct {
+	config     openStackConfig
+	httpClient *http.Client
+	// pollInterval and pollTimeout bound waiting for the kinds which are deleted asynchronously
+	pollInterval time.Duration
+	pollTimeout  time.Duration
+}
+
+type openStackConfig struct {
+	authURL    string
+	domainName string
+	tenantName string
+	username   string
+	password   string
+}
+
+// openStackKind describes how to list and delete one kind of the OpenStack resources, the kinds are deleted in the order
+// of the openStackKinds list, so that the resources using other resources are deleted first
+type openStackKind struct {
+	name    string
+	service string
+	// awaitDeletion is set for the kinds which OpenStack deletes asynchronously, the next kinds are deleted only after
+	// these resources are gone, otherwise volumes and networks still in use cannot be deleted
+	awaitDeletion bool
+	list          func(s *openStackSession, endpoint string) ([]string, error)
+	delete        func(s *openStackSession, endpoint, id string) error
+}
+
+var openStackKinds = []openStackKind{
+	{
+		name:          "load balancer",
+		service:       "load-balancer",
+		awaitDeletion: true,
+		list: func(s *openStackSession, endpoint string) ([]string, error) {
+			return s.listIDs(endpoint+"/v2/lbaas/loadbalancers", "loadbalancers")
+		},
+		delete: func(s *openStackSession, endpoint, id string) error {
+			return s.delete(endpoint + "/v2/lbaas/loadbalancers/" + id + "?cascade=true")
+		},
+	},
+	{
+		name:          "server",
+		service:       "compute",
+		awaitDeletion: true,
+		list: func(s *openStackSession, endpoint string) ([]string, error) {
+			return s.listIDs(endpoint+"/servers", "servers")
+		},
+		delete: func(s *openStackSession, endpoint, id string) error {
+			return s.delete(endpoint + "/servers/" + id)
+		},
+	},
+	{
+		name:    "floating IP",
+		service: "network",
+		list: func(s *openStackSession, endpoint string) ([]string, error) {
+			return s.listIDs(endpoint+"/v2.0/floatingips?project_id="+url.QueryEscape(s.projectID), "floatingips")
+		},
+		delete: func(s *openStackSession, endpoint, id string) error {
+			return s.delete(endpoint + "/v2.0/floatingips/" + id)
+		},
+	},
+	{
+		name:    "router",
+		service: "network",
+		list: func(s *openStackSession, endpoint string) ([]string, error) {
+			return s.listIDs(endpoint+"/v2.0/routers?project_id="+url.QueryEscape(s.projectID), "routers")
+		},
+		delete: func(s *openStackSession, endpoint, id string) error {
+			// a router can be deleted only after its interfaces are removed
+			ports, err := s.listIDs(endpoint+"/v2.0/ports?device_owner=network:router_interface&device_id="+url.QueryEscape(id), "ports")
+			if err != nil {
+				return fmt.Errorf("while listing router interfaces: %w", err)
+			}
+			for _, port := range ports {
+				err := s.do(http.MethodPut, endpoint+"/v2.0/routers/"+id+"/remove_router_interface", map[string]string{"port_id": port}, nil)
+				if err != nil {
+					return fmt.Errorf("while removing router interface %s: %w", port, err)
+				}
+			}
+			return s.delete(endpoint + "/v2.0/routers/" + id)
+		},
+	},
+	{
+		name:    "volume",
+		service: "volumev3",
+		list: func(s *openStackSession, endpoint string) ([]string, error) {
+			return s.listIDs(endpoint+"/volumes", "volumes")
+		},
+		delete: func(s *openStackSession, endpoint, id string) error {
+			return s.delete(endpoint + "/volumes/" + id + "?cascade=true")
+		},
+	},
+	{
+		name:    "network",
+		service: "network",
+		list: func(s *openStackSession, endpoint string) ([]string, error) {
+			return s.listIDs(endpoint+"/v2.0/networks?router:external=false&project_id="+url.QueryEscape(s.projectID), "networks")
+		},
+		delete: func(s *openStackSession, endpoint, id string) error {
+			return s.delete(endpoint + "/v2.0/networks/" + id)
+		},
+	},
+}
+
+// NewOpenStackResourcesCleaner uses the keystoneURL when the secret has no authURL
+func NewOpenStackResourcesCleaner(secretData map[string][]byte, keystoneURL string) (ResourceCleaner, error) {
+	config, err := toOpenStackConfig(secretData, keystoneURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &openStackResourceCleaner{
+		config:       config,
+		httpClient:   &http.Client{Timeout: openStackRequestTimeout},
+		pollInterval: openStackDeletionPollInterval,
+		pollTimeout:  openStackDeletionTimeout,
+	}, nil
+}
+
+func (oc openStackResourceCleaner) Do() error {
+	session, err := oc.authenticate()
+	if err != nil {
+		return err
+	}
+
+	for _, kind := range openStackKinds {
+		var result *multierror.Error
+		for _, region := range session.regions(kind.service) {
+			endpoint := session.endpoints[kind.service][region]
+			ids, err := kind.list(session, endpoint)
+			if err != nil {
+				return fmt.Errorf("while listing %ss in region %s: %w", kind.name, region, err)
+			}
+			deleted := true
+			for _, id := range ids {
+				log.Infof("Deleting %s %s in region %s", kind.name, id, region)
+				if err := kind.delete(session, endpoint, id); err != nil {
+					result = multierror.Append(result, fmt.Errorf("while deleting %s %s in region %s: %w", kind.name, id, region, err))
+					deleted = false
+				}
+			}
+			if kind.awaitDeletion && deleted && len(ids) > 0 {
+				if err := oc.waitForDeletion(session, kind, region); err != nil {
+					result = multierror.Append(result, err)
+				}
+			}
+		}
+		// the next kinds depend on the resources of this kind being gone
+		if err := result.ErrorOrNil(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (oc openStackResourceCleaner) waitForDeletion(session *openStackSession, kind openStackKind, region string) error {
+	err := wait.PollImmediate(oc.pollInterval, oc.pollTimeout, func() (bool, error) {
+		ids, err := kind.list(session, session.endpoints[kind.service][region])
+		if err != nil {
+			return false, fmt.Errorf("while listing %ss in region %s: %w", kind.name, region, err)
+		}
+		if len(ids) > 0 {
+			log.Infof("Waiting for %d %s(s) in region %s to be deleted", len(ids), kind.name, region)
+		}
+		return len(ids) == 0, nil
+	})
+	if err != nil {
+		return fmt.Errorf("while waiting for %ss in region %s to be deleted: %w", kind.name, region, err)
+	}
+	return nil
+}
+
+func (oc openStackResourceCleaner) Remaining() ([]string, error) {
+	session, err := oc.authenticate()
+	if err != nil {
+		return nil, err
+	}
+
+	var remaining []string
+	for _, kind := range openStackKinds {
+		for _, region := range session.regions(kind.service) {
+			ids, err := kind.list(session, session.endpoints[kind.service][region])
+			if err != nil {
+				return nil, fmt.Errorf("while listing %ss in region %s: %w", kind.name, region, err)
+			}
+			for _, id := range ids {
+				remaining = append(remaining, fmt.Sprintf("%s %s in %s", kind.name, id, region))
+			}
+		}
+	}
+
+	return remaining, nil
+}
+
+func toOpenStackConfig(secretData map[string][]byte, keystoneURL string) (openStackConfig, error) {
+	values := map[string]string{}
+	for _, key := range []string{"domainName", "tenantName", "username", "password"} {
+		value, exists := secretData[key]
+		if !exists {
+			return openStackConfig{}, fmt.Errorf("%s not provided in the secret", key)
+		}
+		values[key] = string(value)
+	}
+
+	authURL := keystoneURL
+	if value, exists := secretData["authURL"]; exists && len(value) > 0 {
+		authURL = string(value)
+	}
+	if authURL == "" {
+		return openStackConfig{}, fmt.Errorf("authURL not provided in the secret and no Keystone URL configured")
+	}
+
+	return openStackConfig{
+		authURL:    strings.TrimSuffix(authURL, "/"),
+		domainName: values["domainName"],
+		tenantName: values["tenantName"],
+		username:   values["username"],
+		password:   values["password"],
+	}, nil
+}
+
+// openStackSession holds the token and the service endpoints returned by Keystone
+type openStackSession struct {
+	httpClient *http.Client
+	token      string
+	projectID  string
+	// endpoints holds the public endpoints by the service type and the region
+	endpoints map[string]map[string]string
+}
+
+type keystoneTokenResponse struct {
+	Token struct {
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+		Catalog []struct {
+			Type      string `json:"type"`
+			Endpoints []struct {
+				Interface string `json:"interface"`
+				Region    string `json:"region"`
+				URL       string `json:"url"`
+			} `json:"endpoints"`
+		} `json:"catalog"`
+	} `json:"token"`
+}
+
+// authenticate gets a token scoped to the project with the Keystone v3 password method
+func (oc openStackResourceCleaner) authenticate() (*openStackSession, error) {
+	domain := map[string]string{"name": oc.config.domainName}
+	request := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{
+						"name":     oc.config.username,
+						"domain":   domain,
+						"password": oc.config.password,
+					},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]interface{}{
+					"name":   oc.config.tenantName,
+					"domain": domain,
+				},
+			},
+		},
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("while encoding the token request: %w", err)
+	}
+
+	resp, err := oc.httpClient.Post(oc.config.authURL+"/auth/tokens", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("while requesting a token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("while requesting a token: %s", responseError(resp))
+	}
+
+	var tokenResponse keystoneTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return nil, fmt.Errorf("while decoding the token response: %w", err)
+	}
+
+	session := &openStackSession{
+		httpClient: oc.httpClient,
+		token:      resp.Header.Get("X-Subject-Token"),
+		projectID:  tokenResponse.Token.Project.ID,
+		endpoints:  map[string]map[string]string{},
+	}
+	for _, service := range tokenResponse.Token.Catalog {
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface != "public" {
+				continue
+			}
+			if session.endpoints[service.Type] == nil {
+				session.endpoints[service.Type] = map[string]string{}
+			}
+			session.endpoints[service.Type][endpoint.Region] = strings.TrimSuffix(endpoint.URL, "/")
+		}
+	}
+	return session, nil
+}
+
+// regions returns the regions of the service in a stable order, a service missing in the catalog has no regions
+func (s *openStackSession) regions(service string) []string {
+	regions := make([]string, 0, len(s.endpoints[service]))
+	for region := range s.endpoints[service] {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+func (s *openStackSession) listIDs(url, collection string) ([]string, error) {
+	var response map[string][]struct {
+		ID string `json:"id"`
+	}
+	if err := s.do(http.MethodGet, url, nil, &response); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(response[collection]))
+	for _, item := range response[collection] {
+		ids = append(ids, item.ID)
+	}
+	return ids, nil
+}
+
+func (s *openStackSession) delete(url string) error {
+	return s.do(http.MethodDelete, url, nil, nil)
+}
+
+func (s *openStackSession) do(method, url string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("while encoding the request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return fmt.Errorf("while creating the request: %w", err)
+	}
+	req.Header.Set("X-Auth-Token", s.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling %s %s: %w", method, url, err)
+	}
+	defer resp.Body.Close()
+
+	// the resource is already gone
+	if method == http.MethodDelete && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s %s: %s", method, url, responseError(resp))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("while decoding the response of %s %s: %w", method, url, err)
+	}
+	return nil
+}
+
+func responseError(resp *http.Response) string {
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Sprintf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
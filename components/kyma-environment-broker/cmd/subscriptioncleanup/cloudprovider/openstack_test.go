@@ -0,0 +1,369 @@
+package cloudprovider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fakeOpenStackToken     = "token-1"
+	fakeOpenStackPassword  = "secret"
+	fakeOpenStackProjectID = "project-1"
+)
+
+func TestOpenStackResourceCleaner(t *testing.T) {
+	t.Run("should delete all resources", func(t *testing.T) {
+		// given
+		fake := newFakeOpenStack()
+		defer fake.Close()
+		cleaner := newTestOpenStackCleaner(t, fake.URL, fakeOpenStackPassword)
+
+		// when
+		err := cleaner.Do()
+		require.NoError(t, err)
+		remaining, err := cleaner.Remaining()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+		assert.Empty(t, fake.routerInterfaces["router-1"])
+		assert.Contains(t, fake.deleted, "/load-balancer/v2/lbaas/loadbalancers/lb-1?cascade=true")
+	})
+
+	t.Run("should report the resources which could not be deleted", func(t *testing.T) {
+		// given
+		fake := newFakeOpenStack()
+		defer fake.Close()
+		fake.locked["vol-2"] = true
+		cleaner := newTestOpenStackCleaner(t, fake.URL, fakeOpenStackPassword)
+
+		// when
+		err := cleaner.Do()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while deleting volume vol-2 in region RegionOne")
+		remaining, err := cleaner.Remaining()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"volume vol-2 in RegionOne", "network net-1 in RegionOne"}, remaining)
+	})
+
+	t.Run("should wait for the servers and load balancers to be deleted", func(t *testing.T) {
+		// given
+		fake := newFakeOpenStack()
+		defer fake.Close()
+		fake.deletionDelay = 3
+		cleaner := newTestOpenStackCleaner(t, fake.URL, fakeOpenStackPassword)
+
+		// when
+		err := cleaner.Do()
+		require.NoError(t, err)
+		remaining, err := cleaner.Remaining()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+	})
+
+	t.Run("should fail when the servers are not deleted in time", func(t *testing.T) {
+		// given
+		fake := newFakeOpenStack()
+		defer fake.Close()
+		fake.deletionDelay = 1000
+		cleaner := newTestOpenStackCleaner(t, fake.URL, fakeOpenStackPassword)
+
+		// when
+		err := cleaner.Do()
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while waiting for load balancers in region RegionOne to be deleted")
+		assert.Len(t, fake.collections["/volume/volumes"], 2)
+	})
+
+	t.Run("should skip the services missing in the catalog", func(t *testing.T) {
+		// given
+		fake := newFakeOpenStack()
+		defer fake.Close()
+		fake.services = []string{"compute"}
+		cleaner := newTestOpenStackCleaner(t, fake.URL, fakeOpenStackPassword)
+
+		// when
+		err := cleaner.Do()
+		require.NoError(t, err)
+		remaining, err := cleaner.Remaining()
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+		assert.Len(t, fake.collections["/volume/volumes"], 2)
+	})
+
+	t.Run("should fail on invalid credentials", func(t *testing.T) {
+		// given
+		fake := newFakeOpenStack()
+		defer fake.Close()
+		cleaner := newTestOpenStackCleaner(t, fake.URL, "wrong")
+
+		// when
+		err := cleaner.Do()
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "401")
+	})
+
+	t.Run("should require all credentials in the secret", func(t *testing.T) {
+		// when
+		_, err := NewOpenStackResourcesCleaner(map[string][]byte{"authURL": []byte("http://keystone/v3")}, "")
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should use the configured Keystone URL when the secret has no authURL", func(t *testing.T) {
+		// given
+		fake := newFakeOpenStack()
+		defer fake.Close()
+		secretData := newOpenStackSecretData("", fakeOpenStackPassword)
+
+		// when
+		cleaner, err := NewOpenStackResourcesCleaner(secretData, fake.URL+"/identity/v3/")
+		require.NoError(t, err)
+		err = cleaner.Do()
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should prefer the authURL from the secret", func(t *testing.T) {
+		// given
+		fake := newFakeOpenStack()
+		defer fake.Close()
+		secretData := newOpenStackSecretData(fake.URL, fakeOpenStackPassword)
+
+		// when
+		cleaner, err := NewOpenStackResourcesCleaner(secretData, "http://keystone.invalid/v3")
+		require.NoError(t, err)
+		err = cleaner.Do()
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail without the authURL and the configured Keystone URL", func(t *testing.T) {
+		// when
+		_, err := NewOpenStackResourcesCleaner(newOpenStackSecretData("", fakeOpenStackPassword), "")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func newTestOpenStackCleaner(t *testing.T, url, password string) ResourceCleaner {
+	cleaner, err := NewOpenStackResourcesCleaner(newOpenStackSecretData(url, password), "")
+	require.NoError(t, err)
+	openStackCleaner := cleaner.(*openStackResourceCleaner)
+	openStackCleaner.pollInterval = time.Millisecond
+	openStackCleaner.pollTimeout = 100 * time.Millisecond
+	return openStackCleaner
+}
+
+// newOpenStackSecretData returns the secret data of the fake OpenStack, an empty url leaves out the authURL
+func newOpenStackSecretData(url, password string) map[string][]byte {
+	secretData := map[string][]byte{
+		"domainName": []byte("domain"),
+		"tenantName": []byte("tenant"),
+		"username":   []byte("user"),
+		"password":   []byte(password),
+	}
+	if url != "" {
+		secretData["authURL"] = []byte(url + "/identity/v3/")
+	}
+	return secretData
+}
+
+// fakeOpenStack is an in-memory OpenStack API serving Keystone, Nova, Cinder, Neutron and Octavia from a single server
+type fakeOpenStack struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	services []string
+	// collections holds the resource IDs by the collection path
+	collections      map[string][]string
+	routerInterfaces map[string][]string
+	locked           map[string]bool
+	deleted          []string
+	// deletionDelay is the number of list calls the asynchronously deleted servers and load balancers are still listed for
+	deletionDelay int
+	pending       map[string]int
+}
+
+func newFakeOpenStack() *fakeOpenStack {
+	fake := &fakeOpenStack{
+		services: []string{"compute", "volumev3", "network", "load-balancer"},
+		collections: map[string][]string{
+			"/load-balancer/v2/lbaas/loadbalancers": {"lb-1"},
+			"/compute/servers":                      {"server-1", "server-2"},
+			"/volume/volumes":                       {"vol-1", "vol-2"},
+			"/network/v2.0/floatingips":             {"fip-1"},
+			"/network/v2.0/routers":                 {"router-1"},
+			"/network/v2.0/networks":                {"net-1"},
+		},
+		routerInterfaces: map[string][]string{"router-1": {"port-1", "port-2"}},
+		locked:           map[string]bool{},
+		pending:          map[string]int{},
+	}
+	fake.Server = httptest.NewServer(http.HandlerFunc(fake.serveHTTP))
+	return fake
+}
+
+func (f *fakeOpenStack) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodPost && r.URL.Path == "/identity/v3/auth/tokens" {
+		f.issueToken(w, r)
+		return
+	}
+	if r.Header.Get("X-Auth-Token") != fakeOpenStackToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/network/v2.0/ports":
+		f.writeIDs(w, "ports", f.routerInterfaces[r.URL.Query().Get("device_id")])
+	case r.Method == http.MethodGet:
+		ids, found := f.collections[r.URL.Path]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.writeIDs(w, path.Base(r.URL.Path), ids)
+		f.progressDeletion(r.URL.Path)
+	case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/remove_router_interface"):
+		var body struct {
+			PortID string `json:"port_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		router := path.Base(path.Dir(r.URL.Path))
+		f.routerInterfaces[router] = remove(f.routerInterfaces[router], body.PortID)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	case r.Method == http.MethodDelete:
+		collection, id := path.Dir(r.URL.Path), path.Base(r.URL.Path)
+		if f.locked[id] || len(f.routerInterfaces[id]) > 0 || f.attached(collection) {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"message": "resource is in use"}`))
+			return
+		}
+		f.deleted = append(f.deleted, r.URL.RequestURI())
+		if f.deletionDelay > 0 && f.deletedAsynchronously(collection) {
+			f.pending[id] = f.deletionDelay
+			break
+		}
+		f.collections[collection] = remove(f.collections[collection], id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// attached reports whether the resources of the collection are still used by the servers
+func (f *fakeOpenStack) attached(collection string) bool {
+	return (collection == "/volume/volumes" || collection == "/network/v2.0/networks") && len(f.collections["/compute/servers"]) > 0
+}
+
+func (f *fakeOpenStack) deletedAsynchronously(collection string) bool {
+	return collection == "/compute/servers" || collection == "/load-balancer/v2/lbaas/loadbalancers"
+}
+
+// progressDeletion removes the pending resources of the collection once they were listed deletionDelay times
+func (f *fakeOpenStack) progressDeletion(collection string) {
+	for _, id := range f.collections[collection] {
+		if _, pending := f.pending[id]; !pending {
+			continue
+		}
+		f.pending[id]--
+		if f.pending[id] <= 0 {
+			delete(f.pending, id)
+			f.collections[collection] = remove(f.collections[collection], id)
+		}
+	}
+}
+
+func (f *fakeOpenStack) issueToken(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Auth struct {
+			Identity struct {
+				Password struct {
+					User struct {
+						Password string `json:"password"`
+					} `json:"user"`
+				} `json:"password"`
+			} `json:"identity"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Auth.Identity.Password.User.Password != fakeOpenStackPassword {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	paths := map[string]string{
+		"compute":       "/compute",
+		"volumev3":      "/volume",
+		"network":       "/network",
+		"load-balancer": "/load-balancer",
+	}
+	var catalog []map[string]interface{}
+	for _, service := range f.services {
+		catalog = append(catalog, map[string]interface{}{
+			"type": service,
+			"endpoints": []map[string]string{
+				{"interface": "internal", "region": "RegionOne", "url": "http://internal" + paths[service]},
+				{"interface": "public", "region": "RegionOne", "url": f.URL + paths[service] + "/"},
+			},
+		})
+	}
+
+	w.Header().Set("X-Subject-Token", fakeOpenStackToken)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"token": map[string]interface{}{
+			"project": map[string]string{"id": fakeOpenStackProjectID},
+			"catalog": catalog,
+		},
+	})
+}
+
+func (f *fakeOpenStack) writeIDs(w http.ResponseWriter, collection string, ids []string) {
+	items := make([]map[string]string, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, map[string]string{"id": id})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{collection: items})
+}
+
+func remove(ids []string, id string) []string {
+	var result []string
+	for _, current := range ids {
+		if current != id {
+			result = append(result, current)
+		}
+	}
+	return result
+}
@@ -3,10 +3,13 @@ package job
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/cloudprovider"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/model"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/cleanup"
 	"github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,17 +18,31 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
+const jobName = "subscription-cleanup"
+
 type Type string
 
+type Config struct {
+	// DryRun only reports the secret bindings to release
+	DryRun bool `envconfig:"default=false"`
+	// MaxAffected aborts the cleanup if more secret bindings would be released, zero means no limit
+	MaxAffected int `envconfig:"default=0"`
+	// MaxAttempts is the number of failed cleanups after which the secret binding is quarantined, zero means no limit
+	MaxAttempts int `envconfig:"default=3"`
+}
+
 type Cleaner interface {
 	Do() error
+	// Release returns the report of the secret bindings returned to the hyperscaler account pool
+	Release() (*cleanup.Report, error)
 }
 
 func NewCleaner(context context.Context,
 	kubernetesInterface kubernetes.Interface,
 	secretBindingsClient dynamic.ResourceInterface,
 	shootClient dynamic.ResourceInterface,
-	providerFactory cloudprovider.ProviderFactory) Cleaner {
+	providerFactory cloudprovider.ProviderFactory,
+	cfg Config) Cleaner {
 
 	return &cleaner{
 		kubernetesInterface:  kubernetesInterface,
@@ -33,6 +50,7 @@ func NewCleaner(context context.Context,
 		providerFactory:      providerFactory,
 		shootClient:          shootClient,
 		context:              context,
+		cfg:                  cfg,
 	}
 }
 
@@ -42,14 +60,22 @@ type cleaner struct {
 	providerFactory      cloudprovider.ProviderFactory
 	shootClient          dynamic.ResourceInterface
 	context              context.Context
+	cfg                  Config
 }
 
 func (p *cleaner) Do() error {
+	_, err := p.Release()
+	return err
+}
+
+func (p *cleaner) Release() (*cleanup.Report, error) {
 	logrus.Info("Started releasing resources")
+	report := cleanup.NewReport(jobName, p.cfg.DryRun)
 	secretBindings, err := p.getSecretBindingsToRelease()
 	if err != nil {
-		return err
+		return report, err
 	}
+	var toRelease []unstructured.Unstructured
 	for _, secretBinding := range secretBindings {
 		canRelease, err := p.checkIfSecretCanBeReleased(secretBinding)
 		if err != nil {
@@ -62,40 +88,151 @@ func (p *cleaner) Do() error {
 			continue
 		}
 
-		err = p.releaseResources(secretBinding)
-		if err != nil {
-			logrus.Errorf("Failed to release resources for '%s' secret binding: %s", secretBinding.GetName(), err.Error())
-			continue
-		}
-		err = p.returnSecretBindingToThePool(secretBinding)
+		toRelease = append(toRelease, secretBinding)
+		report.Add(cleanup.Item{
+			Kind: cleanup.KindSecretBinding,
+			Name: secretBinding.GetName(),
+			Reason: fmt.Sprintf("marked dirty by tenant %q and not used by any shoot, cleanup state %s after %d attempts",
+				secretBinding.GetLabels()["tenantName"], getState(secretBinding), getAttempts(secretBinding)),
+		})
+	}
+
+	if err := report.CheckLimit(p.cfg.MaxAffected); err != nil {
+		return report, err
+	}
+	if p.cfg.DryRun {
+		logrus.Infof("Dry run: %d secret bindings would be released", len(toRelease))
+		return report, nil
+	}
+
+	for _, secretBinding := range toRelease {
+		err = p.process(secretBinding)
 		if err != nil {
-			logrus.Errorf("Failed returning '%s' secret binding to the pool: %s", secretBinding.GetName(), err.Error())
+			logrus.Errorf("Failed to release '%s' secret binding: %s", secretBinding.GetName(), err.Error())
+			report.SetError(cleanup.KindSecretBinding, secretBinding.GetName(), err)
 			continue
 		}
 		logrus.Infof("Resources released for '%s' secret binding", secretBinding.GetName())
 	}
 
 	logrus.Info("Finished releasing resources")
+	return report, nil
+}
+
+// process moves the secret binding through the cleanup states: dirty -> cleaning -> verifying -> clean, a failure in
+// any state moves it to failed. The state is stored in the secret binding, so the next run resumes an interrupted cleanup.
+func (p *cleaner) process(secretBinding unstructured.Unstructured) error {
+	state := getState(secretBinding)
+	attempts := getAttempts(secretBinding)
+	if p.cfg.MaxAttempts > 0 && attempts >= p.cfg.MaxAttempts {
+		// quarantined secret bindings are not listed, so the operator removed the label and the cleanup starts over
+		logrus.Infof("Secret binding '%s' left the quarantine, resetting %d cleanup attempts", secretBinding.GetName(), attempts)
+		attempts = 0
+	}
+	if state == StateDirty || state == StateFailed {
+		attempts++
+		logrus.Infof("Starting cleanup attempt %d of '%s' secret binding", attempts, secretBinding.GetName())
+		if err := p.setState(secretBinding.GetName(), StateCleaning, attempts, nil); err != nil {
+			return err
+		}
+		state = StateCleaning
+	}
+
+	if state == StateCleaning {
+		if err := p.releaseResources(secretBinding); err != nil {
+			return p.fail(secretBinding.GetName(), attempts, fmt.Errorf("while deleting resources: %w", err))
+		}
+		if err := p.setState(secretBinding.GetName(), StateVerifying, attempts, nil); err != nil {
+			return err
+		}
+	}
+
+	remaining, err := p.remainingResources(secretBinding)
+	if err != nil {
+		return p.fail(secretBinding.GetName(), attempts, fmt.Errorf("while verifying resources: %w", err))
+	}
+	if len(remaining) > 0 {
+		return p.fail(secretBinding.GetName(), attempts, fmt.Errorf("%d resources remain: %s", len(remaining), strings.Join(remaining, ", ")))
+	}
+
+	return p.returnSecretBindingToThePool(secretBinding)
+}
+
+// fail stores the error in the secret binding and quarantines it after too many failed attempts
+func (p *cleaner) fail(name string, attempts int, cause error) error {
+	quarantine := p.cfg.MaxAttempts > 0 && attempts >= p.cfg.MaxAttempts
+	err := p.updateSecretBinding(name, func(sb *unstructured.Unstructured) {
+		setState(sb, StateFailed, attempts, cause)
+		if quarantine {
+			labels := sb.GetLabels()
+			labels[hyperscaler.QuarantinedLabel] = "true"
+			sb.SetLabels(labels)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", cause, err)
+	}
+	if quarantine {
+		logrus.Warnf("Quarantined '%s' secret binding after %d failed cleanup attempts", name, attempts)
+		return fmt.Errorf("%w, quarantined after %d attempts", cause, attempts)
+	}
+	return cause
+}
+
+func (p *cleaner) setState(name string, state State, attempts int, cause error) error {
+	return p.updateSecretBinding(name, func(sb *unstructured.Unstructured) {
+		setState(sb, state, attempts, cause)
+	})
+}
+
+// updateSecretBinding applies the change to the current version of the secret binding
+func (p *cleaner) updateSecretBinding(name string, change func(sb *unstructured.Unstructured)) error {
+	sb, err := p.secretBindingsClient.Get(p.context, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("while getting secret binding: %w", err)
+	}
+	change(sb)
+	if _, err := p.secretBindingsClient.Update(p.context, sb, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("while updating the cleanup state of the secret binding: %w", err)
+	}
 	return nil
 }
 
 func (p *cleaner) releaseResources(secretBinding unstructured.Unstructured) error {
+	cleaner, err := p.newResourceCleaner(secretBinding)
+	if err != nil {
+		return err
+	}
+
+	return cleaner.Do()
+}
+
+func (p *cleaner) remainingResources(secretBinding unstructured.Unstructured) ([]string, error) {
+	cleaner, err := p.newResourceCleaner(secretBinding)
+	if err != nil {
+		return nil, err
+	}
+
+	return cleaner.Remaining()
+}
+
+func (p *cleaner) newResourceCleaner(secretBinding unstructured.Unstructured) (cloudprovider.ResourceCleaner, error) {
 	hyperscalerType, err := model.NewHyperscalerType(secretBinding.GetLabels()["hyperscalerType"])
 	if err != nil {
-		return fmt.Errorf("starting releasing resources: %w", err)
+		return nil, fmt.Errorf("starting releasing resources: %w", err)
 	}
 
 	secret, err := p.getBoundSecret(secretBinding)
 	if err != nil {
-		return fmt.Errorf("getting referenced secret: %w", err)
+		return nil, fmt.Errorf("getting referenced secret: %w", err)
 	}
 
 	cleaner, err := p.providerFactory.New(hyperscalerType, secret.Data)
 	if err != nil {
-		return fmt.Errorf("initializing cloud provider cleaner: %w", err)
+		return nil, fmt.Errorf("initializing cloud provider cleaner: %w", err)
 	}
 
-	return cleaner.Do()
+	return cleaner, nil
 }
 
 func (p *cleaner) getBoundSecret(sb unstructured.Unstructured) (*apiv1.Secret, error) {
@@ -120,6 +257,10 @@ func (p *cleaner) returnSecretBindingToThePool(secretBinding unstructured.Unstru
 	delete(l, "dirty")
 	delete(l, "tenantName")
 	sb.SetLabels(l)
+	a := sb.GetAnnotations()
+	delete(a, hyperscaler.DirtySinceAnnotation)
+	removeState(a)
+	sb.SetAnnotations(a)
 
 	_, err = p.secretBindingsClient.Update(p.context, sb, metav1.UpdateOptions{})
 	if err != nil {
@@ -130,7 +271,7 @@ func (p *cleaner) returnSecretBindingToThePool(secretBinding unstructured.Unstru
 }
 
 func (p *cleaner) getSecretBindingsToRelease() ([]unstructured.Unstructured, error) {
-	labelSelector := fmt.Sprintf("dirty=true")
+	labelSelector := fmt.Sprintf("dirty=true,!%s", hyperscaler.QuarantinedLabel)
 
 	return getSecretBindings(p.context, p.secretBindingsClient, labelSelector)
 }
@@ -2,11 +2,14 @@ package job
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/cloudprovider/mocks"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/model"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/cleanup"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -67,7 +70,7 @@ func TestCleanerJob(t *testing.T) {
 		providerFactory := &mocks.ProviderFactory{}
 		providerFactory.On("New", model.Azure, mock.Anything).Return(resCleaner, nil)
 
-		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory)
+		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory, Config{})
 
 		//when
 		err := cleaner.Do()
@@ -79,6 +82,8 @@ func TestCleanerJob(t *testing.T) {
 
 		assert.Equal(t, "", cleanedSecretBinding.GetLabels()["dirty"])
 		assert.Equal(t, "", cleanedSecretBinding.GetLabels()["tenantName"])
+		assert.Empty(t, cleanedSecretBinding.GetAnnotations()[hyperscaler.CleanupStateAnnotation])
+		assert.Empty(t, cleanedSecretBinding.GetAnnotations()[hyperscaler.CleanupAttemptsAnnotation])
 	})
 
 	t.Run("should not return secret binding to the secrets pool when secret is still in use", func(t *testing.T) {
@@ -138,7 +143,7 @@ func TestCleanerJob(t *testing.T) {
 		providerFactory := &mocks.ProviderFactory{}
 		providerFactory.On("New", model.Azure, mock.Anything).Return(resCleaner, nil)
 
-		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory)
+		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory, Config{})
 
 		//when
 		err := cleaner.Do()
@@ -151,12 +156,231 @@ func TestCleanerJob(t *testing.T) {
 		assert.Equal(t, "true", cleanedSecretBinding.GetLabels()["dirty"])
 		assert.Equal(t, "tenant1", cleanedSecretBinding.GetLabels()["tenantName"])
 	})
+
+	t.Run("should only report secret binding in the dry-run mode", func(t *testing.T) {
+		//given
+		secret, secretBinding := fixDirtySecretBinding()
+		mockClient := fake.NewSimpleClientset(secret)
+
+		gardenerFake := gardener.NewDynamicFakeClient(secretBinding)
+		mockSecretBindings := gardenerFake.Resource(gardener.SecretBindingResource).Namespace(namespace)
+		mockShoots := gardenerFake.Resource(gardener.ShootResource).Namespace(namespace)
+		providerFactory := &mocks.ProviderFactory{}
+
+		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory, Config{DryRun: true})
+
+		//when
+		report, err := cleaner.Release()
+
+		//then
+		require.NoError(t, err)
+		providerFactory.AssertNotCalled(t, "New", mock.Anything, mock.Anything)
+		require.Len(t, report.Items, 1)
+		assert.Equal(t, cleanup.KindSecretBinding, report.Items[0].Kind)
+		assert.Equal(t, secretBinding.GetName(), report.Items[0].Name)
+		assert.Empty(t, report.Affected())
+
+		notCleanedSecretBinding, err := mockSecretBindings.Get(context.Background(), secretBinding.GetName(), machineryv1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "true", notCleanedSecretBinding.GetLabels()["dirty"])
+	})
+
+	t.Run("should not release secret bindings when more would be released than the limit", func(t *testing.T) {
+		//given
+		secret, secretBinding := fixDirtySecretBinding()
+		otherSecretBinding := secretBinding.DeepCopy()
+		otherSecretBinding.SetName("secretBinding2")
+		mockClient := fake.NewSimpleClientset(secret)
+
+		gardenerFake := gardener.NewDynamicFakeClient(secretBinding, otherSecretBinding)
+		mockSecretBindings := gardenerFake.Resource(gardener.SecretBindingResource).Namespace(namespace)
+		mockShoots := gardenerFake.Resource(gardener.ShootResource).Namespace(namespace)
+		providerFactory := &mocks.ProviderFactory{}
+
+		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory, Config{MaxAffected: 1})
+
+		//when
+		report, err := cleaner.Release()
+
+		//then
+		assert.EqualError(t, err, "subscription-cleanup would affect 2 resources, more than the limit of 1, aborting")
+		providerFactory.AssertNotCalled(t, "New", mock.Anything, mock.Anything)
+		assert.Len(t, report.Items, 2)
+	})
+}
+
+func TestCleanerJob_Pipeline(t *testing.T) {
+	t.Run("should mark secret binding as failed when resources remain", func(t *testing.T) {
+		//given
+		secret, secretBinding := fixDirtySecretBinding()
+		mockClient := fake.NewSimpleClientset(secret)
+
+		gardenerFake := gardener.NewDynamicFakeClient(secretBinding)
+		mockSecretBindings := gardenerFake.Resource(gardener.SecretBindingResource).Namespace(namespace)
+		mockShoots := gardenerFake.Resource(gardener.ShootResource).Namespace(namespace)
+
+		resCleaner := &azureMockResourceCleaner{remaining: []string{"resource group rg-1"}}
+		providerFactory := &mocks.ProviderFactory{}
+		providerFactory.On("New", model.Azure, mock.Anything).Return(resCleaner, nil)
+
+		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory, Config{MaxAttempts: 3})
+
+		//when
+		report, err := cleaner.Release()
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, "1 resources remain: resource group rg-1", report.Items[0].Error)
+		assert.Empty(t, report.Affected())
+
+		failedSecretBinding, err := mockSecretBindings.Get(context.Background(), secretBinding.GetName(), machineryv1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "true", failedSecretBinding.GetLabels()["dirty"])
+		assert.Empty(t, failedSecretBinding.GetLabels()[hyperscaler.QuarantinedLabel])
+		assert.Equal(t, string(StateFailed), failedSecretBinding.GetAnnotations()[hyperscaler.CleanupStateAnnotation])
+		assert.Equal(t, "1", failedSecretBinding.GetAnnotations()[hyperscaler.CleanupAttemptsAnnotation])
+		assert.Equal(t, "1 resources remain: resource group rg-1", failedSecretBinding.GetAnnotations()[hyperscaler.CleanupErrorAnnotation])
+	})
+
+	t.Run("should quarantine secret binding after the last failed attempt", func(t *testing.T) {
+		//given
+		secret, secretBinding := fixDirtySecretBinding()
+		secretBinding.SetAnnotations(map[string]string{
+			hyperscaler.CleanupStateAnnotation:    string(StateFailed),
+			hyperscaler.CleanupAttemptsAnnotation: "2",
+		})
+		mockClient := fake.NewSimpleClientset(secret)
+
+		gardenerFake := gardener.NewDynamicFakeClient(secretBinding)
+		mockSecretBindings := gardenerFake.Resource(gardener.SecretBindingResource).Namespace(namespace)
+		mockShoots := gardenerFake.Resource(gardener.ShootResource).Namespace(namespace)
+
+		resCleaner := &azureMockResourceCleaner{error: fmt.Errorf("resource group is locked")}
+		providerFactory := &mocks.ProviderFactory{}
+		providerFactory.On("New", model.Azure, mock.Anything).Return(resCleaner, nil)
+
+		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory, Config{MaxAttempts: 3})
+
+		//when
+		report, err := cleaner.Release()
+		require.NoError(t, err)
+
+		//then
+		assert.Equal(t, "while deleting resources: resource group is locked, quarantined after 3 attempts", report.Items[0].Error)
+		quarantinedSecretBinding, err := mockSecretBindings.Get(context.Background(), secretBinding.GetName(), machineryv1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "true", quarantinedSecretBinding.GetLabels()[hyperscaler.QuarantinedLabel])
+		assert.Equal(t, "3", quarantinedSecretBinding.GetAnnotations()[hyperscaler.CleanupAttemptsAnnotation])
+
+		//when
+		report, err = cleaner.Release()
+
+		//then
+		require.NoError(t, err)
+		assert.Empty(t, report.Items)
+		assert.Equal(t, 1, resCleaner.calls)
+
+		//given
+		quarantinedSecretBinding, err = mockSecretBindings.Get(context.Background(), secretBinding.GetName(), machineryv1.GetOptions{})
+		require.NoError(t, err)
+		labels := quarantinedSecretBinding.GetLabels()
+		delete(labels, hyperscaler.QuarantinedLabel)
+		quarantinedSecretBinding.SetLabels(labels)
+		_, err = mockSecretBindings.Update(context.Background(), quarantinedSecretBinding, machineryv1.UpdateOptions{})
+		require.NoError(t, err)
+
+		//when
+		report, err = cleaner.Release()
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, "while deleting resources: resource group is locked", report.Items[0].Error)
+		assert.Equal(t, 2, resCleaner.calls)
+		releasedSecretBinding, err := mockSecretBindings.Get(context.Background(), secretBinding.GetName(), machineryv1.GetOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, releasedSecretBinding.GetLabels()[hyperscaler.QuarantinedLabel])
+		assert.Equal(t, "1", releasedSecretBinding.GetAnnotations()[hyperscaler.CleanupAttemptsAnnotation])
+	})
+
+	t.Run("should resume the verification of secret binding", func(t *testing.T) {
+		//given
+		secret, secretBinding := fixDirtySecretBinding()
+		secretBinding.SetAnnotations(map[string]string{
+			hyperscaler.CleanupStateAnnotation:    string(StateVerifying),
+			hyperscaler.CleanupAttemptsAnnotation: "1",
+		})
+		mockClient := fake.NewSimpleClientset(secret)
+
+		gardenerFake := gardener.NewDynamicFakeClient(secretBinding)
+		mockSecretBindings := gardenerFake.Resource(gardener.SecretBindingResource).Namespace(namespace)
+		mockShoots := gardenerFake.Resource(gardener.ShootResource).Namespace(namespace)
+
+		resCleaner := &azureMockResourceCleaner{}
+		providerFactory := &mocks.ProviderFactory{}
+		providerFactory.On("New", model.Azure, mock.Anything).Return(resCleaner, nil)
+
+		cleaner := NewCleaner(context.Background(), mockClient, mockSecretBindings, mockShoots, providerFactory, Config{MaxAttempts: 3})
+
+		//when
+		report, err := cleaner.Release()
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, []string{secretBinding.GetName()}, report.Affected())
+		assert.Equal(t, 0, resCleaner.calls)
+
+		cleanedSecretBinding, err := mockSecretBindings.Get(context.Background(), secretBinding.GetName(), machineryv1.GetOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, cleanedSecretBinding.GetLabels()["dirty"])
+		assert.Empty(t, cleanedSecretBinding.GetAnnotations())
+	})
+}
+
+func fixDirtySecretBinding() (*v1.Secret, *unstructured.Unstructured) {
+	secret := &v1.Secret{
+		ObjectMeta: machineryv1.ObjectMeta{
+			Name: "secret1", Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"credentials":    []byte("secret1"),
+			"clientID":       []byte("tenant1"),
+			"clientSecret":   []byte("secret"),
+			"subscriptionID": []byte("12344"),
+			"tenantID":       []byte("tenant1"),
+		},
+	}
+	secretBinding := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      "secretBinding1",
+				"namespace": namespace,
+				"labels": map[string]interface{}{
+					"tenantName":      "tenant1",
+					"hyperscalerType": "azure",
+					"dirty":           "true",
+				},
+			},
+			"secretRef": map[string]interface{}{
+				"name":      "secret1",
+				"namespace": namespace,
+			},
+		},
+	}
+	secretBinding.SetGroupVersionKind(secretBindingGVK)
+	return secret, secretBinding
 }
 
 type azureMockResourceCleaner struct {
-	error error
+	error     error
+	remaining []string
+	calls     int
 }
 
 func (am *azureMockResourceCleaner) Do() error {
+	am.calls++
 	return am.error
 }
+
+func (am *azureMockResourceCleaner) Remaining() ([]string, error) {
+	return am.remaining, nil
+}
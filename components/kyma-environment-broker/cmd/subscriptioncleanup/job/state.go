@@ -0,0 +1,61 @@
+package job
+
+import (
+	"strconv"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// State is the state of the subscription cleanup of a dirty secret binding
+type State string
+
+const (
+	// StateDirty is the secret binding released by the tenant, not processed yet
+	StateDirty State = "dirty"
+	// StateCleaning is set while the resources in the hyperscaler account are deleted
+	StateCleaning State = "cleaning"
+	// StateVerifying is set after the deletion, until no resources remain in the hyperscaler account
+	StateVerifying State = "verifying"
+	// StateFailed is set when the deletion or the verification failed, the next run retries the cleanup
+	StateFailed State = "failed"
+)
+
+func getState(secretBinding unstructured.Unstructured) State {
+	state := State(secretBinding.GetAnnotations()[hyperscaler.CleanupStateAnnotation])
+	switch state {
+	case StateCleaning, StateVerifying, StateFailed:
+		return state
+	default:
+		return StateDirty
+	}
+}
+
+func getAttempts(secretBinding unstructured.Unstructured) int {
+	attempts, err := strconv.Atoi(secretBinding.GetAnnotations()[hyperscaler.CleanupAttemptsAnnotation])
+	if err != nil {
+		return 0
+	}
+	return attempts
+}
+
+func setState(secretBinding *unstructured.Unstructured, state State, attempts int, cause error) {
+	annotations := secretBinding.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[hyperscaler.CleanupStateAnnotation] = string(state)
+	annotations[hyperscaler.CleanupAttemptsAnnotation] = strconv.Itoa(attempts)
+	if cause != nil {
+		annotations[hyperscaler.CleanupErrorAnnotation] = cause.Error()
+	} else {
+		delete(annotations, hyperscaler.CleanupErrorAnnotation)
+	}
+	secretBinding.SetAnnotations(annotations)
+}
+
+func removeState(annotations map[string]string) {
+	delete(annotations, hyperscaler.CleanupStateAnnotation)
+	delete(annotations, hyperscaler.CleanupAttemptsAnnotation)
+	delete(annotations, hyperscaler.CleanupErrorAnnotation)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/cloudprovider"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
@@ -22,6 +23,12 @@ type config struct {
 		KubeconfigPath string `envconfig:"default=/gardener/kubeconfig"`
 		Project        string `envconfig:"default="`
 	}
+	DryRun       bool   `envconfig:"default=false"`
+	MaxAffected  int    `envconfig:"default=0"`
+	MaxAttempts  int    `envconfig:"default=3"`
+	ReportFormat string `envconfig:"default=table"`
+	// KeystoneURL is used for the OpenStack secrets without the authURL
+	KeystoneURL string `envconfig:"optional"`
 }
 
 func main() {
@@ -43,7 +50,13 @@ func main() {
 	shootInterface := gardenerClient.Resource(gardener.ShootResource).Namespace(gardenerNamespace)
 	secretBindingsInterface := gardenerClient.Resource(gardener.SecretBindingResource).Namespace(gardenerNamespace)
 
-	err = job.NewCleaner(context.Background(), kubernetesInterface, secretBindingsInterface, shootInterface, cloudprovider.NewProviderFactory()).Do()
+	cleanerCfg := job.Config{DryRun: cfg.DryRun, MaxAffected: cfg.MaxAffected, MaxAttempts: cfg.MaxAttempts}
+	report, err := job.NewCleaner(context.Background(), kubernetesInterface, secretBindingsInterface, shootInterface, cloudprovider.NewProviderFactory(cfg.KeystoneURL), cleanerCfg).Release()
+	if report != nil {
+		if writeErr := report.Write(os.Stdout, cfg.ReportFormat); writeErr != nil {
+			log.Errorf("while writing cleanup report: %s", writeErr)
+		}
+	}
 	exitOnError(err, "Job execution failed")
 
 	log.Info("Cleanup job finished successfully!")
@@ -2,44 +2,27 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"time"
 
-	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
-	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/trialcleanup"
 	"github.com/kyma-project/control-plane/components/schema-migrator/cleaner"
 	log "github.com/sirupsen/logrus"
 	"github.com/vrischmann/envconfig"
 )
 
-const (
-	trialPlanID = broker.TrialPlanID
-)
-
-type BrokerClient interface {
-	SendExpirationRequest(instance internal.Instance) (bool, error)
-}
-
 type Config struct {
 	Database         storage.Config
 	Broker           broker.ClientConfig
 	DryRun           bool          `envconfig:"default=true"`
 	ExpirationPeriod time.Duration `envconfig:"default=336h"`
+	MaxAffected      int           `envconfig:"default=0"`
+	ReportFormat     string        `envconfig:"default=table"`
 }
 
-type TrialCleanupService struct {
-	cfg             Config
-	filter          dbmodel.InstanceFilter
-	instanceStorage storage.Instances
-	brokerClient    BrokerClient
-}
-
-type instancePredicate func(internal.Instance) bool
-
 func main() {
 	log.SetFormatter(&log.JSONFormatter{})
 	log.Info("Starting trial cleanup job")
@@ -62,9 +45,15 @@ func main() {
 	cipher := storage.NewEncrypter(cfg.Database.SecretKey)
 	db, conn, err := storage.NewFromConfig(cfg.Database, events.Config{}, cipher, log.WithField("service", "storage"))
 	fatalOnError(err)
-	svc := newTrialCleanupService(cfg, brokerClient, db.Instances())
+	svcCfg := trialcleanup.Config{DryRun: cfg.DryRun, ExpirationPeriod: cfg.ExpirationPeriod, MaxAffected: cfg.MaxAffected}
+	svc := trialcleanup.NewService(svcCfg, brokerClient, db.Instances(), log.StandardLogger())
 
-	err = svc.PerformCleanup()
+	report, err := svc.PerformCleanup()
+	if report != nil {
+		if writeErr := report.Write(os.Stdout, cfg.ReportFormat); writeErr != nil {
+			log.Errorf("while writing cleanup report: %s", writeErr)
+		}
+	}
 
 	fatalOnError(err)
 
@@ -80,99 +69,6 @@ func main() {
 	fatalOnError(err)
 }
 
-func newTrialCleanupService(cfg Config, brokerClient BrokerClient, instances storage.Instances) *TrialCleanupService {
-	return &TrialCleanupService{
-		cfg:             cfg,
-		instanceStorage: instances,
-		brokerClient:    brokerClient,
-	}
-}
-
-func (s *TrialCleanupService) PerformCleanup() error {
-
-	nonExpiredTrialInstancesFilter := dbmodel.InstanceFilter{PlanIDs: []string{trialPlanID}, Expired: &[]bool{false}[0]}
-	nonExpiredTrialInstances, nonExpiredTrialInstancesCount, err := s.getInstances(nonExpiredTrialInstancesFilter)
-
-	if err != nil {
-		log.Error(fmt.Sprintf("while getting non-expired trial instances: %s", err))
-		return err
-	}
-
-	instancesToExpire, instancesToExpireCount := s.filterInstances(
-		nonExpiredTrialInstances,
-		func(instance internal.Instance) bool { return time.Since(instance.CreatedAt) >= s.cfg.ExpirationPeriod },
-	)
-
-	instancesToBeLeftCount := nonExpiredTrialInstancesCount - instancesToExpireCount
-
-	if s.cfg.DryRun {
-		s.logInstances(instancesToExpire)
-		log.Infof("Trials non-expired: %+v, to expire now: %+v, to be left non-expired: %+v", nonExpiredTrialInstancesCount, instancesToExpireCount, instancesToBeLeftCount)
-	} else {
-		suspensionsAcceptedCount, onlyMarkedAsExpiredCount, failuresCount := s.cleanupInstances(instancesToExpire)
-		log.Infof("Trials non-expired: %+v, to expire: %+v, left non-expired: %+v, suspension under way: %+v just marked expired: %+v, failures: %+v", nonExpiredTrialInstancesCount, instancesToExpireCount, instancesToBeLeftCount, suspensionsAcceptedCount, onlyMarkedAsExpiredCount, failuresCount)
-	}
-	return nil
-}
-
-func (s *TrialCleanupService) getInstances(filter dbmodel.InstanceFilter) ([]internal.Instance, int, error) {
-
-	instances, _, totalCount, err := s.instanceStorage.List(filter)
-	if err != nil {
-		return []internal.Instance{}, 0, err
-	}
-
-	return instances, totalCount, nil
-}
-
-func (s *TrialCleanupService) filterInstances(instances []internal.Instance, filter instancePredicate) ([]internal.Instance, int) {
-	var filteredInstances []internal.Instance
-	for _, instance := range instances {
-		if filter(instance) {
-			filteredInstances = append(filteredInstances, instance)
-		}
-	}
-	return filteredInstances, len(filteredInstances)
-}
-
-func (s *TrialCleanupService) cleanupInstances(instances []internal.Instance) (int, int, int) {
-	var suspensionAccepted int
-	var onlyExpirationMarked int
-	totalInstances := len(instances)
-	for _, instance := range instances {
-		suspensionUnderWay, err := s.expireInstance(instance)
-		if err != nil {
-			// ignoring errors - only logging
-			log.Error(fmt.Sprintf("while sending expiration request for instanceID: %s, error: %s", instance.InstanceID, err))
-			continue
-		}
-		if suspensionUnderWay {
-			suspensionAccepted += 1
-		} else {
-			onlyExpirationMarked += 1
-		}
-	}
-	failures := totalInstances - suspensionAccepted - onlyExpirationMarked
-	return suspensionAccepted, onlyExpirationMarked, failures
-}
-
-func (s *TrialCleanupService) logInstances(instances []internal.Instance) {
-	for _, instance := range instances {
-		log.Infof("instanceId: %+v createdAt: %+v (%.0f days ago) servicePlanID: %+v servicePlanName: %+v",
-			instance.InstanceID, instance.CreatedAt, time.Since(instance.CreatedAt).Hours()/24, instance.ServicePlanID, instance.ServicePlanName)
-	}
-}
-
-func (s *TrialCleanupService) expireInstance(instance internal.Instance) (processed bool, err error) {
-	log.Infof("About to make instance suspended for instanceId: %+v", instance.InstanceID)
-	suspensionUnderWay, err := s.brokerClient.SendExpirationRequest(instance)
-	if err != nil {
-		log.Error(fmt.Sprintf("while sending expiration request for instance ID %q: %s", instance.InstanceID, err))
-		return suspensionUnderWay, err
-	}
-	return suspensionUnderWay, nil
-}
-
 func fatalOnError(err error) {
 	if err != nil {
 		// temporarily we exit with 0 to avoid any side effects - we ignore all errors only logging those
@@ -0,0 +1,237 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+)
+
+type Action string
+
+const (
+	ActionProvision                  Action = "provision"
+	ActionUpdate                     Action = "update"
+	ActionDeprovision                Action = "deprovision"
+	ActionBind                       Action = "bind"
+	ActionUnbind                     Action = "unbind"
+	ActionCreateKymaOrchestration    Action = "createKymaOrchestration"
+	ActionCreateClusterOrchestration Action = "createClusterOrchestration"
+	ActionCancelOrchestration        Action = "cancelOrchestration"
+	ActionRetryOrchestration         Action = "retryOrchestration"
+	ActionDownloadKubeconfig         Action = "downloadKubeconfig"
+	ActionTriggerJob                 Action = "triggerJob"
+	ActionSetPlanVisibility          Action = "setPlanVisibility"
+	ActionDeletePlanVisibility       Action = "deletePlanVisibility"
+)
+
+type CallerType string
+
+const (
+	// CallerTypeOriginatingIdentity is the platform user sending the OSB request on behalf of the platform
+	CallerTypeOriginatingIdentity CallerType = "originatingIdentity"
+	// CallerTypeToken is the subject of the OIDC token used for the admin APIs
+	CallerTypeToken     CallerType = "token"
+	CallerTypeAnonymous CallerType = "anonymous"
+)
+
+type Outcome string
+
+const (
+	OutcomeSucceeded Outcome = "succeeded"
+	OutcomeFailed    Outcome = "failed"
+)
+
+const (
+	InstanceIDParam      = "instance_id"
+	OrchestrationIDParam = "orchestration_id"
+	CallerParam          = "caller"
+	ActionParam          = "action"
+	OutcomeParam         = "outcome"
+	FromParam            = "from"
+	ToParam              = "to"
+)
+
+// EntryDTO is a single audited call of the KEB API, the parameters hold the request with secret values redacted
+type EntryDTO struct {
+	ID              string     `json:"id"`
+	Caller          string     `json:"caller"`
+	CallerType      CallerType `json:"callerType"`
+	Action          Action     `json:"action"`
+	InstanceID      string     `json:"instanceID,omitempty"`
+	OrchestrationID string     `json:"orchestrationID,omitempty"`
+	Parameters      string     `json:"parameters,omitempty"`
+	StatusCode      int        `json:"statusCode"`
+	Outcome         Outcome    `json:"outcome"`
+	Error           string     `json:"error,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+type EntriesPage struct {
+	Data       []EntryDTO `json:"data"`
+	Count      int        `json:"count"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// ListParameters holds the filters of the audit log query, entries matching all non-empty filters are returned
+type ListParameters struct {
+	Page             int
+	PageSize         int
+	InstanceIDs      []string
+	OrchestrationIDs []string
+	Callers          []string
+	Actions          []Action
+	Outcomes         []Outcome
+	From             time.Time
+	To               time.Time
+}
+
+// Client is the interface to interact with the KEB /audit API as an HTTP client using OIDC ID token in JWT format.
+type Client interface {
+	ListEntries(params ListParameters) (EntriesPage, error)
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient constructs and returns new Client for KEB /audit API
+// It takes the following arguments:
+//   - url        : base url of all KEB APIs, e.g. https://kyma-env-broker.kyma.local
+//   - httpClient : underlying HTTP client used for API call to KEB
+func NewClient(url string, httpClient *http.Client) Client {
+	return &client{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// ListEntries fetches the audit log entries matching the parameters, the newest entries come first
+func (c *client) ListEntries(params ListParameters) (EntriesPage, error) {
+	var page EntriesPage
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/audit", c.url), nil)
+	if err != nil {
+		return page, fmt.Errorf("while creating request: %w", err)
+	}
+	setQuery(req.URL, params)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return page, fmt.Errorf("while calling %s: %w", req.URL.String(), err)
+	}
+
+	// Drain response body and close, return error to context if there isn't any.
+	defer func() {
+		derr := drainResponseBody(resp.Body)
+		if err == nil {
+			err = derr
+		}
+		cerr := resp.Body.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return page, fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&page)
+	if err != nil {
+		return page, fmt.Errorf("while decoding response body: %w", err)
+	}
+	return page, nil
+}
+
+func setQuery(url *url.URL, params ListParameters) {
+	query := url.Query()
+	if params.Page != 0 {
+		query.Add(pagination.PageParam, strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Add(pagination.PageSizeParam, strconv.Itoa(params.PageSize))
+	}
+	setParamsOf(query, InstanceIDParam, params.InstanceIDs)
+	setParamsOf(query, OrchestrationIDParam, params.OrchestrationIDs)
+	setParamsOf(query, CallerParam, params.Callers)
+	for _, action := range params.Actions {
+		query.Add(ActionParam, string(action))
+	}
+	for _, outcome := range params.Outcomes {
+		query.Add(OutcomeParam, string(outcome))
+	}
+	if !params.From.IsZero() {
+		query.Add(FromParam, params.From.Format(time.RFC3339))
+	}
+	if !params.To.IsZero() {
+		query.Add(ToParam, params.To.Format(time.RFC3339))
+	}
+	url.RawQuery = query.Encode()
+}
+
+func setParamsOf(query url.Values, key string, values []string) {
+	for _, value := range values {
+		query.Add(key, value)
+	}
+}
+
+// ParseListParameters reads the filters of the audit log query from the request query parameters
+func ParseListParameters(query url.Values) (ListParameters, error) {
+	params := ListParameters{
+		InstanceIDs:      splitValues(query[InstanceIDParam]),
+		OrchestrationIDs: splitValues(query[OrchestrationIDParam]),
+		Callers:          splitValues(query[CallerParam]),
+	}
+	for _, action := range splitValues(query[ActionParam]) {
+		params.Actions = append(params.Actions, Action(action))
+	}
+	for _, outcome := range splitValues(query[OutcomeParam]) {
+		switch Outcome(outcome) {
+		case OutcomeSucceeded, OutcomeFailed:
+			params.Outcomes = append(params.Outcomes, Outcome(outcome))
+		default:
+			return params, fmt.Errorf("invalid value for %s: %s", OutcomeParam, outcome)
+		}
+	}
+
+	var err error
+	if from := query.Get(FromParam); from != "" {
+		if params.From, err = time.Parse(time.RFC3339, from); err != nil {
+			return params, fmt.Errorf("while parsing %s: %w", FromParam, err)
+		}
+	}
+	if to := query.Get(ToParam); to != "" {
+		if params.To, err = time.Parse(time.RFC3339, to); err != nil {
+			return params, fmt.Errorf("while parsing %s: %w", ToParam, err)
+		}
+	}
+	return params, nil
+}
+
+func splitValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			if v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+func drainResponseBody(body io.Reader) error {
+	if body == nil {
+		return nil
+	}
+	_, err := io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -87,6 +88,15 @@ func (p *secretBindingsAccountPool) MarkSecretBindingAsDirty(hyperscalerType Typ
 	labels := secretBinding.GetLabels()
 	labels["dirty"] = "true"
 	secretBinding.SetLabels(labels)
+	annotations := secretBinding.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	// the secret binding stays dirty since it was marked the first time, e.g. before a failed cleanup attempt
+	if _, marked := annotations[DirtySinceAnnotation]; !marked {
+		annotations[DirtySinceAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	}
+	secretBinding.SetAnnotations(annotations)
 
 	_, err = p.gardenerClient.Resource(gardener.SecretBindingResource).Namespace(p.gardenerNS).Update(context.Background(), &secretBinding.Unstructured, v1.UpdateOptions{})
 	if err != nil {
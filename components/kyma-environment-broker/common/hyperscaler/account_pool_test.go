@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
 	"github.com/stretchr/testify/assert"
@@ -203,6 +204,29 @@ func TestSecretsAccountPool_MarkSecretBindingAsDirty(t *testing.T) {
 				secretBinding, err := gardenerClient.Get(context.Background(), "secretBinding1", machineryv1.GetOptions{})
 				require.NoError(t, err)
 				assert.Equal(t, secretBinding.GetLabels()["dirty"], "true")
+				_, err = time.Parse(time.RFC3339, secretBinding.GetAnnotations()[DirtySinceAnnotation])
+				assert.NoError(t, err)
+			})
+
+			t.Run("should keep the time the secret binding was first marked as dirty", func(t *testing.T) {
+				//given
+				accPool, gardenerClient := newTestAccountPoolWithoutShoots(euAccess)
+				dirtySince := "2023-01-02T03:04:05Z"
+				secretBinding, err := gardenerClient.Get(context.Background(), "secretBinding1", machineryv1.GetOptions{})
+				require.NoError(t, err)
+				secretBinding.SetAnnotations(map[string]string{DirtySinceAnnotation: dirtySince})
+				_, err = gardenerClient.Update(context.Background(), secretBinding, machineryv1.UpdateOptions{})
+				require.NoError(t, err)
+
+				//when
+				err = accPool.MarkSecretBindingAsDirty("azure", "tenant1", euAccess)
+
+				//then
+				require.NoError(t, err)
+				secretBinding, err = gardenerClient.Get(context.Background(), "secretBinding1", machineryv1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, "true", secretBinding.GetLabels()["dirty"])
+				assert.Equal(t, dirtySince, secretBinding.GetAnnotations()[DirtySinceAnnotation])
 			})
 		})
 	}
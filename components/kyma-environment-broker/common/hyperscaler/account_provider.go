@@ -9,7 +9,7 @@ import (
 //go:generate mockery --name=AccountProvider --output=automock --outpkg=automock --case=underscore
 type AccountProvider interface {
 	GardenerSecretName(hyperscalerType Type, tenantName string, euAccess bool) (string, error)
-	GardenerSharedSecretName(hyperscalerType Type, euAccess bool) (string, error)
+	GardenerSharedSecretName(hyperscalerType Type, euAccess bool, region string) (string, error)
 	MarkUnusedGardenerSecretBindingAsDirty(hyperscalerType Type, tenantName string, euAccess bool) error
 }
 
@@ -59,12 +59,12 @@ func (p *accountProvider) GardenerSecretName(hyperscalerType Type, tenantName st
 	return secretBinding.GetSecretRefName(), nil
 }
 
-func (p *accountProvider) GardenerSharedSecretName(hyperscalerType Type, euAccess bool) (string, error) {
+func (p *accountProvider) GardenerSharedSecretName(hyperscalerType Type, euAccess bool, region string) (string, error) {
 	if p.sharedGardenerPool == nil {
 		return "", fmt.Errorf("failed to get shared Secret Binding name. Gardener Shared Account pool is not configured for hyperscaler type %s", hyperscalerType)
 	}
 
-	secretBinding, err := p.sharedGardenerPool.SharedCredentialsSecretBinding(hyperscalerType, euAccess)
+	secretBinding, err := p.sharedGardenerPool.SharedCredentialsSecretBinding(hyperscalerType, euAccess, region)
 	if err != nil {
 		return "", fmt.Errorf("getting shared secret binding: %w", err)
 	}
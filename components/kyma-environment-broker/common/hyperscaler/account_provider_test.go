@@ -92,7 +92,7 @@ func TestGardenerSharedSecretName(t *testing.T) {
 		accountProvider := NewAccountProvider(nil, nil)
 
 		//when
-		_, err := accountProvider.GardenerSharedSecretName(GCP, false)
+		_, err := accountProvider.GardenerSharedSecretName(GCP, false, "")
 		require.Error(t, err)
 
 		//then
@@ -102,12 +102,12 @@ func TestGardenerSharedSecretName(t *testing.T) {
 	t.Run("should return correct shared secret name", func(t *testing.T) {
 		//given
 		gardenerFake := gardener.NewDynamicFakeClient(newSecretBinding("secretBinding1", "secret1", "azure", true, false))
-		sharedAccountPool := NewSharedGardenerAccountPool(gardenerFake, testNamespace)
+		sharedAccountPool := NewSharedGardenerAccountPool(gardenerFake, testNamespace, SharedPoolConfig{})
 
 		accountProvider := NewAccountProvider(nil, sharedAccountPool)
 
 		//when
-		secretName, err := accountProvider.GardenerSharedSecretName(Azure, false)
+		secretName, err := accountProvider.GardenerSharedSecretName(Azure, false, "")
 
 		//then
 		require.NoError(t, err)
@@ -134,12 +134,12 @@ func TestGardenerSharedSecretName(t *testing.T) {
 		}
 		sb.SetGroupVersionKind(secretBindingGVK)
 		gardenerFake := gardener.NewDynamicFakeClient(sb)
-		sharedAccountPool := NewSharedGardenerAccountPool(gardenerFake, testNamespace)
+		sharedAccountPool := NewSharedGardenerAccountPool(gardenerFake, testNamespace, SharedPoolConfig{})
 
 		accountProvider := NewAccountProvider(nil, sharedAccountPool)
 
 		//when
-		secretName, err := accountProvider.GardenerSharedSecretName(Azure, false)
+		secretName, err := accountProvider.GardenerSharedSecretName(Azure, false, "")
 
 		//then
 		require.NoError(t, err)
@@ -149,12 +149,12 @@ func TestGardenerSharedSecretName(t *testing.T) {
 	t.Run("should return error when failed to find secret binding", func(t *testing.T) {
 		//given
 		gardenerFake := gardener.NewDynamicFakeClient()
-		sharedAccountPool := NewSharedGardenerAccountPool(gardenerFake, testNamespace)
+		sharedAccountPool := NewSharedGardenerAccountPool(gardenerFake, testNamespace, SharedPoolConfig{})
 
 		accountProvider := NewAccountProvider(nil, sharedAccountPool)
 
 		//when
-		_, err := accountProvider.GardenerSharedSecretName(Azure, false)
+		_, err := accountProvider.GardenerSharedSecretName(Azure, false, "")
 
 		//then
 		require.Error(t, err)
@@ -33,20 +33,20 @@ func (_m *AccountProvider) GardenerSecretName(hyperscalerType hyperscaler.Type,
 	return r0, r1
 }
 
-// GardenerSharedSecretName provides a mock function with given fields: hyperscalerType, euAccess
-func (_m *AccountProvider) GardenerSharedSecretName(hyperscalerType hyperscaler.Type, euAccess bool) (string, error) {
-	ret := _m.Called(hyperscalerType, euAccess)
+// GardenerSharedSecretName provides a mock function with given fields: hyperscalerType, euAccess, region
+func (_m *AccountProvider) GardenerSharedSecretName(hyperscalerType hyperscaler.Type, euAccess bool, region string) (string, error) {
+	ret := _m.Called(hyperscalerType, euAccess, region)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(hyperscaler.Type, bool) string); ok {
-		r0 = rf(hyperscalerType, euAccess)
+	if rf, ok := ret.Get(0).(func(hyperscaler.Type, bool, string) string); ok {
+		r0 = rf(hyperscalerType, euAccess, region)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(hyperscaler.Type, bool) error); ok {
-		r1 = rf(hyperscalerType, euAccess)
+	if rf, ok := ret.Get(1).(func(hyperscaler.Type, bool, string) error); ok {
+		r1 = rf(hyperscalerType, euAccess, region)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,223 @@
+package hyperscaler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+)
+
+// DirtySinceAnnotation holds the time the secret binding was marked as dirty, in the RFC 3339 format
+const DirtySinceAnnotation = "kcp.kyma-project.io/dirty-since"
+
+const (
+	// CleanupStateAnnotation holds the state of the subscription cleanup of the dirty secret binding
+	CleanupStateAnnotation = "kcp.kyma-project.io/cleanup-state"
+	// CleanupAttemptsAnnotation holds the number of the subscription cleanup attempts of the dirty secret binding
+	CleanupAttemptsAnnotation = "kcp.kyma-project.io/cleanup-attempts"
+	// CleanupErrorAnnotation holds the error of the last failed subscription cleanup attempt
+	CleanupErrorAnnotation = "kcp.kyma-project.io/cleanup-error"
+	// QuarantinedLabel marks the dirty secret binding which failed the subscription cleanup too many times,
+	// the cleanup skips it until an operator removes the label, which resets the number of the cleanup attempts
+	QuarantinedLabel = "quarantined"
+)
+
+type AccountState string
+
+const (
+	// AccountFree is not assigned to any tenant and can be assigned with the next provisioning
+	AccountFree AccountState = "free"
+	// AccountAssigned is used by a tenant
+	AccountAssigned AccountState = "assigned"
+	// AccountDirty was released by the tenant and waits for the subscription cleanup
+	AccountDirty AccountState = "dirty"
+	// AccountQuarantined failed the subscription cleanup too many times and waits for an operator
+	AccountQuarantined AccountState = "quarantined"
+	// AccountShared is used by many tenants, e.g. for trials
+	AccountShared AccountState = "shared"
+)
+
+type AccountDTO struct {
+	Name            string       `json:"name"`
+	HyperscalerType Type         `json:"hyperscalerType"`
+	EUAccess        bool         `json:"euAccess"`
+	Internal        bool         `json:"internal"`
+	State           AccountState `json:"state"`
+	TenantName      string       `json:"tenantName,omitempty"`
+	// Shoots is the number of shoots using the secret binding
+	Shoots     int        `json:"shoots"`
+	DirtySince *time.Time `json:"dirtySince,omitempty"`
+}
+
+// PoolDTO summarizes the secret bindings of one hyperscaler type and EU access flag
+type PoolDTO struct {
+	HyperscalerType  Type       `json:"hyperscalerType"`
+	EUAccess         bool       `json:"euAccess"`
+	Total            int        `json:"total"`
+	Free             int        `json:"free"`
+	Assigned         int        `json:"assigned"`
+	Dirty            int        `json:"dirty"`
+	Quarantined      int        `json:"quarantined"`
+	Shared           int        `json:"shared"`
+	OldestDirtySince *time.Time `json:"oldestDirtySince,omitempty"`
+	// BelowLowWatermark is set if the pool of the non-shared secret bindings has fewer free bindings than the low watermark
+	BelowLowWatermark bool `json:"belowLowWatermark"`
+}
+
+type InventoryDTO struct {
+	LowWatermark int          `json:"lowWatermark"`
+	Pools        []PoolDTO    `json:"pools"`
+	Accounts     []AccountDTO `json:"accounts"`
+}
+
+type InventoryConfig struct {
+	// LowWatermark is the number of free secret bindings below which the pool must be refilled
+	LowWatermark int           `envconfig:"default=5"`
+	CacheTTL     time.Duration `envconfig:"default=1m"`
+}
+
+// Inventory lists the secret bindings of the hyperscaler account pools, the result is cached for the configured time
+type Inventory struct {
+	gardenerClient dynamic.Interface
+	namespace      string
+	cfg            InventoryConfig
+
+	mu        sync.Mutex
+	cached    InventoryDTO
+	fetchedAt time.Time
+}
+
+func NewInventory(gardenerClient dynamic.Interface, gardenerNamespace string, cfg InventoryConfig) *Inventory {
+	return &Inventory{
+		gardenerClient: gardenerClient,
+		namespace:      gardenerNamespace,
+		cfg:            cfg,
+	}
+}
+
+func (i *Inventory) Get() (InventoryDTO, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !i.fetchedAt.IsZero() && time.Since(i.fetchedAt) < i.cfg.CacheTTL {
+		return i.cached, nil
+	}
+
+	inventory, err := i.fetch()
+	if err != nil {
+		return InventoryDTO{}, err
+	}
+	i.cached = inventory
+	i.fetchedAt = time.Now()
+	return inventory, nil
+}
+
+func (i *Inventory) fetch() (InventoryDTO, error) {
+	secretBindings, err := i.gardenerClient.Resource(gardener.SecretBindingResource).Namespace(i.namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: "hyperscalerType",
+	})
+	if err != nil {
+		return InventoryDTO{}, fmt.Errorf("while listing secret bindings: %w", err)
+	}
+	shoots, err := i.gardenerClient.Resource(gardener.ShootResource).Namespace(i.namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return InventoryDTO{}, fmt.Errorf("while listing shoots: %w", err)
+	}
+
+	usage := map[string]int{}
+	for _, item := range shoots.Items {
+		shoot := gardener.Shoot{Unstructured: item}
+		usage[shoot.GetSpecSecretBindingName()]++
+	}
+
+	inventory := InventoryDTO{
+		LowWatermark: i.cfg.LowWatermark,
+		Pools:        []PoolDTO{},
+		Accounts:     []AccountDTO{},
+	}
+	for _, secretBinding := range secretBindings.Items {
+		labels := secretBinding.GetLabels()
+		account := AccountDTO{
+			Name:            secretBinding.GetName(),
+			HyperscalerType: Type(labels["hyperscalerType"]),
+			EUAccess:        labels["euAccess"] == "true",
+			Internal:        labels["internal"] == "true",
+			TenantName:      labels["tenantName"],
+			Shoots:          usage[secretBinding.GetName()],
+		}
+		switch {
+		case labels["shared"] == "true":
+			account.State = AccountShared
+		case labels["dirty"] == "true":
+			account.State = AccountDirty
+			if labels[QuarantinedLabel] == "true" {
+				account.State = AccountQuarantined
+			}
+			if since, err := time.Parse(time.RFC3339, secretBinding.GetAnnotations()[DirtySinceAnnotation]); err == nil {
+				account.DirtySince = &since
+			}
+		case account.TenantName != "":
+			account.State = AccountAssigned
+		default:
+			account.State = AccountFree
+		}
+		inventory.Accounts = append(inventory.Accounts, account)
+	}
+	sort.Slice(inventory.Accounts, func(a, b int) bool {
+		return inventory.Accounts[a].Name < inventory.Accounts[b].Name
+	})
+	inventory.Pools = summarize(inventory.Accounts, i.cfg.LowWatermark)
+
+	return inventory, nil
+}
+
+func summarize(accounts []AccountDTO, lowWatermark int) []PoolDTO {
+	type poolKey struct {
+		hyperscalerType Type
+		euAccess        bool
+	}
+	pools := map[poolKey]*PoolDTO{}
+	for _, account := range accounts {
+		key := poolKey{account.HyperscalerType, account.EUAccess}
+		pool, found := pools[key]
+		if !found {
+			pool = &PoolDTO{HyperscalerType: account.HyperscalerType, EUAccess: account.EUAccess}
+			pools[key] = pool
+		}
+		pool.Total++
+		switch account.State {
+		case AccountFree:
+			pool.Free++
+		case AccountAssigned:
+			pool.Assigned++
+		case AccountDirty:
+			pool.Dirty++
+			if account.DirtySince != nil && (pool.OldestDirtySince == nil || account.DirtySince.Before(*pool.OldestDirtySince)) {
+				pool.OldestDirtySince = account.DirtySince
+			}
+		case AccountQuarantined:
+			pool.Quarantined++
+		case AccountShared:
+			pool.Shared++
+		}
+	}
+
+	result := make([]PoolDTO, 0, len(pools))
+	for _, pool := range pools {
+		// the pools of the shared secret bindings, e.g. for trials, are never used up
+		pool.BelowLowWatermark = pool.Total > pool.Shared && pool.Free < lowWatermark
+		result = append(result, *pool)
+	}
+	sort.Slice(result, func(a, b int) bool {
+		if result[a].HyperscalerType != result[b].HyperscalerType {
+			return result[a].HyperscalerType < result[b].HyperscalerType
+		}
+		return !result[a].EUAccess && result[b].EUAccess
+	})
+	return result
+}
@@ -0,0 +1,90 @@
+package hyperscaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestInventory_Get(t *testing.T) {
+	// given
+	dirtySince := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	gardenerClient := gardener.NewDynamicFakeClient(
+		newSecretBinding("aws-free-1", "s1", "aws", false, false),
+		withLabels(newSecretBinding("aws-assigned", "s2", "aws", false, false), map[string]string{"tenantName": "tenant1"}),
+		withDirtySince(withLabels(newSecretBinding("aws-dirty", "s3", "aws", false, false), map[string]string{"tenantName": "tenant2", "dirty": "true"}), dirtySince),
+		newSecretBinding("aws-eu-free", "s4", "aws", false, true),
+		newSecretBinding("gcp-shared", "s5", "gcp", true, false),
+		newShoot("shoot1", "aws-assigned"),
+		newShoot("shoot2", "gcp-shared"),
+		newShoot("shoot3", "gcp-shared"),
+	)
+	inventory := NewInventory(gardenerClient, testNamespace, InventoryConfig{LowWatermark: 1, CacheTTL: time.Minute})
+
+	// when
+	result, err := inventory.Get()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.LowWatermark)
+	assert.Equal(t, []AccountDTO{
+		{Name: "aws-assigned", HyperscalerType: AWS, State: AccountAssigned, TenantName: "tenant1", Shoots: 1},
+		{Name: "aws-dirty", HyperscalerType: AWS, State: AccountDirty, TenantName: "tenant2", DirtySince: &dirtySince},
+		{Name: "aws-eu-free", HyperscalerType: AWS, EUAccess: true, State: AccountFree},
+		{Name: "aws-free-1", HyperscalerType: AWS, State: AccountFree},
+		{Name: "gcp-shared", HyperscalerType: GCP, State: AccountShared, Shoots: 2},
+	}, result.Accounts)
+	assert.Equal(t, []PoolDTO{
+		{HyperscalerType: AWS, Total: 3, Free: 1, Assigned: 1, Dirty: 1, OldestDirtySince: &dirtySince},
+		{HyperscalerType: AWS, EUAccess: true, Total: 1, Free: 1},
+		{HyperscalerType: GCP, Total: 1, Shared: 1},
+	}, result.Pools)
+
+	t.Run("should report the pool below the low watermark", func(t *testing.T) {
+		// given
+		inventory := NewInventory(gardenerClient, testNamespace, InventoryConfig{LowWatermark: 2})
+
+		// when
+		result, err := inventory.Get()
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, result.Pools[0].BelowLowWatermark)
+		assert.True(t, result.Pools[1].BelowLowWatermark)
+		assert.False(t, result.Pools[2].BelowLowWatermark, "the shared pool is never used up")
+	})
+
+	t.Run("should report quarantined secret bindings", func(t *testing.T) {
+		// given
+		gardenerClient := gardener.NewDynamicFakeClient(
+			withLabels(newSecretBinding("aws-quarantined", "s1", "aws", false, false), map[string]string{"tenantName": "tenant1", "dirty": "true", QuarantinedLabel: "true"}),
+		)
+		inventory := NewInventory(gardenerClient, testNamespace, InventoryConfig{})
+
+		// when
+		result, err := inventory.Get()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, AccountQuarantined, result.Accounts[0].State)
+		assert.Equal(t, []PoolDTO{{HyperscalerType: AWS, Total: 1, Quarantined: 1}}, result.Pools)
+	})
+}
+
+func withLabels(secretBinding *unstructured.Unstructured, labels map[string]string) *unstructured.Unstructured {
+	current := secretBinding.GetLabels()
+	for k, v := range labels {
+		current[k] = v
+	}
+	secretBinding.SetLabels(current)
+	return secretBinding
+}
+
+func withDirtySince(secretBinding *unstructured.Unstructured, since time.Time) *unstructured.Unstructured {
+	secretBinding.SetAnnotations(map[string]string{DirtySinceAnnotation: since.Format(time.RFC3339)})
+	return secretBinding
+}
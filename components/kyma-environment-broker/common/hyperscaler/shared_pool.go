@@ -3,6 +3,8 @@ package hyperscaler
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,30 +12,172 @@ import (
 	"k8s.io/client-go/dynamic"
 )
 
+const (
+	// WeightLabel sets the share of the new clusters assigned to the shared secret binding, defaults to 1,
+	// the value 0 stops assigning new clusters to the secret binding
+	WeightLabel = "weight"
+	// MaxShootsLabel is the number of shoots the subscription of the shared secret binding can hold
+	MaxShootsLabel = "maxShoots"
+	// MaxShootsPerRegionLabel is the number of shoots the subscription of the shared secret binding can hold in one region
+	MaxShootsPerRegionLabel = "maxShootsPerRegion"
+)
+
 type SharedPool interface {
-	SharedCredentialsSecretBinding(hyperscalerType Type, euAccess bool) (*gardener.SecretBinding, error)
+	SharedCredentialsSecretBinding(hyperscalerType Type, euAccess bool, region string) (*gardener.SecretBinding, error)
+	Report(hyperscalerType Type) (SharedPoolReport, error)
+}
+
+type SharedPoolConfig struct {
+	// NearLimitRatio is the part of the subscription limits at which the shared secret binding stops getting new clusters
+	NearLimitRatio float64 `envconfig:"default=0.9"`
+}
+
+// SharedAccountDTO describes the usage of a shared secret binding
+type SharedAccountDTO struct {
+	Name               string         `json:"name"`
+	HyperscalerType    Type           `json:"hyperscalerType"`
+	Weight             int            `json:"weight"`
+	MaxShoots          int            `json:"maxShoots,omitempty"`
+	MaxShootsPerRegion int            `json:"maxShootsPerRegion,omitempty"`
+	Shoots             int            `json:"shoots"`
+	ShootsPerRegion    map[string]int `json:"shootsPerRegion"`
+	// TargetShoots is the number of shoots the secret binding would have if all shoots of the hyperscaler type were spread by the weights
+	TargetShoots int `json:"targetShoots"`
+	// NearLimit is set if the secret binding gets no new clusters because of the MaxShoots limit
+	NearLimit bool `json:"nearLimit"`
+	// NearLimitRegions lists the regions in which the secret binding gets no new clusters because of the MaxShootsPerRegion limit
+	NearLimitRegions []string `json:"nearLimitRegions,omitempty"`
+	// Error is set if the labels of the secret binding are invalid, such secret binding gets no new clusters
+	Error string `json:"error,omitempty"`
+}
+
+// SharedPoolReport shows how the shoots are spread over the shared secret bindings compared to their weights and limits
+type SharedPoolReport struct {
+	NearLimitRatio float64            `json:"nearLimitRatio"`
+	Accounts       []SharedAccountDTO `json:"accounts"`
 }
 
-func NewSharedGardenerAccountPool(gardenerClient dynamic.Interface, gardenerNamespace string) SharedPool {
+func NewSharedGardenerAccountPool(gardenerClient dynamic.Interface, gardenerNamespace string, cfg SharedPoolConfig) SharedPool {
 	return &sharedAccountPool{
 		gardenerClient: gardenerClient,
 		namespace:      gardenerNamespace,
+		cfg:            cfg,
 	}
 }
 
 type sharedAccountPool struct {
 	gardenerClient dynamic.Interface
 	namespace      string
+	cfg            SharedPoolConfig
 }
 
-func (sp *sharedAccountPool) SharedCredentialsSecretBinding(hyperscalerType Type, euAccess bool) (*gardener.SecretBinding, error) {
+type sharedAccount struct {
+	secretBinding      unstructured.Unstructured
+	weight             int
+	maxShoots          int
+	maxShootsPerRegion int
+	shoots             int
+	shootsPerRegion    map[string]int
+	err                error
+}
+
+// SharedCredentialsSecretBinding returns the shared secret binding with the lowest number of shoots per weight, skipping
+// the secret bindings near the limits of their subscriptions, in total or in the given region
+func (sp *sharedAccountPool) SharedCredentialsSecretBinding(hyperscalerType Type, euAccess bool, region string) (*gardener.SecretBinding, error) {
 	labelSelector := fmt.Sprintf("shared=true,hyperscalerType=%s", hyperscalerType)
 	secretBindings, err := sp.getSecretBindings(labelSelector)
 	if err != nil {
 		return nil, fmt.Errorf("getting secret binding: %w", err)
 	}
+	if len(secretBindings) == 0 {
+		return nil, fmt.Errorf("sharedAccountPool error: no shared secret binding found for %s label selector, "+
+			"namespace %s", labelSelector, sp.namespace)
+	}
+
+	accounts, err := sp.getUsage(secretBindings)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected *sharedAccount
+	for i := range accounts {
+		account := &accounts[i]
+		if !sp.isEligible(account, region) {
+			continue
+		}
+		// compares shoots/weight without rounding, the first secret binding wins a tie
+		if selected == nil || account.shoots*selected.weight < selected.shoots*account.weight {
+			selected = account
+		}
+	}
+	if selected == nil {
+		return nil, fmt.Errorf("sharedAccountPool error: all shared secret bindings for %s label selector are near their limits, "+
+			"namespace %s, region %s", labelSelector, sp.namespace, region)
+	}
 
-	return sp.getLeastUsed(secretBindings)
+	return &gardener.SecretBinding{Unstructured: selected.secretBinding}, nil
+}
+
+// Report returns the usage of the shared secret bindings of the hyperscaler type, or of all types if the type is empty
+func (sp *sharedAccountPool) Report(hyperscalerType Type) (SharedPoolReport, error) {
+	labelSelector := "shared=true,hyperscalerType"
+	if hyperscalerType != "" {
+		labelSelector = fmt.Sprintf("shared=true,hyperscalerType=%s", hyperscalerType)
+	}
+	secretBindings, err := sp.getSecretBindings(labelSelector)
+	if err != nil {
+		return SharedPoolReport{}, fmt.Errorf("getting secret binding: %w", err)
+	}
+	accounts, err := sp.getUsage(secretBindings)
+	if err != nil {
+		return SharedPoolReport{}, err
+	}
+
+	shootsPerType := map[Type]int{}
+	weightPerType := map[Type]int{}
+	for _, account := range accounts {
+		accountType := Type(account.secretBinding.GetLabels()["hyperscalerType"])
+		shootsPerType[accountType] += account.shoots
+		if account.err == nil {
+			weightPerType[accountType] += account.weight
+		}
+	}
+
+	report := SharedPoolReport{
+		NearLimitRatio: sp.cfg.NearLimitRatio,
+		Accounts:       make([]SharedAccountDTO, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		dto := SharedAccountDTO{
+			Name:               account.secretBinding.GetName(),
+			HyperscalerType:    Type(account.secretBinding.GetLabels()["hyperscalerType"]),
+			Weight:             account.weight,
+			MaxShoots:          account.maxShoots,
+			MaxShootsPerRegion: account.maxShootsPerRegion,
+			Shoots:             account.shoots,
+			ShootsPerRegion:    account.shootsPerRegion,
+		}
+		if account.err != nil {
+			dto.Error = account.err.Error()
+		} else {
+			if totalWeight := weightPerType[dto.HyperscalerType]; totalWeight > 0 {
+				dto.TargetShoots = shootsPerType[dto.HyperscalerType] * account.weight / totalWeight
+			}
+			dto.NearLimit = sp.isNearLimit(account.shoots, account.maxShoots)
+			for region, shoots := range account.shootsPerRegion {
+				if sp.isNearLimit(shoots, account.maxShootsPerRegion) {
+					dto.NearLimitRegions = append(dto.NearLimitRegions, region)
+				}
+			}
+			sort.Strings(dto.NearLimitRegions)
+		}
+		report.Accounts = append(report.Accounts, dto)
+	}
+	sort.Slice(report.Accounts, func(a, b int) bool {
+		return report.Accounts[a].Name < report.Accounts[b].Name
+	})
+
+	return report, nil
 }
 
 func (sp *sharedAccountPool) getSecretBindings(labelSelector string) ([]unstructured.Unstructured, error) {
@@ -44,48 +188,88 @@ func (sp *sharedAccountPool) getSecretBindings(labelSelector string) ([]unstruct
 		return nil, fmt.Errorf("error listing secret bindings for %s label selector: %w", labelSelector, err)
 	}
 
-	if secretBindings == nil || len(secretBindings.Items) == 0 {
-		return nil, fmt.Errorf("sharedAccountPool error: no shared secret binding found for %s label selector, "+
-			"namespace %s", labelSelector, sp.namespace)
+	if secretBindings == nil {
+		return nil, nil
 	}
 
 	return secretBindings.Items, nil
 }
 
-func (sp *sharedAccountPool) getLeastUsed(secretBindings []unstructured.Unstructured) (*gardener.SecretBinding, error) {
-	usageCount := make(map[string]int, len(secretBindings))
-	for _, s := range secretBindings {
-		usageCount[s.GetName()] = 0
-	}
-
+// getUsage counts the shoots of the secret bindings in total and per region
+func (sp *sharedAccountPool) getUsage(secretBindings []unstructured.Unstructured) ([]sharedAccount, error) {
 	shoots, err := sp.gardenerClient.Resource(gardener.ShootResource).Namespace(sp.namespace).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("error while listing Shoots: %w", err)
 	}
 
-	if shoots == nil || len(shoots.Items) == 0 {
-		return &gardener.SecretBinding{secretBindings[0]}, nil
+	accounts := make([]sharedAccount, 0, len(secretBindings))
+	indexes := make(map[string]int, len(secretBindings))
+	for _, secretBinding := range secretBindings {
+		account := sharedAccount{
+			secretBinding:   secretBinding,
+			shootsPerRegion: map[string]int{},
+		}
+		account.weight, account.maxShoots, account.maxShootsPerRegion, account.err = parseSharedLabels(secretBinding.GetLabels())
+		indexes[secretBinding.GetName()] = len(accounts)
+		accounts = append(accounts, account)
 	}
 
-	for _, shoot := range shoots.Items {
-		s := gardener.Shoot{shoot}
-		count, found := usageCount[s.GetSpecSecretBindingName()]
+	for _, item := range shoots.Items {
+		shoot := gardener.Shoot{Unstructured: item}
+		index, found := indexes[shoot.GetSpecSecretBindingName()]
 		if !found {
 			continue
 		}
+		accounts[index].shoots++
+		if region := shoot.GetSpecRegion(); region != "" {
+			accounts[index].shootsPerRegion[region]++
+		}
+	}
+
+	return accounts, nil
+}
 
-		usageCount[s.GetSpecSecretBindingName()] = count + 1
+func (sp *sharedAccountPool) isEligible(account *sharedAccount, region string) bool {
+	if account.err != nil || account.weight == 0 {
+		return false
 	}
+	if sp.isNearLimit(account.shoots, account.maxShoots) {
+		return false
+	}
+	if region != "" && sp.isNearLimit(account.shootsPerRegion[region], account.maxShootsPerRegion) {
+		return false
+	}
+	return true
+}
 
-	min := usageCount[secretBindings[0].GetName()]
-	minIndex := 0
+// isNearLimit checks if the shoots reached the configured part of the limit, zero means no limit
+func (sp *sharedAccountPool) isNearLimit(shoots, limit int) bool {
+	if limit == 0 {
+		return false
+	}
+	ratio := sp.cfg.NearLimitRatio
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+	return float64(shoots) >= ratio*float64(limit)
+}
 
-	for i, sb := range secretBindings {
-		if usageCount[sb.GetName()] < min {
-			min = usageCount[sb.GetName()]
-			minIndex = i
+func parseSharedLabels(labels map[string]string) (weight, maxShoots, maxShootsPerRegion int, err error) {
+	weight = 1
+	for label, value := range map[string]*int{
+		WeightLabel:             &weight,
+		MaxShootsLabel:          &maxShoots,
+		MaxShootsPerRegionLabel: &maxShootsPerRegion,
+	} {
+		raw, found := labels[label]
+		if !found {
+			continue
+		}
+		parsed, parseErr := strconv.Atoi(raw)
+		if parseErr != nil || parsed < 0 {
+			return 0, 0, 0, fmt.Errorf("invalid value of the %s label: %q", label, raw)
 		}
+		*value = parsed
 	}
-
-	return &gardener.SecretBinding{secretBindings[minIndex]}, nil
+	return weight, maxShoots, maxShootsPerRegion, nil
 }
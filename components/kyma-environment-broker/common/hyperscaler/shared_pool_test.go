@@ -1,6 +1,7 @@
 package hyperscaler
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
@@ -103,10 +104,10 @@ func TestSharedPool_SharedCredentialsSecretBinding(t *testing.T) {
 			t.Run(testCase.description, func(t *testing.T) {
 				// given
 				gardenerFake := gardener.NewDynamicFakeClient(append(testCase.shoots, testCase.secretBindings...)...)
-				pool := NewSharedGardenerAccountPool(gardenerFake, testNamespace)
+				pool := NewSharedGardenerAccountPool(gardenerFake, testNamespace, SharedPoolConfig{})
 
 				// when
-				secretBinding, err := pool.SharedCredentialsSecretBinding(testCase.hyperscaler, euAccess)
+				secretBinding, err := pool.SharedCredentialsSecretBinding(testCase.hyperscaler, euAccess, "")
 				require.NoError(t, err)
 
 				// then
@@ -123,10 +124,10 @@ func TestSharedPool_SharedCredentialsSecretBinding_Errors(t *testing.T) {
 			newSecretBinding("sb1", "s1", "azure", true, false),
 			newSecretBinding("sb2", "s2", "gcp", false, false),
 		)
-		pool := NewSharedGardenerAccountPool(gardenerFake, testNamespace)
+		pool := NewSharedGardenerAccountPool(gardenerFake, testNamespace, SharedPoolConfig{})
 
 		// when
-		_, err := pool.SharedCredentialsSecretBinding("gcp", false)
+		_, err := pool.SharedCredentialsSecretBinding("gcp", false, "")
 
 		// then
 		require.Error(t, err)
@@ -134,6 +135,147 @@ func TestSharedPool_SharedCredentialsSecretBinding_Errors(t *testing.T) {
 	})
 }
 
+func TestSharedPool_SharedCredentialsSecretBinding_WeightsAndLimits(t *testing.T) {
+	for _, testCase := range []struct {
+		description    string
+		secretBindings []runtime.Object
+		shoots         []runtime.Object
+		region         string
+		expectedSecret string
+	}{
+		{
+			description: "should get Secret Binding with the lowest number of shoots per weight",
+			secretBindings: []runtime.Object{
+				withLabels(newSecretBinding("sb1", "s1", "azure", true, false), map[string]string{WeightLabel: "3"}),
+				newSecretBinding("sb2", "s2", "azure", true, false),
+			},
+			shoots: []runtime.Object{
+				newShoot("sh1", "sb1"),
+				newShoot("sh2", "sb1"),
+				newShoot("sh3", "sb2"),
+			},
+			expectedSecret: "s1",
+		},
+		{
+			description: "should skip Secret Binding with zero weight",
+			secretBindings: []runtime.Object{
+				withLabels(newSecretBinding("sb1", "s1", "azure", true, false), map[string]string{WeightLabel: "0"}),
+				newSecretBinding("sb2", "s2", "azure", true, false),
+			},
+			shoots: []runtime.Object{
+				newShoot("sh1", "sb2"),
+			},
+			expectedSecret: "s2",
+		},
+		{
+			description: "should skip Secret Binding near the shoots limit",
+			secretBindings: []runtime.Object{
+				withLabels(newSecretBinding("sb1", "s1", "azure", true, false), map[string]string{MaxShootsLabel: "10"}),
+				newSecretBinding("sb2", "s2", "azure", true, false),
+			},
+			shoots:         append(newShoots("sb1", "westeurope", 9), newShoots("sb2", "westeurope", 20)...),
+			expectedSecret: "s2",
+		},
+		{
+			description: "should skip Secret Binding near the shoots limit in the region",
+			secretBindings: []runtime.Object{
+				withLabels(newSecretBinding("sb1", "s1", "azure", true, false), map[string]string{MaxShootsPerRegionLabel: "5"}),
+				newSecretBinding("sb2", "s2", "azure", true, false),
+			},
+			shoots:         append(newShoots("sb1", "westeurope", 5), newShoots("sb2", "eastus", 10)...),
+			region:         "westeurope",
+			expectedSecret: "s2",
+		},
+		{
+			description: "should use Secret Binding near the shoots limit in another region",
+			secretBindings: []runtime.Object{
+				withLabels(newSecretBinding("sb1", "s1", "azure", true, false), map[string]string{MaxShootsPerRegionLabel: "5"}),
+				newSecretBinding("sb2", "s2", "azure", true, false),
+			},
+			shoots:         append(newShoots("sb1", "westeurope", 5), newShoots("sb2", "eastus", 10)...),
+			region:         "eastus",
+			expectedSecret: "s1",
+		},
+		{
+			description: "should skip Secret Binding with invalid labels",
+			secretBindings: []runtime.Object{
+				withLabels(newSecretBinding("sb1", "s1", "azure", true, false), map[string]string{WeightLabel: "heavy"}),
+				newSecretBinding("sb2", "s2", "azure", true, false),
+			},
+			shoots: []runtime.Object{
+				newShoot("sh1", "sb2"),
+			},
+			expectedSecret: "s2",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			gardenerFake := gardener.NewDynamicFakeClient(append(testCase.shoots, testCase.secretBindings...)...)
+			pool := NewSharedGardenerAccountPool(gardenerFake, testNamespace, SharedPoolConfig{NearLimitRatio: 0.9})
+
+			// when
+			secretBinding, err := pool.SharedCredentialsSecretBinding(Azure, false, testCase.region)
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, testCase.expectedSecret, secretBinding.GetSecretRefName())
+		})
+	}
+
+	t.Run("should return error when all Secret Bindings are near the limits", func(t *testing.T) {
+		// given
+		gardenerFake := gardener.NewDynamicFakeClient(append(newShoots("sb1", "westeurope", 1),
+			withLabels(newSecretBinding("sb1", "s1", "azure", true, false), map[string]string{MaxShootsLabel: "1"}),
+		)...)
+		pool := NewSharedGardenerAccountPool(gardenerFake, testNamespace, SharedPoolConfig{NearLimitRatio: 0.9})
+
+		// when
+		_, err := pool.SharedCredentialsSecretBinding(Azure, false, "westeurope")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "are near their limits")
+	})
+}
+
+func TestSharedPool_Report(t *testing.T) {
+	// given
+	gardenerFake := gardener.NewDynamicFakeClient(append(append(append(
+		newShoots("sb1", "westeurope", 9),
+		newShoots("sb2", "eastus", 3)...),
+		newShoots("sb3", "us-east-1", 2)...),
+		withLabels(newSecretBinding("sb1", "s1", "azure", true, false), map[string]string{WeightLabel: "2", MaxShootsPerRegionLabel: "10"}),
+		newSecretBinding("sb2", "s2", "azure", true, false),
+		withLabels(newSecretBinding("sb3", "s3", "aws", true, false), map[string]string{MaxShootsLabel: "2"}),
+		withLabels(newSecretBinding("sb4", "s4", "aws", true, false), map[string]string{MaxShootsLabel: "many"}),
+		newSecretBinding("sb5", "s5", "aws", false, false),
+	)...)
+	pool := NewSharedGardenerAccountPool(gardenerFake, testNamespace, SharedPoolConfig{NearLimitRatio: 0.9})
+
+	// when
+	report, err := pool.Report("")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 0.9, report.NearLimitRatio)
+	assert.Equal(t, []SharedAccountDTO{
+		{Name: "sb1", HyperscalerType: Azure, Weight: 2, MaxShootsPerRegion: 10, Shoots: 9, ShootsPerRegion: map[string]int{"westeurope": 9}, TargetShoots: 8, NearLimitRegions: []string{"westeurope"}},
+		{Name: "sb2", HyperscalerType: Azure, Weight: 1, Shoots: 3, ShootsPerRegion: map[string]int{"eastus": 3}, TargetShoots: 4},
+		{Name: "sb3", HyperscalerType: AWS, Weight: 1, MaxShoots: 2, Shoots: 2, ShootsPerRegion: map[string]int{"us-east-1": 2}, TargetShoots: 2, NearLimit: true},
+		{Name: "sb4", HyperscalerType: AWS, ShootsPerRegion: map[string]int{}, Error: `invalid value of the maxShoots label: "many"`},
+	}, report.Accounts)
+
+	t.Run("should filter by hyperscaler type", func(t *testing.T) {
+		// when
+		report, err := pool.Report(AWS)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, report.Accounts, 2)
+		assert.Equal(t, "sb3", report.Accounts[0].Name)
+	})
+}
+
 func newSecret(name string) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: machineryv1.ObjectMeta{
@@ -188,3 +330,13 @@ func newShoot(name, secretBinding string) *unstructured.Unstructured {
 	shoot.SetGroupVersionKind(shootGVK)
 	return shoot
 }
+
+func newShoots(secretBinding, region string, count int) []runtime.Object {
+	shoots := make([]runtime.Object, 0, count)
+	for i := 0; i < count; i++ {
+		shoot := newShoot(fmt.Sprintf("%s-%s-%d", secretBinding, region, i), secretBinding)
+		_ = unstructured.SetNestedField(shoot.Object, region, "spec", "region")
+		shoots = append(shoots, shoot)
+	}
+	return shoots
+}
@@ -0,0 +1,181 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+)
+
+type RunState string
+
+const (
+	// Pending is the state of a run triggered manually and not yet picked up by the scheduler
+	Pending   RunState = "pending"
+	Running   RunState = "running"
+	Succeeded RunState = "succeeded"
+	Failed    RunState = "failed"
+)
+
+// TriggeredBySchedule marks the runs started by the cron schedule of the job, manual runs hold the caller name
+const TriggeredBySchedule = "schedule"
+
+const (
+	StateParam = "state"
+)
+
+// RunDTO is a single execution of a job, the affected list holds the IDs of the instances (or other resources) changed by the run
+type RunDTO struct {
+	ID          string     `json:"id"`
+	JobName     string     `json:"jobName"`
+	State       RunState   `json:"state"`
+	TriggeredBy string     `json:"triggeredBy"`
+	Affected    []string   `json:"affected,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	FinishedAt  *time.Time `json:"finishedAt,omitempty"`
+}
+
+// JobDTO describes a job registered in the scheduler together with its latest run
+type JobDTO struct {
+	Name     string    `json:"name"`
+	Schedule string    `json:"schedule"`
+	NextRun  time.Time `json:"nextRun"`
+	LastRun  *RunDTO   `json:"lastRun,omitempty"`
+}
+
+type RunsPage struct {
+	Data       []RunDTO `json:"data"`
+	Count      int      `json:"count"`
+	TotalCount int      `json:"totalCount"`
+}
+
+// ListParameters holds the filters of the run history query, runs matching all non-empty filters are returned
+type ListParameters struct {
+	Page     int
+	PageSize int
+	JobNames []string
+	States   []RunState
+}
+
+// Client is the interface to interact with the KEB /jobs API as an HTTP client using OIDC ID token in JWT format.
+type Client interface {
+	ListJobs() ([]JobDTO, error)
+	ListRuns(jobName string, params ListParameters) (RunsPage, error)
+	TriggerRun(jobName string) (RunDTO, error)
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient constructs and returns new Client for KEB /jobs API
+// It takes the following arguments:
+//   - url        : base url of all KEB APIs, e.g. https://kyma-env-broker.kyma.local
+//   - httpClient : underlying HTTP client used for API call to KEB
+func NewClient(url string, httpClient *http.Client) Client {
+	return &client{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// ListJobs fetches all jobs registered in KEB
+func (c *client) ListJobs() ([]JobDTO, error) {
+	var jobs []JobDTO
+	err := c.call(http.MethodGet, fmt.Sprintf("%s/jobs", c.url), nil, http.StatusOK, &jobs)
+	return jobs, err
+}
+
+// ListRuns fetches the run history of the job, the newest runs come first
+func (c *client) ListRuns(jobName string, params ListParameters) (RunsPage, error) {
+	var page RunsPage
+	query := url.Values{}
+	if params.Page != 0 {
+		query.Add(pagination.PageParam, strconv.Itoa(params.Page))
+	}
+	if params.PageSize != 0 {
+		query.Add(pagination.PageSizeParam, strconv.Itoa(params.PageSize))
+	}
+	for _, state := range params.States {
+		query.Add(StateParam, string(state))
+	}
+	err := c.call(http.MethodGet, fmt.Sprintf("%s/jobs/%s/runs", c.url, jobName), query, http.StatusOK, &page)
+	return page, err
+}
+
+// TriggerRun requests an immediate run of the job, the run is executed asynchronously by the scheduler
+func (c *client) TriggerRun(jobName string) (RunDTO, error) {
+	var run RunDTO
+	err := c.call(http.MethodPost, fmt.Sprintf("%s/jobs/%s/runs", c.url, jobName), nil, http.StatusAccepted, &run)
+	return run, err
+}
+
+func (c *client) call(method, url string, query url.Values, expectedStatus int, result interface{}) (err error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling %s: %w", req.URL.String(), err)
+	}
+
+	// Drain response body and close, return error to context if there isn't any.
+	defer func() {
+		derr := drainResponseBody(resp.Body)
+		if err == nil {
+			err = derr
+		}
+		cerr := resp.Body.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != expectedStatus {
+		return fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(result)
+	if err != nil {
+		return fmt.Errorf("while decoding response body: %w", err)
+	}
+	return nil
+}
+
+// ParseListParameters reads the filters of the run history query from the request query parameters
+func ParseListParameters(query url.Values) (ListParameters, error) {
+	var params ListParameters
+	for _, value := range query[StateParam] {
+		for _, state := range strings.Split(value, ",") {
+			switch RunState(state) {
+			case Pending, Running, Succeeded, Failed:
+				params.States = append(params.States, RunState(state))
+			case "":
+			default:
+				return params, fmt.Errorf("invalid value for %s: %s", StateParam, state)
+			}
+		}
+	}
+	return params, nil
+}
+
+func drainResponseBody(body io.Reader) error {
+	if body == nil {
+		return nil
+	}
+	_, err := io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	return err
+}
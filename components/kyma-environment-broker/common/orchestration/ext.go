@@ -17,8 +17,10 @@ type Runtime struct {
 	// The corresponding shoot cluster's .spec.maintenance.timeWindow.End value, which is in "HHMMSS+[HHMM TZ]" format, e.g. "040000+0000"
 	MaintenanceWindowEnd time.Time `json:"maintenanceWindowEnd"`
 	MaintenanceDays      []string  `json:"maintenanceDays"`
-	Plan                 string    `json:"plan"`
-	Region               string    `json:"region"`
+	// CustomMaintenanceWindow indicates that the maintenance window was requested by the customer and takes precedence over the maintenance policy
+	CustomMaintenanceWindow bool   `json:"customMaintenanceWindow,omitempty"`
+	Plan                    string `json:"plan"`
+	Region                  string `json:"region"`
 }
 
 // RuntimeOperation holds information about operation performed on a runtime
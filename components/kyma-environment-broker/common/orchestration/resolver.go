@@ -44,6 +44,8 @@ const (
 	subAccountLabel         = "subaccount"
 	runtimeIDAnnotation     = "kcp.provisioner.kyma-project.io/runtime-id"
 	maintenanceWindowFormat = "150405-0700"
+	// customMaintenanceWindowFormat is the format of the maintenance window requested by the customer in the provisioning parameters
+	customMaintenanceWindowFormat = "15:04"
 )
 
 // NewGardenerRuntimeResolver constructs a GardenerRuntimeResolver with the mandatory input parameters.
@@ -230,8 +232,8 @@ func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget,
 	return runtimes, nil
 }
 
-func (*GardenerRuntimeResolver) runtimeFromDTO(runtime runtime.RuntimeDTO, shootName string, windowBegin, windowEnd time.Time) Runtime {
-	return Runtime{
+func (resolver *GardenerRuntimeResolver) runtimeFromDTO(runtime runtime.RuntimeDTO, shootName string, windowBegin, windowEnd time.Time) Runtime {
+	r := Runtime{
 		InstanceID:             runtime.InstanceID,
 		RuntimeID:              runtime.RuntimeID,
 		GlobalAccountID:        runtime.GlobalAccountID,
@@ -243,4 +245,36 @@ func (*GardenerRuntimeResolver) runtimeFromDTO(runtime runtime.RuntimeDTO, shoot
 		MaintenanceWindowEnd:   windowEnd,
 		MaintenanceDays:        []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
 	}
+
+	if runtime.MaintenanceWindow != nil {
+		if err := applyCustomMaintenanceWindow(&r, *runtime.MaintenanceWindow); err != nil {
+			resolver.logger.Errorf("Failed to apply maintenance window of runtime %s, using the one of shoot %s: %s", runtime.RuntimeID, shootName, err)
+		}
+	}
+
+	return r
+}
+
+// applyCustomMaintenanceWindow overrides the shoot maintenance window with the one requested by the customer
+func applyCustomMaintenanceWindow(r *Runtime, window runtime.MaintenanceWindow) error {
+	location, err := time.LoadLocation(window.TimeZone)
+	if err != nil {
+		return fmt.Errorf("while loading time zone %s: %w", window.TimeZone, err)
+	}
+	begin, err := time.ParseInLocation(customMaintenanceWindowFormat, window.Begin, location)
+	if err != nil {
+		return fmt.Errorf("while parsing maintenance window begin %s: %w", window.Begin, err)
+	}
+	end, err := time.ParseInLocation(customMaintenanceWindowFormat, window.End, location)
+	if err != nil {
+		return fmt.Errorf("while parsing maintenance window end %s: %w", window.End, err)
+	}
+
+	r.MaintenanceWindowBegin = begin
+	r.MaintenanceWindowEnd = end
+	if len(window.Days) > 0 {
+		r.MaintenanceDays = window.Days
+	}
+	r.CustomMaintenanceWindow = true
+	return nil
 }
@@ -256,6 +256,54 @@ func TestResolver_Resolve_StorageFailure(t *testing.T) {
 	assert.Len(t, runtimes, 0)
 }
 
+func TestResolver_RuntimeFromDTO_CustomMaintenanceWindow(t *testing.T) {
+	// given
+	resolver := NewGardenerRuntimeResolver(newFakeGardenerClient(), shootNamespace, newRuntimeListerMock(), newLogDummy())
+	shootBegin, _ := time.Parse(maintenanceWindowFormat, "030000+0000")
+	shootEnd, _ := time.Parse(maintenanceWindowFormat, "040000+0000")
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	t.Run("should use the shoot maintenance window", func(t *testing.T) {
+		// when
+		r := resolver.runtimeFromDTO(runtime1, "shoot1", shootBegin, shootEnd)
+
+		// then
+		assert.False(t, r.CustomMaintenanceWindow)
+		assert.Equal(t, shootBegin, r.MaintenanceWindowBegin)
+		assert.Equal(t, shootEnd, r.MaintenanceWindowEnd)
+		assert.Len(t, r.MaintenanceDays, 7)
+	})
+
+	t.Run("should use the maintenance window requested by the customer", func(t *testing.T) {
+		// given
+		dto := runtime1
+		dto.MaintenanceWindow = &runtime.MaintenanceWindow{Days: []string{"Sat", "Sun"}, Begin: "22:00", End: "23:30", TimeZone: "Europe/Berlin"}
+
+		// when
+		r := resolver.runtimeFromDTO(dto, "shoot1", shootBegin, shootEnd)
+
+		// then
+		assert.True(t, r.CustomMaintenanceWindow)
+		assert.Equal(t, time.Date(0, time.January, 1, 22, 0, 0, 0, berlin), r.MaintenanceWindowBegin)
+		assert.Equal(t, time.Date(0, time.January, 1, 23, 30, 0, 0, berlin), r.MaintenanceWindowEnd)
+		assert.Equal(t, []string{"Sat", "Sun"}, r.MaintenanceDays)
+	})
+
+	t.Run("should fall back to the shoot maintenance window when the customer one is invalid", func(t *testing.T) {
+		// given
+		dto := runtime1
+		dto.MaintenanceWindow = &runtime.MaintenanceWindow{Begin: "22:00", End: "23:30", TimeZone: "Europe/Nowhere"}
+
+		// when
+		r := resolver.runtimeFromDTO(dto, "shoot1", shootBegin, shootEnd)
+
+		// then
+		assert.False(t, r.CustomMaintenanceWindow)
+		assert.Equal(t, shootBegin, r.MaintenanceWindowBegin)
+	})
+}
+
 var (
 	shoot1 = fixShoot(1, globalAccountID1, region1)
 	shoot2 = fixShoot(2, globalAccountID1, region2)
@@ -0,0 +1,137 @@
+package planvisibility
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type ScopeType string
+
+const (
+	GlobalAccountScope  ScopeType = "globalAccount"
+	SubAccountScope     ScopeType = "subAccount"
+	PlatformRegionScope ScopeType = "platformRegion"
+)
+
+// AdditionalParamsFeature includes the OIDC and administrators parameters in the plan schemas, the same as
+// the IncludeAdditionalParamsInSchema setting does for all plans
+const AdditionalParamsFeature = "additionalParams"
+
+// Features are the feature flags which can be enabled for a plan
+var Features = []string{
+	AdditionalParamsFeature,
+}
+
+const (
+	PlanParam       = "plan"
+	ScopeTypeParam  = "scope_type"
+	ScopeValueParam = "scope_value"
+)
+
+// RuleDTO makes a restricted plan visible and enables the plan feature flags for a global account, subaccount or platform region
+type RuleDTO struct {
+	PlanName   string    `json:"planName"`
+	ScopeType  ScopeType `json:"scopeType"`
+	ScopeValue string    `json:"scopeValue"`
+	// Visible makes the restricted plan visible in the catalog and allows provisioning it
+	Visible   bool      `json:"visible"`
+	Features  []string  `json:"features,omitempty"`
+	UpdatedBy string    `json:"updatedBy"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// RuleRequest is the body of the request setting the rule of a plan for a scope
+type RuleRequest struct {
+	Visible  bool     `json:"visible"`
+	Features []string `json:"features,omitempty"`
+}
+
+// RulesDTO lists the rules together with the plans visible only to the scopes granted by the rules
+type RulesDTO struct {
+	RestrictedPlans []string  `json:"restrictedPlans"`
+	Rules           []RuleDTO `json:"rules"`
+}
+
+// ListParameters holds the filters of the rules query, rules matching all non-empty filters are returned
+type ListParameters struct {
+	PlanNames   []string
+	ScopeTypes  []ScopeType
+	ScopeValues []string
+}
+
+// Scope identifies the caller the rules are matched against, empty fields match no rule
+type Scope struct {
+	GlobalAccountID string
+	SubAccountID    string
+	PlatformRegion  string
+}
+
+// Matches returns true if the rule applies to the scope
+func (s Scope) Matches(rule RuleDTO) bool {
+	var value string
+	switch rule.ScopeType {
+	case GlobalAccountScope:
+		value = s.GlobalAccountID
+	case SubAccountScope:
+		value = s.SubAccountID
+	case PlatformRegionScope:
+		value = s.PlatformRegion
+	}
+	return value != "" && value == rule.ScopeValue
+}
+
+func ParseScopeType(value string) (ScopeType, error) {
+	switch ScopeType(value) {
+	case GlobalAccountScope, SubAccountScope, PlatformRegionScope:
+		return ScopeType(value), nil
+	default:
+		return "", fmt.Errorf("invalid scope type %q, allowed: %s, %s, %s", value, GlobalAccountScope, SubAccountScope, PlatformRegionScope)
+	}
+}
+
+// ValidateFeatures checks if the features are known
+func ValidateFeatures(features []string) error {
+	for _, feature := range features {
+		known := false
+		for _, f := range Features {
+			if f == feature {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown feature %q, allowed: %s", feature, strings.Join(Features, ", "))
+		}
+	}
+	return nil
+}
+
+func ParseListParameters(query url.Values) (ListParameters, error) {
+	params := ListParameters{
+		PlanNames:   splitValues(query[PlanParam]),
+		ScopeValues: splitValues(query[ScopeValueParam]),
+	}
+	for _, value := range splitValues(query[ScopeTypeParam]) {
+		scopeType, err := ParseScopeType(value)
+		if err != nil {
+			return params, fmt.Errorf("invalid value for %s: %w", ScopeTypeParam, err)
+		}
+		params.ScopeTypes = append(params.ScopeTypes, scopeType)
+	}
+	return params, nil
+}
+
+func splitValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			if v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
@@ -49,6 +49,7 @@ func TestClient_ListRuntimes(t *testing.T) {
 			Shoots:           []string{"shoot1", "shoot2"},
 			Plans:            []string{"plan1", "plan2"},
 			States:           []State{StateFailed, StateSucceeded},
+			OnlyDeleted:      true,
 		}
 		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			called++
@@ -68,6 +69,7 @@ func TestClient_ListRuntimes(t *testing.T) {
 			assert.ElementsMatch(t, params.Regions, query[RegionParam])
 			assert.ElementsMatch(t, params.Shoots, query[ShootParam])
 			assert.ElementsMatch(t, params.Plans, query[PlanParam])
+			assert.ElementsMatch(t, []string{"true"}, query[OnlyDeletedParam])
 			stateParams := query[StateParam]
 			assert.Len(t, stateParams, 2)
 			assert.EqualValues(t, params.States[0], stateParams[0])
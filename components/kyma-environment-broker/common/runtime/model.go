@@ -48,6 +48,8 @@ type RuntimeDTO struct {
 	ServicePlanID               string                         `json:"servicePlanID"`
 	ServicePlanName             string                         `json:"servicePlanName"`
 	Provider                    string                         `json:"provider"`
+	HighAvailability            bool                           `json:"highAvailability"`
+	MaintenanceWindow           *MaintenanceWindow             `json:"maintenanceWindow,omitempty"`
 	Status                      RuntimeStatus                  `json:"status"`
 	UserID                      string                         `json:"userID"`
 	AVSInternalEvaluationID     int64                          `json:"avsInternalEvaluationID"`
@@ -56,6 +58,14 @@ type RuntimeDTO struct {
 	ClusterConfig               *gqlschema.GardenerConfigInput `json:"clusterConfig,omitempty"`
 }
 
+// MaintenanceWindow is the maintenance window preferred by the customer. Begin and end are local times in the HH:MM format
+type MaintenanceWindow struct {
+	Days     []string `json:"days,omitempty"`
+	Begin    string   `json:"begin"`
+	End      string   `json:"end"`
+	TimeZone string   `json:"timeZone,omitempty"`
+}
+
 type RuntimeStatus struct {
 	CreatedAt        time.Time       `json:"createdAt"`
 	ModifiedAt       time.Time       `json:"modifiedAt"`
@@ -108,6 +118,31 @@ type RuntimesPage struct {
 	TotalCount int          `json:"totalCount"`
 }
 
+type ChangeType string
+
+const (
+	// ChangeUpserted means that the runtime was created or modified after the given cursor.
+	ChangeUpserted ChangeType = "upserted"
+	// ChangeRemoved means that the instance of the runtime does not exist anymore.
+	ChangeRemoved ChangeType = "removed"
+)
+
+type RuntimeChange struct {
+	Type         ChangeType  `json:"type"`
+	InstanceID   string      `json:"instanceID"`
+	RuntimeID    string      `json:"runtimeID"`
+	SubAccountID string      `json:"subAccountID"`
+	Runtime      *RuntimeDTO `json:"runtime,omitempty"`
+}
+
+// RuntimeChangesPage is returned by the /runtimes/changes endpoint. Cursor must be passed as the since parameter
+// in the subsequent request to get the changes which happened afterwards.
+type RuntimeChangesPage struct {
+	Data   []RuntimeChange `json:"data"`
+	Count  int             `json:"count"`
+	Cursor string          `json:"cursor"`
+}
+
 const (
 	GlobalAccountIDParam = "account"
 	SubAccountIDParam    = "subaccount"
@@ -121,6 +156,8 @@ const (
 	KymaConfigParam      = "kyma_config"
 	ClusterConfigParam   = "cluster_config"
 	ExpiredParam         = "expired"
+	OnlyDeletedParam     = "only_deleted"
+	SinceParam           = "since"
 )
 
 type OperationDetail string
@@ -161,6 +198,8 @@ type ListParameters struct {
 	Expired bool
 	// Events parameter fetches tracing events per instance
 	Events string
+	// OnlyDeleted parameter rebuilds the runtimes of the given instance IDs, which are already removed, from their residual operations
+	OnlyDeleted bool
 }
 
 func (rt RuntimeDTO) LastOperation() Operation {
@@ -25,6 +25,9 @@ import (
 type config struct {
 	MaxAgeHours   time.Duration `envconfig:"default=24h"`
 	LabelSelector string        `envconfig:"default=owner.do-not-delete"`
+	DryRun        bool          `envconfig:"default=false"`
+	MaxAffected   int           `envconfig:"default=0"`
+	ReportFormat  string        `envconfig:"default=table"`
 	Gardener      gardener.Config
 	Database      storage.Config
 	Broker        broker.ClientConfig
@@ -125,7 +128,7 @@ func (b *AppBuilder) Cleanup() {
 }
 
 func (b *AppBuilder) Create() App {
-	return environmentscleanup.NewService(
+	svc := environmentscleanup.NewService(
 		b.gardenerClient,
 		b.brokerClient,
 		b.provisionerClient,
@@ -134,4 +137,8 @@ func (b *AppBuilder) Create() App {
 		b.cfg.MaxAgeHours,
 		b.cfg.LabelSelector,
 	)
+	svc.DryRun = b.cfg.DryRun
+	svc.MaxAffected = b.cfg.MaxAffected
+	svc.ReportFormat = b.cfg.ReportFormat
+	return svc
 }
@@ -16,6 +16,7 @@ require (
 	github.com/docker/docker v23.0.1+incompatible
 	github.com/docker/go-connections v0.4.0
 	github.com/gocraft/dbr v0.0.0-20190714181702-8114670a83bd
+	github.com/golang-jwt/jwt/v4 v4.5.0
 	github.com/google/go-github v17.0.0+incompatible
 	github.com/google/uuid v1.3.0
 	github.com/gorilla/handlers v1.5.1
@@ -84,7 +85,6 @@ require (
 	github.com/go-openapi/swag v0.22.3 // indirect
 	github.com/go-ozzo/ozzo-validation/v4 v4.3.0 // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
-	github.com/golang-jwt/jwt/v4 v4.5.0 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/google/gnostic v0.6.9 // indirect
 	github.com/google/go-cmp v0.5.9 // indirect
@@ -145,6 +145,9 @@ replace (
 	// include fix https://github.com/satori/go.uuid/pull/75 https://nvd.nist.gov/vuln/detail/CVE-2021-3538
 	github.com/satori/go.uuid => github.com/satori/go.uuid v0.0.0-20181028125025-b2ce2384e17b
 
+	// use the provisioner API from the same revision, so that KEB can send all the parameters supported by the provisioner
+	github.com/kyma-project/control-plane/components/provisioner => ../provisioner
+
 	k8s.io/api => k8s.io/api v0.24.1
 	k8s.io/apiextensions-apiserver => k8s.io/apiextensions-apiserver v0.24.1
 	k8s.io/apimachinery => k8s.io/apimachinery v0.24.1
@@ -0,0 +1,107 @@
+package accountpool
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	hyperscalerTypeParam = "hyperscaler_type"
+	euAccessParam        = "eu_access"
+	stateParam           = "state"
+)
+
+type Inventory interface {
+	Get() (hyperscaler.InventoryDTO, error)
+}
+
+type SharedPool interface {
+	Report(hyperscalerType hyperscaler.Type) (hyperscaler.SharedPoolReport, error)
+}
+
+type Handler struct {
+	inventory  Inventory
+	sharedPool SharedPool
+	log        logrus.FieldLogger
+}
+
+func NewHandler(inventory Inventory, sharedPool SharedPool, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		inventory:  inventory,
+		sharedPool: sharedPool,
+		log:        log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/hyperscaler-accounts", h.getInventory).Methods(http.MethodGet)
+	router.HandleFunc("/hyperscaler-accounts/shared", h.getSharedReport).Methods(http.MethodGet)
+}
+
+func (h *Handler) getInventory(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	hyperscalerType := query.Get(hyperscalerTypeParam)
+	state := query.Get(stateParam)
+	var euAccess *bool
+	if value := query.Get(euAccessParam); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("invalid value for %s: %s", euAccessParam, value))
+			return
+		}
+		euAccess = &parsed
+	}
+	switch hyperscaler.AccountState(state) {
+	case "", hyperscaler.AccountFree, hyperscaler.AccountAssigned, hyperscaler.AccountDirty, hyperscaler.AccountQuarantined, hyperscaler.AccountShared:
+	default:
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("invalid value for %s: %s", stateParam, state))
+		return
+	}
+
+	inventory, err := h.inventory.Get()
+	if err != nil {
+		h.log.Errorf("while getting hyperscaler accounts inventory: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting hyperscaler accounts inventory: %w", err))
+		return
+	}
+
+	matches := func(t hyperscaler.Type, eu bool) bool {
+		return (hyperscalerType == "" || string(t) == hyperscalerType) && (euAccess == nil || *euAccess == eu)
+	}
+	result := hyperscaler.InventoryDTO{
+		LowWatermark: inventory.LowWatermark,
+		Pools:        []hyperscaler.PoolDTO{},
+		Accounts:     []hyperscaler.AccountDTO{},
+	}
+	for _, pool := range inventory.Pools {
+		if matches(pool.HyperscalerType, pool.EUAccess) {
+			result.Pools = append(result.Pools, pool)
+		}
+	}
+	for _, account := range inventory.Accounts {
+		if matches(account.HyperscalerType, account.EUAccess) && (state == "" || string(account.State) == state) {
+			result.Accounts = append(result.Accounts, account)
+		}
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, result)
+}
+
+func (h *Handler) getSharedReport(w http.ResponseWriter, req *http.Request) {
+	hyperscalerType := hyperscaler.Type(req.URL.Query().Get(hyperscalerTypeParam))
+
+	report, err := h.sharedPool.Report(hyperscalerType)
+	if err != nil {
+		h.log.Errorf("while getting shared hyperscaler accounts report: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting shared hyperscaler accounts report: %w", err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, report)
+}
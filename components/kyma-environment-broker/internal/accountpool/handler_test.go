@@ -0,0 +1,98 @@
+package accountpool
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetInventory(t *testing.T) {
+	router := mux.NewRouter()
+	NewHandler(fakeInventory{}, fakeSharedPool{}, logger.NewLogDummy()).AttachRoutes(router)
+
+	t.Run("should filter by hyperscaler type, EU access and state", func(t *testing.T) {
+		// given
+		req := httptest.NewRequest(http.MethodGet, "/hyperscaler-accounts?hyperscaler_type=aws&eu_access=false&state=free", nil)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var result hyperscaler.InventoryDTO
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+		assert.Equal(t, 5, result.LowWatermark)
+		require.Len(t, result.Pools, 1)
+		assert.Equal(t, 2, result.Pools[0].Total)
+		require.Len(t, result.Accounts, 1)
+		assert.Equal(t, "aws-1", result.Accounts[0].Name)
+	})
+
+	t.Run("should reject invalid state", func(t *testing.T) {
+		// given
+		req := httptest.NewRequest(http.MethodGet, "/hyperscaler-accounts?state=broken", nil)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return the shared accounts report for the hyperscaler type", func(t *testing.T) {
+		// given
+		req := httptest.NewRequest(http.MethodGet, "/hyperscaler-accounts/shared?hyperscaler_type=gcp", nil)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var result hyperscaler.SharedPoolReport
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+		require.Len(t, result.Accounts, 1)
+		assert.Equal(t, "gcp-1", result.Accounts[0].Name)
+		assert.True(t, result.Accounts[0].NearLimit)
+	})
+}
+
+type fakeInventory struct{}
+
+func (fakeInventory) Get() (hyperscaler.InventoryDTO, error) {
+	return hyperscaler.InventoryDTO{
+		LowWatermark: 5,
+		Pools: []hyperscaler.PoolDTO{
+			{HyperscalerType: hyperscaler.AWS, Total: 2, Free: 1, Assigned: 1, BelowLowWatermark: true},
+			{HyperscalerType: hyperscaler.AWS, EUAccess: true, Total: 1, Free: 1, BelowLowWatermark: true},
+			{HyperscalerType: hyperscaler.GCP, Total: 1, Shared: 1},
+		},
+		Accounts: []hyperscaler.AccountDTO{
+			{Name: "aws-1", HyperscalerType: hyperscaler.AWS, State: hyperscaler.AccountFree},
+			{Name: "aws-2", HyperscalerType: hyperscaler.AWS, State: hyperscaler.AccountAssigned, TenantName: "tenant1"},
+			{Name: "aws-eu-1", HyperscalerType: hyperscaler.AWS, EUAccess: true, State: hyperscaler.AccountFree},
+			{Name: "gcp-1", HyperscalerType: hyperscaler.GCP, State: hyperscaler.AccountShared},
+		},
+	}, nil
+}
+
+type fakeSharedPool struct{}
+
+func (fakeSharedPool) Report(hyperscalerType hyperscaler.Type) (hyperscaler.SharedPoolReport, error) {
+	report := hyperscaler.SharedPoolReport{NearLimitRatio: 0.9, Accounts: []hyperscaler.SharedAccountDTO{}}
+	if hyperscalerType == "" || hyperscalerType == hyperscaler.GCP {
+		report.Accounts = append(report.Accounts, hyperscaler.SharedAccountDTO{
+			Name: "gcp-1", HyperscalerType: hyperscaler.GCP, Weight: 1, MaxShoots: 10, Shoots: 9, TargetShoots: 9, NearLimit: true,
+		})
+	}
+	return report, nil
+}
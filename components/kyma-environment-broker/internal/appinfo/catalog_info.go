@@ -0,0 +1,31 @@
+package appinfo
+
+import (
+	"net/http"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+)
+
+type CatalogStatusProvider interface {
+	Status() broker.CatalogStatus
+}
+
+// CatalogInfoHandler serves the version of the active catalog and the error of the last rejected catalog update
+type CatalogInfoHandler struct {
+	statusProvider CatalogStatusProvider
+	respWriter     ResponseWriter
+}
+
+func NewCatalogInfoHandler(statusProvider CatalogStatusProvider, respWriter ResponseWriter) *CatalogInfoHandler {
+	return &CatalogInfoHandler{
+		statusProvider: statusProvider,
+		respWriter:     respWriter,
+	}
+}
+
+func (h *CatalogInfoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := httputil.JSONEncode(w, h.statusProvider.Status()); err != nil {
+		h.respWriter.InternalServerError(w, r, err, "while encoding response to JSON")
+	}
+}
@@ -0,0 +1,38 @@
+package appinfo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/appinfo"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogInfoHandler(t *testing.T) {
+	// given
+	handler := appinfo.NewCatalogInfoHandler(fakeCatalogStatus{version: "2023-10", err: "invalid catalog"}, httputil.NewResponseWriter(logrus.New(), true))
+	req, err := http.NewRequest("GET", "/info/catalog", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+
+	// when
+	handler.ServeHTTP(rr, req)
+
+	// then
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"version": "2023-10", "error": "invalid catalog"}`, rr.Body.String())
+}
+
+type fakeCatalogStatus struct {
+	version string
+	err     string
+}
+
+func (f fakeCatalogStatus) Status() broker.CatalogStatus {
+	return broker.CatalogStatus{Version: f.version, Error: f.err}
+}
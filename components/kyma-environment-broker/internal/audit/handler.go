@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	auditapi "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type Handler struct {
+	auditLog       storage.AuditLog
+	defaultMaxPage int
+	log            logrus.FieldLogger
+}
+
+func NewHandler(auditLog storage.AuditLog, defaultMaxPage int, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		auditLog:       auditLog,
+		defaultMaxPage: defaultMaxPage,
+		log:            log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/audit", h.listEntries).Methods(http.MethodGet)
+}
+
+func (h *Handler) listEntries(w http.ResponseWriter, req *http.Request) {
+	pageSize, page, err := pagination.ExtractPaginationConfigFromRequest(req, h.defaultMaxPage)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while getting query parameters: %w", err))
+		return
+	}
+	params, err := auditapi.ParseListParameters(req.URL.Query())
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	params.Page = page
+	params.PageSize = pageSize
+
+	entries, count, totalCount, err := h.auditLog.List(params)
+	if err != nil {
+		h.log.Errorf("while listing audit log entries: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while listing audit log entries: %w", err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, auditapi.EntriesPage{
+		Data:       entries,
+		Count:      count,
+		TotalCount: totalCount,
+	})
+}
@@ -0,0 +1,291 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	auditapi "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/auth"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	originatingIdentityHeader = "X-Broker-API-Originating-Identity"
+
+	redactedValue = "[REDACTED]"
+
+	// maxParametersSize limits the part of the request body stored with the entry
+	maxParametersSize = 64 * 1024
+	// maxResponseSize limits the part of the response body used to resolve the outcome
+	maxResponseSize = 4 * 1024
+	maxErrorLength  = 1024
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=true"`
+}
+
+type auditedRoute struct {
+	method string
+	// pathSuffix matches the end of the route template, the OSB routes are registered under several prefixes
+	pathSuffix string
+	action     auditapi.Action
+}
+
+var auditedRoutes = []auditedRoute{
+	{http.MethodPut, "/v2/service_instances/{instance_id}", auditapi.ActionProvision},
+	{http.MethodPatch, "/v2/service_instances/{instance_id}", auditapi.ActionUpdate},
+	{http.MethodDelete, "/v2/service_instances/{instance_id}", auditapi.ActionDeprovision},
+	{http.MethodPut, "/service_bindings/{binding_id}", auditapi.ActionBind},
+	{http.MethodDelete, "/service_bindings/{binding_id}", auditapi.ActionUnbind},
+	{http.MethodPost, "/upgrade/kyma", auditapi.ActionCreateKymaOrchestration},
+	{http.MethodPost, "/upgrade/cluster", auditapi.ActionCreateClusterOrchestration},
+	{http.MethodPut, "/orchestrations/{orchestration_id}/cancel", auditapi.ActionCancelOrchestration},
+	{http.MethodPost, "/orchestrations/{orchestration_id}/retry", auditapi.ActionRetryOrchestration},
+	{http.MethodGet, "/kubeconfig/{instance_id}", auditapi.ActionDownloadKubeconfig},
+	{http.MethodPost, "/jobs/{job_name}/runs", auditapi.ActionTriggerJob},
+	{http.MethodPut, "/plan-visibility/{plan_name}/{scope_type}/{scope_value}", auditapi.ActionSetPlanVisibility},
+	{http.MethodDelete, "/plan-visibility/{plan_name}/{scope_type}/{scope_value}", auditapi.ActionDeletePlanVisibility},
+}
+
+// sensitiveKeys are the fragments of parameter names whose values are never stored
+var sensitiveKeys = []string{"password", "secret", "token", "credential", "kubeconfig", "certificate", "privatekey"}
+
+// Recorder stores the audit log entry of every state-changing call handled by the broker
+type Recorder struct {
+	storage storage.AuditLog
+	log     logrus.FieldLogger
+}
+
+func NewRecorder(storage storage.AuditLog, log logrus.FieldLogger) *Recorder {
+	return &Recorder{
+		storage: storage,
+		log:     log,
+	}
+}
+
+// Middleware records the calls of the audited routes, it must be registered on the router serving these routes
+func (r *Recorder) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		action, audited := resolveAction(req)
+		if !audited {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		parameters := readParameters(req)
+		caller, callerType := ResolveCaller(req)
+		vars := mux.Vars(req)
+
+		recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(recorder, req)
+
+		entry := auditapi.EntryDTO{
+			ID:              uuid.NewString(),
+			Caller:          caller,
+			CallerType:      callerType,
+			Action:          action,
+			InstanceID:      vars["instance_id"],
+			OrchestrationID: vars["orchestration_id"],
+			Parameters:      parameters,
+			StatusCode:      recorder.statusCode,
+			Outcome:         auditapi.OutcomeSucceeded,
+			CreatedAt:       time.Now(),
+		}
+		if recorder.statusCode >= http.StatusBadRequest {
+			entry.Outcome = auditapi.OutcomeFailed
+			entry.Error = responseError(recorder.body.Bytes())
+		} else if entry.OrchestrationID == "" && action != auditapi.ActionDownloadKubeconfig {
+			entry.OrchestrationID = createdOrchestrationID(recorder.body.Bytes())
+		}
+
+		if err := r.storage.Insert(entry); err != nil {
+			r.log.Errorf("while storing audit log entry of %s action called by %s: %v", action, caller, err)
+		}
+	})
+}
+
+func resolveAction(req *http.Request) (auditapi.Action, bool) {
+	route := mux.CurrentRoute(req)
+	if route == nil {
+		return "", false
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return "", false
+	}
+	for _, r := range auditedRoutes {
+		if req.Method == r.method && strings.HasSuffix(template, r.pathSuffix) {
+			return r.action, true
+		}
+	}
+	return "", false
+}
+
+// ResolveCaller returns the platform user from the OSB originating identity header or the subject of the token
+// verified by the authentication middleware, the requests not authenticated by the middleware are anonymous
+func ResolveCaller(req *http.Request) (string, auditapi.CallerType) {
+	if identity := req.Header.Get(originatingIdentityHeader); identity != "" {
+		if user := originatingUser(identity); user != "" {
+			return user, auditapi.CallerTypeOriginatingIdentity
+		}
+	}
+
+	if caller, authenticated := auth.CallerFromContext(req.Context()); authenticated && caller.Subject != "" {
+		return caller.Subject, auditapi.CallerTypeToken
+	}
+
+	return string(auditapi.CallerTypeAnonymous), auditapi.CallerTypeAnonymous
+}
+
+// originatingUser decodes the "<platform> <base64 encoded JSON>" value of the OSB originating identity header
+func originatingUser(header string) string {
+	platform, value, found := strings.Cut(header, " ")
+	if !found {
+		return ""
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return ""
+	}
+	var properties map[string]interface{}
+	if err := json.Unmarshal(decoded, &properties); err != nil {
+		return ""
+	}
+	for _, key := range []string{"user_name", "username", "email", "user_id"} {
+		if user, ok := properties[key].(string); ok && user != "" {
+			return platform + "/" + user
+		}
+	}
+	return ""
+}
+
+type parameters struct {
+	Query map[string][]string `json:"query,omitempty"`
+	Body  interface{}         `json:"body,omitempty"`
+}
+
+// readParameters returns the redacted request parameters and leaves the request body intact for the handler
+func readParameters(req *http.Request) string {
+	params := parameters{}
+	if len(req.URL.Query()) > 0 {
+		params.Query = req.URL.Query()
+	}
+
+	if req.Body != nil {
+		body, err := io.ReadAll(io.LimitReader(req.Body, maxParametersSize))
+		if err == nil {
+			req.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+
+			var decoded interface{}
+			if json.Unmarshal(body, &decoded) == nil {
+				params.Body = redact(decoded)
+			}
+		}
+	}
+
+	if params.Query == nil && params.Body == nil {
+		return ""
+	}
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if isSensitive(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redact(nested)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redact(nested)
+		}
+	}
+	return value
+}
+
+func isSensitive(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(normalized, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// responseError extracts the error message from the OSB, orchestration or kubeconfig error response
+func responseError(body []byte) string {
+	var response map[string]interface{}
+	if json.Unmarshal(body, &response) == nil {
+		for _, key := range []string{"description", "error", "Error"} {
+			if message, ok := response[key].(string); ok && message != "" {
+				return truncate(message)
+			}
+		}
+	}
+	return truncate(string(body))
+}
+
+func createdOrchestrationID(body []byte) string {
+	var response struct {
+		OrchestrationID string `json:"orchestrationID"`
+	}
+	if json.Unmarshal(body, &response) != nil {
+		return ""
+	}
+	return response.OrchestrationID
+}
+
+func truncate(message string) string {
+	if len(message) > maxErrorLength {
+		return message[:maxErrorLength]
+	}
+	return message
+}
+
+// responseRecorder passes the response to the client and keeps its status and the beginning of its body
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if !r.wroteHeader {
+		r.statusCode = statusCode
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.wroteHeader = true
+	if remaining := maxResponseSize - r.body.Len(); remaining > 0 {
+		if len(data) < remaining {
+			remaining = len(data)
+		}
+		r.body.Write(data[:remaining])
+	}
+	return r.ResponseWriter.Write(data)
+}
@@ -0,0 +1,181 @@
+package audit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	auditapi "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/auth"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_Middleware(t *testing.T) {
+	t.Run("should record provisioning with originating identity and redacted parameters", func(t *testing.T) {
+		// given
+		auditLog, router := fixRouter()
+		var handledBody string
+		router.PathPrefix("/oauth/").Subrouter().HandleFunc("/v2/service_instances/{instance_id}", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			handledBody = string(body)
+			w.WriteHeader(http.StatusAccepted)
+		}).Methods(http.MethodPut)
+
+		body := `{"parameters":{"name":"cluster","kubeconfig":"apiVersion: v1"},"context":{"sm_operator_credentials":{"clientsecret":"s3cr3t"}}}`
+		req := httptest.NewRequest(http.MethodPut, "/oauth/v2/service_instances/inst-1?accepts_incomplete=true", strings.NewReader(body))
+		identity := base64.StdEncoding.EncodeToString([]byte(`{"user_id":"123","user_name":"john@example.com"}`))
+		req.Header.Set(originatingIdentityHeader, "cloudfoundry "+identity)
+
+		// when
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		// then
+		assert.Equal(t, body, handledBody)
+		entry := onlyEntry(t, auditLog)
+		assert.Equal(t, auditapi.ActionProvision, entry.Action)
+		assert.Equal(t, "cloudfoundry/john@example.com", entry.Caller)
+		assert.Equal(t, auditapi.CallerTypeOriginatingIdentity, entry.CallerType)
+		assert.Equal(t, "inst-1", entry.InstanceID)
+		assert.Equal(t, http.StatusAccepted, entry.StatusCode)
+		assert.Equal(t, auditapi.OutcomeSucceeded, entry.Outcome)
+		assert.JSONEq(t, `{
+			"query":{"accepts_incomplete":["true"]},
+			"body":{"parameters":{"name":"cluster","kubeconfig":"[REDACTED]"},"context":{"sm_operator_credentials":"[REDACTED]"}}
+		}`, entry.Parameters)
+	})
+
+	t.Run("should record created orchestration with authenticated caller", func(t *testing.T) {
+		// given
+		auditLog := storage.NewMemoryStorage().AuditLog()
+		router := mux.NewRouter()
+		router.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				next.ServeHTTP(w, r.WithContext(auth.WithCaller(r.Context(), auth.Caller{Subject: "admin@example.com", Role: auth.RoleAdmin})))
+			})
+		})
+		router.Use(NewRecorder(auditLog, logrus.New()).Middleware)
+		router.HandleFunc("/upgrade/kyma", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(map[string]string{"orchestrationID": "orch-1"})
+		}).Methods(http.MethodPost)
+
+		req := httptest.NewRequest(http.MethodPost, "/upgrade/kyma", strings.NewReader(`{"targets":{"include":[{"runtimeID":"rt-1"}]}}`))
+
+		// when
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		// then
+		entry := onlyEntry(t, auditLog)
+		assert.Equal(t, auditapi.ActionCreateKymaOrchestration, entry.Action)
+		assert.Equal(t, "admin@example.com", entry.Caller)
+		assert.Equal(t, auditapi.CallerTypeToken, entry.CallerType)
+		assert.Equal(t, "orch-1", entry.OrchestrationID)
+	})
+
+	t.Run("should not take the caller from unverified token", func(t *testing.T) {
+		// given
+		auditLog, router := fixRouter()
+		router.HandleFunc("/upgrade/kyma", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		}).Methods(http.MethodPost)
+
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "admin@example.com"}).SignedString([]byte("key"))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/upgrade/kyma", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// when
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		// then
+		entry := onlyEntry(t, auditLog)
+		assert.Equal(t, string(auditapi.CallerTypeAnonymous), entry.Caller)
+		assert.Equal(t, auditapi.CallerTypeAnonymous, entry.CallerType)
+	})
+
+	t.Run("should record call rejected by the authenticator as anonymous", func(t *testing.T) {
+		// given
+		auditLog := storage.NewMemoryStorage().AuditLog()
+		recorder := NewRecorder(auditLog, logrus.New())
+		authenticator, err := auth.NewAuthenticator(auth.Config{Issuers: []string{"https://issuer.invalid"}}, http.DefaultClient, logrus.New())
+		require.NoError(t, err)
+		authenticator.RecordRejected(recorder.Middleware)
+		router := mux.NewRouter()
+		router.Use(authenticator.Middleware)
+		router.Use(recorder.Middleware)
+		called := false
+		router.HandleFunc("/upgrade/kyma", func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}).Methods(http.MethodPost)
+
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/upgrade/kyma", nil))
+
+		// then
+		assert.False(t, called)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		entry := onlyEntry(t, auditLog)
+		assert.Equal(t, auditapi.ActionCreateKymaOrchestration, entry.Action)
+		assert.Equal(t, auditapi.CallerTypeAnonymous, entry.CallerType)
+		assert.Equal(t, http.StatusUnauthorized, entry.StatusCode)
+		assert.Equal(t, auditapi.OutcomeFailed, entry.Outcome)
+	})
+
+	t.Run("should record failed kubeconfig download", func(t *testing.T) {
+		// given
+		auditLog, router := fixRouter()
+		router.HandleFunc("/kubeconfig/{instance_id}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"Error":"instance with ID inst-1 does not exist"}`))
+		}).Methods(http.MethodGet)
+
+		// when
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/kubeconfig/inst-1", nil))
+
+		// then
+		entry := onlyEntry(t, auditLog)
+		assert.Equal(t, auditapi.ActionDownloadKubeconfig, entry.Action)
+		assert.Equal(t, string(auditapi.CallerTypeAnonymous), entry.Caller)
+		assert.Equal(t, auditapi.OutcomeFailed, entry.Outcome)
+		assert.Equal(t, "instance with ID inst-1 does not exist", entry.Error)
+	})
+
+	t.Run("should not record read calls", func(t *testing.T) {
+		// given
+		auditLog, router := fixRouter()
+		router.HandleFunc("/orchestrations/{orchestration_id}", func(w http.ResponseWriter, r *http.Request) {}).Methods(http.MethodGet)
+
+		// when
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orchestrations/orch-1", nil))
+
+		// then
+		_, _, totalCount, err := auditLog.List(auditapi.ListParameters{})
+		require.NoError(t, err)
+		assert.Zero(t, totalCount)
+	})
+}
+
+func fixRouter() (storage.AuditLog, *mux.Router) {
+	auditLog := storage.NewMemoryStorage().AuditLog()
+	router := mux.NewRouter()
+	router.Use(NewRecorder(auditLog, logrus.New()).Middleware)
+	return auditLog, router
+}
+
+func onlyEntry(t *testing.T, auditLog storage.AuditLog) auditapi.EntryDTO {
+	entries, _, _, err := auditLog.List(auditapi.ListParameters{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	return entries[0]
+}
@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/sirupsen/logrus"
+)
+
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+var roleLevels = map[Role]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// Includes checks if the role grants the permissions of the other role
+func (r Role) Includes(other Role) bool {
+	return roleLevels[r] >= roleLevels[other]
+}
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+	// Issuers lists the trusted token issuers, their signing keys are discovered from the OpenID configuration
+	Issuers []string `envconfig:"optional"`
+	// Audiences lists the accepted values of the aud claim, e.g. the client ID used by kcp login, not verified when empty
+	Audiences    []string      `envconfig:"optional"`
+	KeysCacheTTL time.Duration `envconfig:"default=1h"`
+	// KeysFetchTimeout limits the calls to the issuers fetching the signing keys
+	KeysFetchTimeout time.Duration `envconfig:"default=10s"`
+	GroupsClaim      string        `envconfig:"default=groups"`
+	// Admins, Operators and Viewers list the groups and scopes granting the role
+	Admins    []string `envconfig:"optional"`
+	Operators []string `envconfig:"optional"`
+	Viewers   []string `envconfig:"optional"`
+}
+
+// The key type is no exported to prevent collisions with context keys
+// defined in other packages.
+type key int
+
+const callerKey key = iota + 1
+
+// Caller is the authenticated client of the admin endpoints
+type Caller struct {
+	Subject string
+	Role    Role
+}
+
+// CallerFromContext returns the caller authenticated by the middleware if possible.
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey).(Caller)
+	return caller, ok
+}
+
+// WithCaller returns a copy of the context holding the authenticated caller
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey, caller)
+}
+
+type routeRule struct {
+	pathPrefix string
+	methods    []string
+	role       Role
+}
+
+// routeRules define the lowest role allowed to call the admin endpoints, other methods of the matching paths require
+// the admin role, paths without rules (OSB API, kubeconfig, metrics) are not handled by the authenticator
+var routeRules = []routeRule{
+	{"/runtimes", []string{http.MethodGet}, RoleViewer},
+	{"/info/runtimes", []string{http.MethodGet}, RoleViewer},
+	{"/info/catalog", []string{http.MethodGet}, RoleViewer},
+	{"/events", []string{http.MethodGet}, RoleViewer},
+	{"/orchestrations", []string{http.MethodGet}, RoleViewer},
+	{"/orchestrations", []string{http.MethodPut, http.MethodPost}, RoleOperator},
+	{"/upgrade/", []string{http.MethodPost}, RoleOperator},
+	{"/audit", []string{http.MethodGet}, RoleAdmin},
+	{"/jobs", []string{http.MethodGet}, RoleViewer},
+	{"/hyperscaler-accounts", []string{http.MethodGet}, RoleViewer},
+	{"/plan-visibility", []string{http.MethodGet}, RoleViewer},
+}
+
+// Authenticator validates the bearer tokens of the admin endpoints and enforces the role required by the route
+type Authenticator struct {
+	config      Config
+	parser      *jwt.Parser
+	keySets     map[string]*keySet
+	memberRoles map[string]Role
+	log         logrus.FieldLogger
+	// rejected wraps the handler writing the responses of the rejected requests, e.g. to record them in the audit log
+	rejected func(http.Handler) http.Handler
+}
+
+func NewAuthenticator(config Config, httpClient *http.Client, log logrus.FieldLogger) (*Authenticator, error) {
+	if len(config.Issuers) == 0 {
+		return nil, fmt.Errorf("at least one token issuer must be configured")
+	}
+
+	authenticator := &Authenticator{
+		config:      config,
+		parser:      jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512"})),
+		keySets:     map[string]*keySet{},
+		memberRoles: map[string]Role{},
+		log:         log,
+	}
+	for _, issuer := range config.Issuers {
+		authenticator.keySets[issuer] = newKeySet(issuer, httpClient, config.KeysCacheTTL)
+	}
+	// the highest role wins when a group or scope is configured for several roles
+	for role, members := range map[Role][]string{RoleViewer: config.Viewers, RoleOperator: config.Operators, RoleAdmin: config.Admins} {
+		for _, member := range members {
+			if current, found := authenticator.memberRoles[member]; !found || role.Includes(current) {
+				authenticator.memberRoles[member] = role
+			}
+		}
+	}
+
+	return authenticator, nil
+}
+
+// RecordRejected passes the rejected requests through the middleware, which sees the caller only if the request was
+// authenticated but lacks the required role
+func (a *Authenticator) RecordRejected(middleware func(http.Handler) http.Handler) {
+	a.rejected = middleware
+}
+
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		required, protected := requiredRole(req)
+		if !protected {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		caller, err := a.authenticate(req)
+		if err != nil {
+			a.log.Warnf("Unauthorized %s request to %s: %s", req.Method, req.URL.Path, err)
+			a.reject(w, req, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+			return
+		}
+		req = req.WithContext(WithCaller(req.Context(), caller))
+		if !caller.Role.Includes(required) {
+			a.log.Warnf("Forbidden %s request of %s with role %q to %s", req.Method, caller.Subject, caller.Role, req.URL.Path)
+			a.reject(w, req, http.StatusForbidden, fmt.Errorf("%s role is required", required))
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (a *Authenticator) reject(w http.ResponseWriter, req *http.Request, status int, err error) {
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		httputil.WriteErrorResponse(w, status, err)
+	})
+	if a.rejected != nil {
+		handler = a.rejected(handler)
+	}
+	handler.ServeHTTP(w, req)
+}
+
+func requiredRole(req *http.Request) (Role, bool) {
+	pathMatched := false
+	for _, rule := range routeRules {
+		if !strings.HasPrefix(req.URL.Path, rule.pathPrefix) {
+			continue
+		}
+		pathMatched = true
+		for _, method := range rule.methods {
+			if req.Method == method {
+				return rule.role, true
+			}
+		}
+	}
+	return RoleAdmin, pathMatched
+}
+
+func (a *Authenticator) authenticate(req *http.Request) (Caller, error) {
+	header := req.Header.Get("Authorization")
+	token := strings.TrimPrefix(header, "Bearer ")
+	if header == "" || token == header {
+		return Caller{}, fmt.Errorf("bearer token not provided")
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.signingKey); err != nil {
+		return Caller{}, fmt.Errorf("invalid token: %w", err)
+	}
+	// the parser verifies the exp claim only if it is present
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return Caller{}, fmt.Errorf("invalid token: token has no expiration time")
+	}
+	if !a.validAudience(claims) {
+		return Caller{}, fmt.Errorf("token audience is not accepted")
+	}
+
+	subject, _ := claims["sub"].(string)
+	return Caller{Subject: subject, Role: a.role(claims)}, nil
+}
+
+func (a *Authenticator) signingKey(token *jwt.Token) (interface{}, error) {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("unexpected claims type")
+	}
+	issuer, _ := claims["iss"].(string)
+	keys, found := a.keySets[issuer]
+	if !found {
+		return nil, fmt.Errorf("issuer %q is not trusted", issuer)
+	}
+	keyID, _ := token.Header["kid"].(string)
+	return keys.Key(keyID)
+}
+
+func (a *Authenticator) validAudience(claims jwt.MapClaims) bool {
+	if len(a.config.Audiences) == 0 {
+		return true
+	}
+	for _, audience := range a.config.Audiences {
+		if claims.VerifyAudience(audience, true) {
+			return true
+		}
+	}
+	return false
+}
+
+// role returns the highest role granted by the groups of the user or the scopes of the client
+func (a *Authenticator) role(claims jwt.MapClaims) Role {
+	var members []string
+	for _, claim := range []string{a.config.GroupsClaim, "scp", "scope"} {
+		members = append(members, claimValues(claims[claim])...)
+	}
+
+	var highest Role
+	for _, member := range members {
+		if role, found := a.memberRoles[member]; found && !highest.Includes(role) {
+			highest = role
+		}
+	}
+	return highest
+}
+
+func claimValues(claim interface{}) []string {
+	switch value := claim.(type) {
+	case string:
+		return strings.Fields(value)
+	case []interface{}:
+		var values []string
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticator_Middleware(t *testing.T) {
+	// given
+	issuer := newFakeIssuer(t)
+	defer issuer.Close()
+
+	authenticator, err := NewAuthenticator(Config{
+		Enabled:      true,
+		Issuers:      []string{issuer.URL},
+		Audiences:    []string{"kcp-cli"},
+		KeysCacheTTL: time.Hour,
+		GroupsClaim:  "groups",
+		Admins:       []string{"runtimeAdmin"},
+		Operators:    []string{"runtimeOperator"},
+		Viewers:      []string{"runtimeViewer", "cld:read"},
+	}, http.DefaultClient, logrus.New())
+	require.NoError(t, err)
+
+	var handledCaller Caller
+	handler := authenticator.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handledCaller, _ = CallerFromContext(r.Context())
+	}))
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	for _, testCase := range []struct {
+		description    string
+		method         string
+		path           string
+		token          string
+		expectedStatus int
+		expectedCaller Caller
+	}{
+		{
+			description:    "should allow viewer to list runtimes",
+			method:         http.MethodGet,
+			path:           "/runtimes",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "john", "aud": "kcp-cli", "groups": []interface{}{"runtimeViewer"}}),
+			expectedStatus: http.StatusOK,
+			expectedCaller: Caller{Subject: "john", Role: RoleViewer},
+		},
+		{
+			description:    "should allow client with scope to get runtimes info",
+			method:         http.MethodGet,
+			path:           "/info/runtimes",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "client", "aud": []interface{}{"other", "kcp-cli"}, "scp": []interface{}{"cld:read"}}),
+			expectedStatus: http.StatusOK,
+			expectedCaller: Caller{Subject: "client", Role: RoleViewer},
+		},
+		{
+			description:    "should not allow viewer to cancel orchestration",
+			method:         http.MethodPut,
+			path:           "/orchestrations/orch-1/cancel",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "john", "aud": "kcp-cli", "groups": []interface{}{"runtimeViewer"}}),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			description:    "should allow operator to create orchestration with the highest role of the groups",
+			method:         http.MethodPost,
+			path:           "/upgrade/kyma",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "jane", "aud": "kcp-cli", "groups": []interface{}{"runtimeViewer", "runtimeOperator"}}),
+			expectedStatus: http.StatusOK,
+			expectedCaller: Caller{Subject: "jane", Role: RoleOperator},
+		},
+		{
+			description:    "should require admin for audit log",
+			method:         http.MethodGet,
+			path:           "/audit",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "jane", "aud": "kcp-cli", "groups": []interface{}{"runtimeOperator"}}),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			description:    "should reject user without any role",
+			method:         http.MethodGet,
+			path:           "/events",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "bob", "aud": "kcp-cli", "groups": []interface{}{"developers"}}),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			description:    "should reject token with other audience",
+			method:         http.MethodGet,
+			path:           "/runtimes",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "john", "aud": "other", "groups": []interface{}{"runtimeAdmin"}}),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "should reject expired token",
+			method:         http.MethodGet,
+			path:           "/runtimes",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "john", "aud": "kcp-cli", "groups": []interface{}{"runtimeAdmin"}, "exp": time.Now().Add(-time.Minute).Unix()}),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "should reject token without expiration time",
+			method:         http.MethodGet,
+			path:           "/runtimes",
+			token:          issuer.token(t, jwt.MapClaims{"sub": "john", "aud": "kcp-cli", "groups": []interface{}{"runtimeAdmin"}, "exp": nil}),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "should reject token from untrusted issuer",
+			method:         http.MethodGet,
+			path:           "/runtimes",
+			token:          signToken(t, otherKey, "key-1", jwt.MapClaims{"iss": "https://untrusted", "sub": "john", "aud": "kcp-cli", "groups": []interface{}{"runtimeAdmin"}}),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "should reject token signed with unknown key",
+			method:         http.MethodGet,
+			path:           "/runtimes",
+			token:          signToken(t, otherKey, "key-1", jwt.MapClaims{"iss": issuer.URL, "sub": "john", "aud": "kcp-cli", "groups": []interface{}{"runtimeAdmin"}}),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "should reject request without token",
+			method:         http.MethodGet,
+			path:           "/orchestrations",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "should not authenticate OSB API",
+			method:         http.MethodPut,
+			path:           "/oauth/v2/service_instances/inst-1",
+			expectedStatus: http.StatusOK,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			handledCaller = Caller{}
+			req := httptest.NewRequest(testCase.method, testCase.path, nil)
+			if testCase.token != "" {
+				req.Header.Set("Authorization", "Bearer "+testCase.token)
+			}
+			rr := httptest.NewRecorder()
+
+			// when
+			handler.ServeHTTP(rr, req)
+
+			// then
+			assert.Equal(t, testCase.expectedStatus, rr.Code)
+			assert.Equal(t, testCase.expectedCaller, handledCaller)
+		})
+	}
+
+	t.Run("should cache issuer keys", func(t *testing.T) {
+		assert.Equal(t, int32(1), atomic.LoadInt32(&issuer.jwksCalls))
+	})
+}
+
+func TestKeySet_Key(t *testing.T) {
+	// given
+	issuer := newFakeIssuer(t)
+	defer issuer.Close()
+
+	keys := newKeySet(issuer.URL, &http.Client{Timeout: time.Second}, time.Hour)
+	_, err := keys.Key("key-1")
+	require.NoError(t, err)
+
+	t.Run("should return cached keys while fetching unknown key", func(t *testing.T) {
+		// given
+		keys.mu.Lock()
+		keys.attemptedAt = time.Now().Add(-minRefreshInterval)
+		keys.mu.Unlock()
+		unblock := issuer.block()
+
+		fetched := make(chan error)
+		go func() {
+			_, err := keys.Key("key-2")
+			fetched <- err
+		}()
+		require.Eventually(t, func() bool { return atomic.LoadInt32(&issuer.jwksCalls) == 2 }, time.Second, 10*time.Millisecond)
+
+		// when
+		key, err := keys.Key("key-1")
+
+		// then
+		require.NoError(t, err)
+		assert.NotNil(t, key)
+
+		close(unblock)
+		assert.EqualError(t, <-fetched, fmt.Sprintf("unknown key %q of issuer %s", "key-2", issuer.URL))
+	})
+
+	t.Run("should not wait for unavailable issuer longer than the client timeout", func(t *testing.T) {
+		// given
+		keys.mu.Lock()
+		keys.attemptedAt = time.Now().Add(-minRefreshInterval)
+		keys.mu.Unlock()
+		unblock := issuer.block()
+		defer close(unblock)
+
+		// when
+		_, err := keys.Key("key-2")
+
+		// then
+		assert.ErrorContains(t, err, "while fetching keys of issuer")
+	})
+}
+
+type fakeIssuer struct {
+	*httptest.Server
+	key       *rsa.PrivateKey
+	jwksCalls int32
+
+	mu      sync.Mutex
+	blocked chan struct{}
+}
+
+// block makes the issuer respond to the keys requests only after the returned channel is closed
+func (i *fakeIssuer) block() chan struct{} {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.blocked = make(chan struct{})
+	return i.blocked
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := &fakeIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer.URL, "jwks_uri": issuer.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&issuer.jwksCalls, 1)
+		issuer.mu.Lock()
+		blocked := issuer.blocked
+		issuer.mu.Unlock()
+		if blocked != nil {
+			<-blocked
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": "key-1",
+				"kty": "RSA",
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+			}},
+		})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	return issuer
+}
+
+func (i *fakeIssuer) token(t *testing.T, claims jwt.MapClaims) string {
+	claims["iss"] = i.URL
+	return signToken(t, i.key, "key-1", claims)
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, keyID string, claims jwt.MapClaims) string {
+	if exp, found := claims["exp"]; !found {
+		claims["exp"] = time.Now().Add(time.Hour).Unix()
+	} else if exp == nil {
+		delete(claims, "exp")
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
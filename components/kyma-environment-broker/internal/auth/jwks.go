@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval protects the issuer from refreshing the keys for every token with an unknown key ID
+const minRefreshInterval = time.Minute
+
+type jsonWebKey struct {
+	KeyID     string `json:"kid"`
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+	Algorithm string `json:"alg"`
+}
+
+// keySet caches the RSA signing keys of the issuer discovered from its OpenID configuration
+type keySet struct {
+	issuer     string
+	httpClient *http.Client
+	ttl        time.Duration
+
+	// refreshMu ensures that only one caller fetches the keys, it is never held together with mu
+	refreshMu sync.Mutex
+
+	mu          sync.Mutex
+	keys        map[string]*rsa.PublicKey
+	refreshedAt time.Time
+	attemptedAt time.Time
+}
+
+func newKeySet(issuer string, httpClient *http.Client, ttl time.Duration) *keySet {
+	return &keySet{
+		issuer:     strings.TrimSuffix(issuer, "/"),
+		httpClient: httpClient,
+		ttl:        ttl,
+		keys:       map[string]*rsa.PublicKey{},
+	}
+}
+
+// Key returns the key with the given ID, the keys are fetched again when expired or when the key is unknown.
+// The issuer is called without holding the cache lock, so that the tokens signed with cached keys are verified in the meantime.
+func (s *keySet) Key(keyID string) (*rsa.PublicKey, error) {
+	if key, found, refresh := s.lookup(keyID); !refresh {
+		return s.knownKey(keyID, key, found)
+	}
+
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	// the keys could have been fetched by another caller in the meantime
+	key, found, refresh := s.lookup(keyID)
+	if !refresh {
+		return s.knownKey(keyID, key, found)
+	}
+
+	s.mu.Lock()
+	s.attemptedAt = time.Now()
+	s.mu.Unlock()
+
+	keys, err := s.fetch()
+	if err != nil {
+		if found {
+			// keep using the cached key when the issuer is temporarily unavailable
+			return key, nil
+		}
+		return nil, fmt.Errorf("while fetching keys of issuer %s: %w", s.issuer, err)
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.refreshedAt = time.Now()
+	s.mu.Unlock()
+
+	key, found = keys[keyID]
+	return s.knownKey(keyID, key, found)
+}
+
+// lookup returns the cached key and whether the keys should be fetched from the issuer
+func (s *keySet) lookup(keyID string) (*rsa.PublicKey, bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, found := s.keys[keyID]
+	if found && time.Since(s.refreshedAt) <= s.ttl {
+		return key, true, false
+	}
+	return key, found, time.Since(s.attemptedAt) >= minRefreshInterval
+}
+
+func (s *keySet) knownKey(keyID string, key *rsa.PublicKey, found bool) (*rsa.PublicKey, error) {
+	if !found {
+		return nil, fmt.Errorf("unknown key %q of issuer %s", keyID, s.issuer)
+	}
+	return key, nil
+}
+
+func (s *keySet) fetch() (map[string]*rsa.PublicKey, error) {
+	var discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := s.get(s.issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, fmt.Errorf("while getting OpenID configuration: %w", err)
+	}
+	if discovery.JWKSURI == "" {
+		return nil, fmt.Errorf("OpenID configuration does not contain jwks_uri")
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := s.get(discovery.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("while getting JWKS: %w", err)
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.KeyType != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := jwk.rsaPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("while decoding key %q: %w", jwk.KeyID, err)
+		}
+		keys[jwk.KeyID] = key
+	}
+	return keys, nil
+}
+
+func (s *keySet) get(url string, target interface{}) error {
+	resp, err := s.httpClient.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("calling %s returned %d status", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(target)
+}
+
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(k.Modulus)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	exponent, err := base64.RawURLEncoding.DecodeString(k.Exponent)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(new(big.Int).SetBytes(exponent).Int64()),
+	}, nil
+}
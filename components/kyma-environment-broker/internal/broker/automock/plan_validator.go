@@ -2,7 +2,10 @@
 
 package automock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	internal "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // PlanValidator is an autogenerated mock type for the PlanValidator type
 type PlanValidator struct {
@@ -23,6 +26,20 @@ func (_m *PlanValidator) IsPlanSupport(planID string) bool {
 	return r0
 }
 
+// ValidateHighAvailability provides a mock function with given fields: parameters
+func (_m *PlanValidator) ValidateHighAvailability(parameters internal.ProvisioningParameters) error {
+	ret := _m.Called(parameters)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(internal.ProvisioningParameters) error); ok {
+		r0 = rf(parameters)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewPlanValidator interface {
 	mock.TestingT
 	Cleanup(func())
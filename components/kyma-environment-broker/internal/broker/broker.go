@@ -2,11 +2,8 @@ package broker
 
 import (
 	"fmt"
-	"io/ioutil"
 	"strings"
 
-	"gopkg.in/yaml.v2"
-
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
@@ -41,25 +38,16 @@ type Config struct {
 	ShowTrialExpirationInfo                 bool   `envconfig:"default=false"`
 	SubaccountsIdsToShowTrialExpirationInfo string `envconfig:"default="`
 	TrialDocsURL                            string `envconfig:"default="`
+	// PlanUpgrades lists the allowed plan changes of existing instances, e.g. "azure_lite:azure,free:azure"
+	PlanUpgrades PlanUpgrades `envconfig:"optional"`
+	// EnableMaintenanceInfo advertises the maintenance_info in the catalog and allows platforms to upgrade instances with it
+	EnableMaintenanceInfo bool   `envconfig:"default=false"`
+	KymaVersion           string `envconfig:"-"`
+	KubernetesVersion     string `envconfig:"-"`
 }
 
 type ServicesConfig map[string]Service
 
-func NewServicesConfigFromFile(path string) (ServicesConfig, error) {
-	yamlFile, err := ioutil.ReadFile(path)
-	if err != nil {
-		return nil, fmt.Errorf("while reading YAML file with managed components list: %w", err)
-	}
-	var servicesConfig struct {
-		Services ServicesConfig `yaml:"services"`
-	}
-	err = yaml.Unmarshal(yamlFile, &servicesConfig)
-	if err != nil {
-		return nil, fmt.Errorf("while unmarshaling YAML file with managed components list: %w", err)
-	}
-	return servicesConfig.Services, nil
-}
-
 func (s ServicesConfig) DefaultPlansConfig() (PlansConfig, error) {
 	cfg, ok := s[KymaServiceName]
 	if !ok {
@@ -0,0 +1,409 @@
+package broker
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// PlanCatalog holds the machine types, regions and default autoscaler values offered by a plan
+type PlanCatalog struct {
+	MachineTypes []string `yaml:"machineTypes"`
+	// CatalogMachineTypes are advertised in the create schema of the catalog endpoint, defaults to MachineTypes.
+	// It allows accepting a new machine type in requests before it is advertised, e.g. until it is available in all regions.
+	CatalogMachineTypes []string          `yaml:"catalogMachineTypes"`
+	MachineTypesDisplay map[string]string `yaml:"machineTypesDisplay"`
+	Regions             []string          `yaml:"regions"`
+	// EURegions are offered to the EU access restricted platform regions, defaults to Regions
+	EURegions     []string `yaml:"euRegions"`
+	AutoScalerMin int      `yaml:"autoScalerMin"`
+	AutoScalerMax int      `yaml:"autoScalerMax"`
+}
+
+// Catalog holds the services metadata and the plans configuration used to build the catalog and the plan schemas
+type Catalog struct {
+	// Version identifies the catalog, defaults to the checksum of the catalog file
+	Version  string                 `yaml:"version"`
+	Services ServicesConfig         `yaml:"services"`
+	Plans    map[string]PlanCatalog `yaml:"plans"`
+}
+
+// CatalogStatus describes the active catalog and the last update of the catalog file
+type CatalogStatus struct {
+	Version  string     `json:"version"`
+	LoadedAt *time.Time `json:"loadedAt,omitempty"`
+	// Error is set if the last update of the catalog file was rejected, the previous catalog stays active
+	Error      string     `json:"error,omitempty"`
+	RejectedAt *time.Time `json:"rejectedAt,omitempty"`
+}
+
+type autoScalerBounds struct {
+	min, max int
+}
+
+// planAutoScalerBounds are the limits of the autoscaler values in the plan schemas
+var planAutoScalerBounds = map[string]autoScalerBounds{
+	AWSPlanName:       {min: 3, max: 80},
+	GCPPlanName:       {min: 3, max: 80},
+	AzurePlanName:     {min: 3, max: 80},
+	AzureLitePlanName: {min: 2, max: 40},
+	OpenStackPlanName: {min: 2, max: 40},
+}
+
+const defaultCatalogVersion = "default"
+
+var (
+	activeCatalog  atomic.Value
+	defaultCatalog = DefaultCatalog()
+)
+
+// ActiveCatalog returns the catalog used to build the plans, the returned catalog must not be modified
+func ActiveCatalog() *Catalog {
+	if catalog, ok := activeCatalog.Load().(*Catalog); ok {
+		return catalog
+	}
+	return defaultCatalog
+}
+
+// SetActiveCatalog replaces the catalog used to build the plans
+func SetActiveCatalog(catalog *Catalog) {
+	activeCatalog.Store(catalog)
+}
+
+// DefaultCatalog returns the plans configuration used if the catalog file does not configure them
+func DefaultCatalog() *Catalog {
+	azureMachinesDisplay := map[string]string{
+		// source: https://docs.microsoft.com/en-us/azure/cloud-services/cloud-services-sizes-specs#dv3-series
+		"Standard_D4_v3":  "Standard_D4_v3 (4vCPU, 16GB RAM)",
+		"Standard_D8_v3":  "Standard_D8_v3 (8vCPU, 32GB RAM)",
+		"Standard_D16_v3": "Standard_D16_v3 (16vCPU, 64GB RAM)",
+		"Standard_D32_v3": "Standard_D32_v3 (32vCPU, 128GB RAM)",
+		"Standard_D48_v3": "Standard_D48_v3 (48vCPU, 192GB RAM)",
+		"Standard_D64_v3": "Standard_D64_v3 (64vCPU, 256GB RAM)",
+	}
+	azureRegions := []string{"eastus", "centralus", "westus2", "uksouth", "northeurope", "westeurope", "japaneast", "southeastasia"}
+	azureEURegions := []string{"switzerlandnorth"}
+
+	return &Catalog{
+		Version: defaultCatalogVersion,
+		Plans: map[string]PlanCatalog{
+			AWSPlanName: {
+				MachineTypes: []string{"m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge", "m6i.xlarge", "m6i.2xlarge", "m6i.4xlarge", "m6i.8xlarge", "m6i.12xlarge"},
+				// switch to m6 if m6 is available in all regions
+				CatalogMachineTypes: []string{"m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge"},
+				MachineTypesDisplay: map[string]string{
+					// source: https://aws.amazon.com/ec2/instance-types/m5/
+					"m5.xlarge":   "m5.xlarge (4vCPU, 16GB RAM)",
+					"m5.2xlarge":  "m5.2xlarge (8vCPU, 32GB RAM)",
+					"m5.4xlarge":  "m5.4xlarge (16vCPU, 64GB RAM)",
+					"m5.8xlarge":  "m5.8xlarge (32vCPU, 128GB RAM)",
+					"m5.12xlarge": "m5.12xlarge (48vCPU, 192GB RAM)",
+					// source: https://aws.amazon.com/ec2/instance-types/m6i/
+					"m6i.xlarge":   "m6i.xlarge (4vCPU, 16GB RAM)",
+					"m6i.2xlarge":  "m6i.2xlarge (8vCPU, 32GB RAM)",
+					"m6i.4xlarge":  "m6i.4xlarge (16vCPU, 64GB RAM)",
+					"m6i.8xlarge":  "m6i.8xlarge (32vCPU, 128GB RAM)",
+					"m6i.12xlarge": "m6i.12xlarge (48vCPU, 192GB RAM)",
+				},
+				// be aware of zones defined in internal/provider/aws_provider.go
+				Regions: []string{"eu-central-1", "eu-west-2", "ca-central-1", "sa-east-1", "us-east-1", "us-west-1",
+					"ap-northeast-1", "ap-northeast-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2"},
+				EURegions:     []string{"eu-central-1"},
+				AutoScalerMin: 3,
+				AutoScalerMax: 20,
+			},
+			GCPPlanName: {
+				// source: https://cloud.google.com/compute/docs/general-purpose-machines#e2_limitations
+				MachineTypes: []string{"n2-standard-4", "n2-standard-8", "n2-standard-16", "n2-standard-32", "n2-standard-48"},
+				MachineTypesDisplay: map[string]string{
+					"n2-standard-4":  "n2-standard-4 (4vCPU, 16GB RAM)",
+					"n2-standard-8":  "n2-standard-8 (8vCPU, 32GB RAM)",
+					"n2-standard-16": "n2-standard-16 (16vCPU, 64GB RAM)",
+					"n2-standard-32": "n2-standard-32 (32vCPU, 128GB RAM)",
+					"n2-standard-48": "n2-standard-48 (48vCPU, 192B RAM)",
+				},
+				Regions:       []string{"europe-west3", "asia-south1", "us-central1"},
+				AutoScalerMin: 3,
+				AutoScalerMax: 20,
+			},
+			AzurePlanName: {
+				MachineTypes:        []string{"Standard_D4_v3", "Standard_D8_v3", "Standard_D16_v3", "Standard_D32_v3", "Standard_D48_v3", "Standard_D64_v3"},
+				MachineTypesDisplay: azureMachinesDisplay,
+				// keep internal/hyperscaler/azure/config.go in sync with any changes to available zones
+				Regions:       azureRegions,
+				EURegions:     azureEURegions,
+				AutoScalerMin: 3,
+				AutoScalerMax: 20,
+			},
+			AzureLitePlanName: {
+				MachineTypes: []string{"Standard_D4_v3"},
+				MachineTypesDisplay: map[string]string{
+					"Standard_D4_v3": azureMachinesDisplay["Standard_D4_v3"],
+				},
+				Regions:       azureRegions,
+				EURegions:     azureEURegions,
+				AutoScalerMin: 2,
+				AutoScalerMax: 10,
+			},
+			OpenStackPlanName: {
+				MachineTypes: []string{"g_c4_m16", "g_c8_m32"},
+				MachineTypesDisplay: map[string]string{
+					"g_c4_m16": "g_c4_m16 (4vCPU, 16GB RAM)",
+					"g_c8_m32": "g_c8_m32 (8vCPU, 32GB RAM)",
+				},
+				Regions:       []string{"eu-de-1", "ap-sa-1"},
+				AutoScalerMin: 3,
+				AutoScalerMax: 8,
+			},
+		},
+	}
+}
+
+// ParseCatalog reads the catalog file content, the plans configured in the file override the default ones field by field
+func ParseCatalog(data []byte) (*Catalog, error) {
+	var file Catalog
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("while unmarshaling the catalog: %w", err)
+	}
+
+	catalog := DefaultCatalog()
+	catalog.Services = file.Services
+	catalog.Version = file.Version
+	if catalog.Version == "" {
+		checksum := sha256.Sum256(data)
+		catalog.Version = hex.EncodeToString(checksum[:])[:12]
+	}
+
+	var errs []string
+	for name, override := range file.Plans {
+		plan, found := catalog.Plans[name]
+		if !found {
+			errs = append(errs, fmt.Sprintf("plans.%s: the plan does not support the configuration", name))
+			continue
+		}
+		catalog.Plans[name] = plan.merge(override)
+	}
+	errs = append(errs, catalog.validate()...)
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("invalid catalog: %s", strings.Join(errs, "; "))
+	}
+
+	return catalog, nil
+}
+
+func (c *Catalog) plan(name string) PlanCatalog {
+	return c.Plans[name]
+}
+
+func (c *Catalog) validate() []string {
+	var errs []string
+	if _, found := c.Services[KymaServiceName]; !found {
+		errs = append(errs, fmt.Sprintf("services.%s: the service is missing", KymaServiceName))
+	}
+	for name, plan := range c.Plans {
+		for _, err := range plan.validate(planAutoScalerBounds[name]) {
+			errs = append(errs, fmt.Sprintf("plans.%s.%s", name, err))
+		}
+	}
+	return errs
+}
+
+func (p PlanCatalog) merge(override PlanCatalog) PlanCatalog {
+	if len(override.MachineTypes) > 0 {
+		p.MachineTypes = override.MachineTypes
+		// the default catalog machine types apply only to the default machine types
+		p.CatalogMachineTypes = nil
+	}
+	if len(override.CatalogMachineTypes) > 0 {
+		p.CatalogMachineTypes = override.CatalogMachineTypes
+	}
+	if len(override.MachineTypesDisplay) > 0 {
+		display := make(map[string]string, len(p.MachineTypesDisplay)+len(override.MachineTypesDisplay))
+		for machineType, name := range p.MachineTypesDisplay {
+			display[machineType] = name
+		}
+		for machineType, name := range override.MachineTypesDisplay {
+			display[machineType] = name
+		}
+		p.MachineTypesDisplay = display
+	}
+	if len(override.Regions) > 0 {
+		p.Regions = override.Regions
+	}
+	if len(override.EURegions) > 0 {
+		p.EURegions = override.EURegions
+	}
+	if override.AutoScalerMin > 0 {
+		p.AutoScalerMin = override.AutoScalerMin
+	}
+	if override.AutoScalerMax > 0 {
+		p.AutoScalerMax = override.AutoScalerMax
+	}
+	return p
+}
+
+func (p PlanCatalog) validate(bounds autoScalerBounds) []string {
+	var errs []string
+	if len(p.MachineTypes) == 0 {
+		errs = append(errs, "machineTypes: must not be empty")
+	}
+	if duplicate := findDuplicate(p.MachineTypes); duplicate != "" {
+		errs = append(errs, fmt.Sprintf("machineTypes: %q is duplicated", duplicate))
+	}
+	machineTypes := make(map[string]struct{}, len(p.MachineTypes))
+	for _, machineType := range p.MachineTypes {
+		machineTypes[machineType] = struct{}{}
+	}
+	for _, machineType := range p.CatalogMachineTypes {
+		if _, found := machineTypes[machineType]; !found {
+			errs = append(errs, fmt.Sprintf("catalogMachineTypes: %q is not one of the machineTypes", machineType))
+		}
+	}
+	if len(p.Regions) == 0 {
+		errs = append(errs, "regions: must not be empty")
+	}
+	if duplicate := findDuplicate(p.Regions); duplicate != "" {
+		errs = append(errs, fmt.Sprintf("regions: %q is duplicated", duplicate))
+	}
+	if duplicate := findDuplicate(p.EURegions); duplicate != "" {
+		errs = append(errs, fmt.Sprintf("euRegions: %q is duplicated", duplicate))
+	}
+	if p.AutoScalerMin < bounds.min || p.AutoScalerMin > bounds.max {
+		errs = append(errs, fmt.Sprintf("autoScalerMin: %d is out of the range %d-%d", p.AutoScalerMin, bounds.min, bounds.max))
+	}
+	if p.AutoScalerMax < bounds.min || p.AutoScalerMax > bounds.max {
+		errs = append(errs, fmt.Sprintf("autoScalerMax: %d is out of the range %d-%d", p.AutoScalerMax, bounds.min, bounds.max))
+	}
+	if p.AutoScalerMin > p.AutoScalerMax {
+		errs = append(errs, fmt.Sprintf("autoScalerMin: %d is greater than autoScalerMax %d", p.AutoScalerMin, p.AutoScalerMax))
+	}
+	return errs
+}
+
+// regions returns the regions offered to the platform region
+func (p PlanCatalog) regions(euAccessRestricted bool) []string {
+	if euAccessRestricted && len(p.EURegions) > 0 {
+		return p.EURegions
+	}
+	return p.Regions
+}
+
+// catalogMachineTypes returns the machine types advertised in the create schema of the catalog endpoint
+func (p PlanCatalog) catalogMachineTypes() []string {
+	if len(p.CatalogMachineTypes) > 0 {
+		return p.CatalogMachineTypes
+	}
+	return p.MachineTypes
+}
+
+// display returns the display names of the machine types, a machine type without a display name is displayed as is
+func (p PlanCatalog) display(machineTypes []string) map[string]string {
+	display := make(map[string]string, len(machineTypes))
+	for _, machineType := range machineTypes {
+		name, found := p.MachineTypesDisplay[machineType]
+		if !found {
+			name = machineType
+		}
+		display[machineType] = name
+	}
+	return display
+}
+
+func findDuplicate(items []string) string {
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		if _, found := seen[item]; found {
+			return item
+		}
+		seen[item] = struct{}{}
+	}
+	return ""
+}
+
+// CatalogWatcher loads the catalog file and reloads it when the file changes, an invalid update is rejected
+// and the previous catalog stays active
+type CatalogWatcher struct {
+	path string
+	log  logrus.FieldLogger
+
+	mu       sync.Mutex
+	checksum [sha256.Size]byte
+	status   CatalogStatus
+}
+
+func NewCatalogWatcher(path string, log logrus.FieldLogger) *CatalogWatcher {
+	return &CatalogWatcher{
+		path: path,
+		log:  log.WithField("service", "CatalogWatcher"),
+	}
+}
+
+// Load reads the catalog file and activates the catalog if the file changed since the last load
+func (w *CatalogWatcher) Load() error {
+	data, err := ioutil.ReadFile(w.path)
+	if err != nil {
+		return fmt.Errorf("while reading the catalog file: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	checksum := sha256.Sum256(data)
+	if bytes.Equal(checksum[:], w.checksum[:]) {
+		return nil
+	}
+	w.checksum = checksum
+
+	now := time.Now()
+	catalog, err := ParseCatalog(data)
+	if err != nil {
+		w.status.Error = err.Error()
+		w.status.RejectedAt = &now
+		return err
+	}
+
+	SetActiveCatalog(catalog)
+	w.status = CatalogStatus{
+		Version:  catalog.Version,
+		LoadedAt: &now,
+	}
+	w.log.Infof("Catalog version %s activated", catalog.Version)
+	return nil
+}
+
+// Watch checks the catalog file in the given interval until the context is done
+func (w *CatalogWatcher) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Load(); err != nil {
+				w.log.Errorf("catalog update rejected, version %s stays active: %s", w.Status().Version, err)
+			}
+		}
+	}
+}
+
+// Status returns the version of the active catalog and the error of the last rejected update
+func (w *CatalogWatcher) Status() CatalogStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.status
+}
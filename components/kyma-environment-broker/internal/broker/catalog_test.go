@@ -0,0 +1,152 @@
+package broker
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCatalog = `
+version: "2023-10"
+services:
+  kymaruntime:
+    description: "Kyma runtime"
+plans:
+  aws:
+    machineTypes: ["m6i.xlarge", "m6i.2xlarge"]
+    machineTypesDisplay:
+      m6i.xlarge: "m6i.xlarge (4vCPU, 16GB RAM)"
+    regions: ["eu-central-1", "us-east-1"]
+    autoScalerMin: 4
+  openstack:
+    autoScalerMax: 12
+`
+
+func TestParseCatalog(t *testing.T) {
+	t.Run("should override the default plans configuration", func(t *testing.T) {
+		// when
+		catalog, err := ParseCatalog([]byte(testCatalog))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "2023-10", catalog.Version)
+		assert.Equal(t, "Kyma runtime", catalog.Services[KymaServiceName].Description)
+
+		aws := catalog.plan(AWSPlanName)
+		assert.Equal(t, []string{"m6i.xlarge", "m6i.2xlarge"}, aws.catalogMachineTypes())
+		assert.Equal(t, map[string]string{"m6i.xlarge": "m6i.xlarge (4vCPU, 16GB RAM)", "m6i.2xlarge": "m6i.2xlarge (8vCPU, 32GB RAM)"}, aws.display(aws.MachineTypes))
+		assert.Equal(t, []string{"eu-central-1", "us-east-1"}, aws.regions(false))
+		assert.Equal(t, []string{"eu-central-1"}, aws.regions(true))
+		assert.Equal(t, 4, aws.AutoScalerMin)
+		assert.Equal(t, 20, aws.AutoScalerMax)
+		assert.Equal(t, 12, catalog.plan(OpenStackPlanName).AutoScalerMax)
+		assert.Equal(t, DefaultCatalog().plan(GCPPlanName), catalog.plan(GCPPlanName))
+	})
+
+	t.Run("should use the checksum as the default version", func(t *testing.T) {
+		// when
+		catalog, err := ParseCatalog([]byte("services:\n  kymaruntime: {}\n"))
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, catalog.Version, 12)
+	})
+
+	for name, tc := range map[string]struct {
+		catalog       string
+		expectedError string
+	}{
+		"unknown field": {
+			catalog:       "services:\n  kymaruntime: {}\nplans:\n  aws:\n    machineType: [\"m5.xlarge\"]\n",
+			expectedError: "field machineType not found",
+		},
+		"missing service": {
+			catalog:       "services: {}\n",
+			expectedError: "services.kymaruntime: the service is missing",
+		},
+		"unknown plan": {
+			catalog:       "services:\n  kymaruntime: {}\nplans:\n  trial:\n    regions: [\"europe\"]\n",
+			expectedError: "plans.trial: the plan does not support the configuration",
+		},
+		"catalog machine type out of the machine types": {
+			catalog:       "services:\n  kymaruntime: {}\nplans:\n  gcp:\n    catalogMachineTypes: [\"n2-standard-2\"]\n",
+			expectedError: `plans.gcp.catalogMachineTypes: "n2-standard-2" is not one of the machineTypes`,
+		},
+		"duplicated region": {
+			catalog:       "services:\n  kymaruntime: {}\nplans:\n  azure:\n    regions: [\"eastus\", \"eastus\"]\n",
+			expectedError: `plans.azure.regions: "eastus" is duplicated`,
+		},
+		"autoscaler min greater than max": {
+			catalog:       "services:\n  kymaruntime: {}\nplans:\n  azure_lite:\n    autoScalerMin: 12\n",
+			expectedError: "plans.azure_lite.autoScalerMin: 12 is greater than autoScalerMax 10",
+		},
+		"autoscaler out of the schema range": {
+			catalog:       "services:\n  kymaruntime: {}\nplans:\n  gcp:\n    autoScalerMax: 100\n",
+			expectedError: "plans.gcp.autoScalerMax: 100 is out of the range 3-80",
+		},
+	} {
+		t.Run("should reject the catalog with "+name, func(t *testing.T) {
+			// when
+			_, err := ParseCatalog([]byte(tc.catalog))
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedError)
+		})
+	}
+}
+
+func TestCatalogWatcher_Load(t *testing.T) {
+	// given
+	t.Cleanup(func() { SetActiveCatalog(defaultCatalog) })
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testCatalog), 0644))
+	watcher := NewCatalogWatcher(path, logrus.New())
+
+	// when
+	err := watcher.Load()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "2023-10", watcher.Status().Version)
+	assert.Equal(t, []string{"eu-central-1", "us-east-1"}, AWSRegions(false))
+	plan := Plans(PlansConfig{}, "", false, false)[AWSPlanID]
+	createProperties := plan.Schemas.Instance.Create.Parameters[PropertiesKey].(map[string]interface{})
+	assert.Equal(t, []interface{}{"m6i.xlarge", "m6i.2xlarge"}, createProperties["machineType"].(map[string]interface{})["enum"])
+	assert.Equal(t, float64(4), createProperties["autoScalerMin"].(map[string]interface{})["default"])
+
+	t.Run("should reject an invalid update and keep the active catalog", func(t *testing.T) {
+		// given
+		require.NoError(t, ioutil.WriteFile(path, []byte("services:\n  kymaruntime: {}\nplans:\n  aws:\n    regions: []\n    autoScalerMin: 1\n"), 0644))
+
+		// when
+		err := watcher.Load()
+
+		// then
+		require.Error(t, err)
+		status := watcher.Status()
+		assert.Equal(t, "2023-10", status.Version)
+		assert.Contains(t, status.Error, "plans.aws.autoScalerMin: 1 is out of the range 3-80")
+		assert.NotNil(t, status.RejectedAt)
+		assert.Equal(t, "2023-10", ActiveCatalog().Version)
+	})
+
+	t.Run("should activate a valid update", func(t *testing.T) {
+		// given
+		require.NoError(t, ioutil.WriteFile(path, []byte("version: \"2023-11\"\nservices:\n  kymaruntime: {}\n"), 0644))
+
+		// when
+		err := watcher.Load()
+
+		// then
+		require.NoError(t, err)
+		status := watcher.Status()
+		assert.Equal(t, "2023-11", status.Version)
+		assert.Empty(t, status.Error)
+		assert.Equal(t, DefaultCatalog().plan(AWSPlanName).Regions, AWSRegions(false))
+	})
+}
@@ -15,12 +15,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/dashboard"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
@@ -36,6 +38,7 @@ type (
 
 	PlanValidator interface {
 		IsPlanSupport(planID string) bool
+		ValidateHighAvailability(parameters internal.ProvisioningParameters) error
 	}
 )
 
@@ -59,6 +62,8 @@ type ProvisionEndpoint struct {
 	euAccessWhitelist        euaccess.WhitelistSet
 	euAccessRejectionMessage string
 
+	planVisibility PlanVisibility
+
 	log logrus.FieldLogger
 }
 
@@ -75,6 +80,7 @@ func NewProvision(cfg Config,
 	euRejectMessage string,
 	log logrus.FieldLogger,
 	dashboardConfig dashboard.Config,
+	planVisibility PlanVisibility,
 ) *ProvisionEndpoint {
 	enabledPlanIDs := map[string]struct{}{}
 	for _, planName := range cfg.EnablePlans {
@@ -99,6 +105,7 @@ func NewProvision(cfg Config,
 		euAccessWhitelist:        euAccessWhitelist,
 		euAccessRejectionMessage: euRejectMessage,
 		dashboardConfig:          dashboardConfig,
+		planVisibility:           planVisibility,
 	}
 }
 
@@ -121,6 +128,11 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 		return domain.ProvisionedServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "provisioning")
 	}
 
+	if err := b.config.validateMaintenanceInfo(details.MaintenanceInfo); err != nil {
+		logger.Warnf("maintenance_info %+v does not match the catalog", details.MaintenanceInfo)
+		return domain.ProvisionedServiceSpec{}, err
+	}
+
 	// validation of incoming input
 	ersContext, parameters, err := b.validateAndExtract(details, platformProvider, ctx, logger)
 	if err != nil {
@@ -239,6 +251,23 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 		return ersContext, parameters, fmt.Errorf("while extracting ers context: %w", err)
 	}
 
+	platformRegion, _ := middleware.RegionFromContext(ctx)
+	scope := planvisibility.Scope{
+		GlobalAccountID: ersContext.GlobalAccountID,
+		SubAccountID:    ersContext.SubAccountID,
+		PlatformRegion:  platformRegion,
+	}
+	includeAdditionalParams := b.config.IncludeAdditionalParamsInSchema
+	if b.planVisibility != nil {
+		planName := PlanNamesMapping[details.PlanID]
+		if !b.planVisibility.Available(planName, scope) {
+			logger.Infof("Plan %s is not available for subaccount %s in platform region %s", planName, ersContext.SubAccountID, platformRegion)
+			err := fmt.Errorf("plan %s is not available for the global account %s", planName, ersContext.GlobalAccountID)
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusBadRequest, "provisioning")
+		}
+		includeAdditionalParams = includeAdditionalParams || hasFeature(b.planVisibility.Features(planName, scope), planvisibility.AdditionalParamsFeature)
+	}
+
 	parameters, err = b.extractInputParameters(details)
 	if err != nil {
 		return ersContext, parameters, fmt.Errorf("while extracting input parameters: %w", err)
@@ -260,9 +289,25 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 		}
 	}
+	if err := parameters.AdditionalWorkerNodePools.Validate(); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if parameters.Networking != nil {
+		if defaults.GardenerConfig == nil {
+			err := fmt.Errorf("networking is not supported for the plan")
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+		if err := parameters.Networking.Validate(defaults.GardenerConfig.WorkerCidr, defaultNodesCIDR(defaults.GardenerConfig)); err != nil {
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+	if parameters.MaintenanceWindow != nil {
+		if err := parameters.MaintenanceWindow.Validate(); err != nil {
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
 
-	planValidator, err := b.validator(&details, provider,
-		ctx)
+	planValidator, err := b.validator(&details, provider, ctx, includeAdditionalParams)
 	if err != nil {
 		return ersContext, parameters, fmt.Errorf("while creating plan validator: %w", err)
 	}
@@ -296,6 +341,18 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 		return ersContext, parameters, fmt.Errorf("the plan ID not known, planID: %s", details.PlanID)
 	}
 
+	if parameters.IsHighAvailability() {
+		err := b.builderFactory.ValidateHighAvailability(internal.ProvisioningParameters{
+			PlanID:           details.PlanID,
+			PlatformRegion:   platformRegion,
+			PlatformProvider: provider,
+			Parameters:       parameters,
+		})
+		if err != nil {
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+
 	if IsOwnClusterPlan(details.PlanID) {
 		decodedKubeconfig, err := base64.StdEncoding.DecodeString(parameters.Kubeconfig)
 		if err != nil {
@@ -330,6 +387,19 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 	return ersContext, parameters, nil
 }
 
+// defaultNodesCIDR returns the nodes range which the provider uses when the custom one is not provided
+func defaultNodesCIDR(config *gqlschema.GardenerConfigInput) string {
+	providerConfig := config.ProviderSpecificConfig
+	switch {
+	case providerConfig != nil && providerConfig.AzureConfig != nil:
+		return providerConfig.AzureConfig.VnetCidr
+	case providerConfig != nil && providerConfig.AwsConfig != nil:
+		return providerConfig.AwsConfig.VpcCidr
+	default:
+		return config.WorkerCidr
+	}
+}
+
 func isEuRestrictedAccess(ctx context.Context) bool {
 	platformRegion, _ := middleware.RegionFromContext(ctx)
 	return euaccess.IsEURestrictedAccess(platformRegion)
@@ -412,9 +482,9 @@ func (b *ProvisionEndpoint) determineLicenceType(planId string) *string {
 	return nil
 }
 
-func (b *ProvisionEndpoint) validator(details *domain.ProvisionDetails, provider internal.CloudProvider, ctx context.Context) (JSONSchemaValidator, error) {
+func (b *ProvisionEndpoint) validator(details *domain.ProvisionDetails, provider internal.CloudProvider, ctx context.Context, includeAdditionalParams bool) (JSONSchemaValidator, error) {
 	platformRegion, _ := middleware.RegionFromContext(ctx)
-	plans := Plans(b.plansConfig, provider, b.config.IncludeAdditionalParamsInSchema, euaccess.IsEURestrictedAccess(platformRegion))
+	plans := Plans(b.plansConfig, provider, includeAdditionalParams, euaccess.IsEURestrictedAccess(platformRegion))
 	plan := plans[details.PlanID]
 	schema := string(Marshal(plan.Schemas.Instance.Create.Parameters))
 
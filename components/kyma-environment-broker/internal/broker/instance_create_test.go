@@ -85,6 +85,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -158,6 +159,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -235,6 +237,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -284,6 +287,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when shootDomain is missing
@@ -357,6 +361,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -432,6 +437,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -481,6 +487,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -531,6 +538,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -594,6 +602,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -657,6 +666,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -701,6 +711,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -743,6 +754,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -786,6 +798,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -827,6 +840,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -875,6 +889,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -920,6 +935,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -971,6 +987,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"clientID":"client-id"`
@@ -1028,6 +1045,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"issuerURL":"https://test.local"`
@@ -1085,6 +1103,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"clientID":"client-id","issuerURL":"https://test.local","signingAlgs":["RS256","notValid"]`
@@ -1109,6 +1128,125 @@ func TestProvision_Provision(t *testing.T) {
 		assert.Equal(t, expectedErr.LoggerAction(), apierr.LoggerAction())
 	})
 
+	t.Run("Should fail on networking overlapping with provider nodes range", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+
+		queue := &automock.Queue{}
+		queue.On("Add", mock.AnythingOfType("string"))
+
+		factoryBuilder := &automock.PlanValidator{}
+		factoryBuilder.On("IsPlanSupport", planID).Return(true)
+
+		planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+			return &gqlschema.ClusterConfigInput{
+				GardenerConfig: &gqlschema.GardenerConfigInput{
+					WorkerCidr: "10.250.0.0/19",
+					ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+						AwsConfig: &gqlschema.AWSProviderConfigInput{VpcCidr: "10.250.0.0/16"},
+					},
+				},
+			}, nil
+		}
+		// #create provisioner endpoint
+		provisionEndpoint := broker.NewProvision(
+			broker.Config{
+				EnablePlans:              []string{"gcp", "azure"},
+				URL:                      brokerURL,
+				OnlySingleTrialPerGA:     true,
+				EnableKubeconfigURLLabel: true,
+			},
+			gardener.Config{Project: "test", ShootDomain: "example.com", DNSProviders: fixDNSProviders()},
+			memoryStorage.Operations(),
+			memoryStorage.Instances(),
+			queue,
+			factoryBuilder,
+			broker.PlansConfig{},
+			false,
+			planDefaults,
+			euaccess.WhitelistSet{},
+			"request rejected, your globalAccountId is not whitelisted",
+			logrus.StandardLogger(),
+			dashboardConfig,
+			nil,
+		)
+
+		expectedMsg := "pods CIDR 10.250.128.0/17 overlaps with nodes CIDR 10.250.0.0/16"
+		err := fmt.Errorf(expectedMsg)
+		errMsg := fmt.Sprintf("[instanceID: %s] %s", instanceID, err)
+		expectedErr := apiresponses.NewFailureResponse(err, http.StatusBadRequest, errMsg)
+
+		// when
+		_, err = provisionEndpoint.Provision(fixRequestContext(t, "req-region"), instanceID, domain.ProvisionDetails{
+			ServiceID:     serviceID,
+			PlanID:        planID,
+			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s","networking":{"pods":"10.250.128.0/17"}}`, clusterName)),
+			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, expectedErr.ValidatedStatusCode(nil), apierr.ValidatedStatusCode(nil))
+		assert.Equal(t, expectedErr.LoggerAction(), apierr.LoggerAction())
+		assert.Contains(t, apierr.Error(), expectedMsg)
+	})
+
+	t.Run("Should fail on invalid maintenance window", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+
+		queue := &automock.Queue{}
+		queue.On("Add", mock.AnythingOfType("string"))
+
+		factoryBuilder := &automock.PlanValidator{}
+		factoryBuilder.On("IsPlanSupport", planID).Return(true)
+
+		planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+			return &gqlschema.ClusterConfigInput{}, nil
+		}
+		// #create provisioner endpoint
+		provisionEndpoint := broker.NewProvision(
+			broker.Config{
+				EnablePlans:              []string{"gcp", "azure"},
+				URL:                      brokerURL,
+				OnlySingleTrialPerGA:     true,
+				EnableKubeconfigURLLabel: true,
+			},
+			gardener.Config{Project: "test", ShootDomain: "example.com", DNSProviders: fixDNSProviders()},
+			memoryStorage.Operations(),
+			memoryStorage.Instances(),
+			queue,
+			factoryBuilder,
+			broker.PlansConfig{},
+			false,
+			planDefaults,
+			euaccess.WhitelistSet{},
+			"request rejected, your globalAccountId is not whitelisted",
+			logrus.StandardLogger(),
+			dashboardConfig,
+			nil,
+		)
+
+		expectedMsg := "maintenance window day Saturday is invalid"
+
+		// when
+		_, err := provisionEndpoint.Provision(fixRequestContext(t, "req-region"), instanceID, domain.ProvisionDetails{
+			ServiceID:     serviceID,
+			PlanID:        planID,
+			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s","maintenanceWindow":{"days":["Saturday"],"begin":"22:00","end":"23:00"}}`, clusterName)),
+			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusBadRequest, apierr.ValidatedStatusCode(nil))
+		assert.Contains(t, apierr.Error(), expectedMsg)
+	})
+
 	t.Run("Should pass for whitelisted globalAccountId - EU Access", func(t *testing.T) {
 		// given
 		memoryStorage := storage.NewMemoryStorage()
@@ -1142,6 +1280,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"clientID":"client-id","issuerURL":"https://test.local","signingAlgs":["RS256"]`
@@ -1192,6 +1331,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"clientID":"client-id","issuerURL":"https://test.local","signingAlgs":["RS256"]`
@@ -1216,6 +1356,117 @@ func TestProvision_Provision(t *testing.T) {
 		assert.Equal(t, expectedErr.LoggerAction(), apierr.LoggerAction())
 	})
 
+	t.Run("Should fail for plan not available to the global account", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+
+		queue := &automock.Queue{}
+		queue.On("Add", mock.AnythingOfType("string"))
+
+		factoryBuilder := &automock.PlanValidator{}
+		factoryBuilder.On("IsPlanSupport", planID).Return(true)
+
+		planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+			return &gqlschema.ClusterConfigInput{}, nil
+		}
+		// #create provisioner endpoint
+		provisionEndpoint := broker.NewProvision(
+			broker.Config{
+				EnablePlans:              []string{"gcp", "azure"},
+				URL:                      brokerURL,
+				OnlySingleTrialPerGA:     true,
+				EnableKubeconfigURLLabel: true,
+			},
+			gardener.Config{Project: "test", ShootDomain: "example.com", DNSProviders: fixDNSProviders()},
+			memoryStorage.Operations(),
+			memoryStorage.Instances(),
+			queue,
+			factoryBuilder,
+			broker.PlansConfig{},
+			false,
+			planDefaults,
+			euaccess.WhitelistSet{},
+			"request rejected, your globalAccountId is not whitelisted",
+			logrus.StandardLogger(),
+			dashboardConfig,
+			fakePlanVisibility{hidden: map[string]bool{broker.AzurePlanName: true}},
+		)
+
+		// when
+		_, err := provisionEndpoint.Provision(fixRequestContext(t, "cf-eu10"), instanceID, domain.ProvisionDetails{
+			ServiceID:     serviceID,
+			PlanID:        planID,
+			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s"}`, clusterName)),
+			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusBadRequest, apierr.ValidatedStatusCode(nil))
+		assert.Contains(t, err.Error(), "plan azure is not available")
+		_, err = memoryStorage.Instances().GetByID(instanceID)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail for high availability in a region without enough zones", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+
+		queue := &automock.Queue{}
+		queue.On("Add", mock.AnythingOfType("string"))
+
+		factoryBuilder := &automock.PlanValidator{}
+		factoryBuilder.On("IsPlanSupport", planID).Return(true)
+		factoryBuilder.On("ValidateHighAvailability", mock.AnythingOfType("internal.ProvisioningParameters")).
+			Return(fmt.Errorf("high availability requires workers in 3 zones, but only 1 zones are available in the region westus")).Once()
+		defer factoryBuilder.AssertExpectations(t)
+
+		planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+			return &gqlschema.ClusterConfigInput{}, nil
+		}
+		// #create provisioner endpoint
+		provisionEndpoint := broker.NewProvision(
+			broker.Config{
+				EnablePlans:              []string{"gcp", "azure"},
+				URL:                      brokerURL,
+				OnlySingleTrialPerGA:     true,
+				EnableKubeconfigURLLabel: true,
+			},
+			gardener.Config{Project: "test", ShootDomain: "example.com", DNSProviders: fixDNSProviders()},
+			memoryStorage.Operations(),
+			memoryStorage.Instances(),
+			queue,
+			factoryBuilder,
+			broker.PlansConfig{},
+			false,
+			planDefaults,
+			euaccess.WhitelistSet{},
+			"request rejected, your globalAccountId is not whitelisted",
+			logrus.StandardLogger(),
+			dashboardConfig,
+			nil,
+		)
+
+		// when
+		_, err := provisionEndpoint.Provision(fixRequestContext(t, "cf-eu10"), instanceID, domain.ProvisionDetails{
+			ServiceID:     serviceID,
+			PlanID:        planID,
+			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "highAvailability": true}`, clusterName)),
+			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusBadRequest, apierr.ValidatedStatusCode(nil))
+		assert.Contains(t, err.Error(), "high availability requires workers in 3 zones")
+		_, err = memoryStorage.Instances().GetByID(instanceID)
+		assert.Error(t, err)
+	})
+
 }
 
 func TestRegionValidation(t *testing.T) {
@@ -1296,6 +1547,7 @@ func TestRegionValidation(t *testing.T) {
 				"request rejected, your globalAccountId is not whitelisted",
 				logrus.StandardLogger(),
 				dashboardConfig,
+				nil,
 			)
 
 			// when
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
@@ -14,15 +16,17 @@ import (
 )
 
 type LastOperationEndpoint struct {
-	operationStorage storage.Operations
+	operationStorage     storage.Operations
+	orchestrationStorage storage.Orchestrations
 
 	log logrus.FieldLogger
 }
 
-func NewLastOperation(os storage.Operations, log logrus.FieldLogger) *LastOperationEndpoint {
+func NewLastOperation(os storage.Operations, ors storage.Orchestrations, log logrus.FieldLogger) *LastOperationEndpoint {
 	return &LastOperationEndpoint{
-		operationStorage: os,
-		log:              log.WithField("service", "LastOperationEndpoint"),
+		operationStorage:     os,
+		orchestrationStorage: ors,
+		log:                  log.WithField("service", "LastOperationEndpoint"),
 	}
 }
 
@@ -50,6 +54,10 @@ func (b *LastOperationEndpoint) LastOperation(ctx context.Context, instanceID st
 	}
 
 	operation, err := b.operationStorage.GetOperationByID(details.OperationData)
+	if err != nil && dberr.IsNotFound(err) {
+		// the operation data of a maintenance_info update contains IDs of the upgrade orchestrations
+		return b.maintenanceLastOperation(instanceID, details.OperationData, err, logger)
+	}
 	if err != nil {
 		logger.Errorf("cannot get operation from storage: %s", err)
 		statusCode := http.StatusInternalServerError
@@ -72,6 +80,35 @@ func (b *LastOperationEndpoint) LastOperation(ctx context.Context, instanceID st
 	}, nil
 }
 
+func (b *LastOperationEndpoint) maintenanceLastOperation(instanceID, operationData string, notFoundErr error, logger logrus.FieldLogger) (domain.LastOperation, error) {
+	var orchestrations []internal.Orchestration
+	for _, id := range strings.Split(operationData, ",") {
+		o, err := b.orchestrationStorage.GetByID(id)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if dberr.IsNotFound(err) {
+				statusCode, err = http.StatusNotFound, notFoundErr
+			}
+			logger.Errorf("cannot get operation from storage: %s", err)
+			return domain.LastOperation{}, apiresponses.NewFailureResponse(err, statusCode,
+				fmt.Sprintf("while getting operation from storage"))
+		}
+		if !targetsInstance(o, instanceID) {
+			err := fmt.Errorf("orchestration exists, but instanceID is invalid")
+			logger.Errorf("%s", err.Error())
+			return domain.LastOperation{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+		}
+		orchestrations = append(orchestrations, *o)
+	}
+
+	return maintenanceLastOperation(orchestrations), nil
+}
+
+func targetsInstance(o *internal.Orchestration, instanceID string) bool {
+	include := o.Parameters.Targets.Include
+	return len(include) == 1 && include[0].InstanceID == instanceID
+}
+
 func mapStateToOSBCompliantState(opState domain.LastOperationState) domain.LastOperationState {
 	switch {
 	case opState == orchestration.Pending || opState == orchestration.Retrying:
@@ -27,7 +27,7 @@ func TestLastOperation_LastOperation(t *testing.T) {
 		err := memoryStorage.Operations().InsertOperation(fixOperation())
 		assert.NoError(t, err)
 
-		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), logrus.StandardLogger())
+		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), memoryStorage.Orchestrations(), logrus.StandardLogger())
 
 		// when
 		response, err := lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: operationID})
@@ -45,7 +45,7 @@ func TestLastOperation_LastOperation(t *testing.T) {
 		err := memoryStorage.Operations().InsertOperation(fixOperation())
 		assert.NoError(t, err)
 
-		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), logrus.StandardLogger())
+		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), memoryStorage.Orchestrations(), logrus.StandardLogger())
 
 		// when
 		response, err := lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: ""})
@@ -65,7 +65,7 @@ func TestLastOperation_LastOperation(t *testing.T) {
 		err := memoryStorage.Operations().InsertUpdatingOperation(updateOp)
 		assert.NoError(t, err)
 
-		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), logrus.StandardLogger())
+		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), memoryStorage.Orchestrations(), logrus.StandardLogger())
 
 		// when
 		response, err := lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: ""})
@@ -93,7 +93,7 @@ func TestLastOperation_LastOperation(t *testing.T) {
 		err := memoryStorage.Operations().InsertUpdatingOperation(updateOp)
 		assert.NoError(t, err)
 
-		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), logrus.StandardLogger())
+		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), memoryStorage.Orchestrations(), logrus.StandardLogger())
 
 		// when
 		response, err := lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: ""})
@@ -124,7 +124,7 @@ func TestLastOperation_LastOperation(t *testing.T) {
 		err := memoryStorage.Operations().InsertUpdatingOperation(updateOp)
 		assert.NoError(t, err)
 
-		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), logrus.StandardLogger())
+		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), memoryStorage.Orchestrations(), logrus.StandardLogger())
 
 		// when
 		response, err := lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: ""})
@@ -155,7 +155,7 @@ func TestLastOperation_LastOperation(t *testing.T) {
 		err := memoryStorage.Operations().InsertUpdatingOperation(updateOp)
 		assert.NoError(t, err)
 
-		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), logrus.StandardLogger())
+		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), memoryStorage.Orchestrations(), logrus.StandardLogger())
 
 		// when
 		response, err := lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: ""})
@@ -178,6 +178,59 @@ func TestLastOperation_LastOperation(t *testing.T) {
 			Description: updateOp.Description,
 		}, response)
 	})
+	t.Run("Should return state of the maintenance upgrade orchestrations", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		kymaUpgrade := fixMaintenanceOrchestration("kyma-orchestration", orchestration.UpgradeKymaOrchestration, orchestration.Succeeded)
+		clusterUpgrade := fixMaintenanceOrchestration("cluster-orchestration", orchestration.UpgradeClusterOrchestration, orchestration.InProgress)
+		assert.NoError(t, memoryStorage.Orchestrations().Insert(kymaUpgrade))
+		assert.NoError(t, memoryStorage.Orchestrations().Insert(clusterUpgrade))
+
+		lastOperationEndpoint := broker.NewLastOperation(memoryStorage.Operations(), memoryStorage.Orchestrations(), logrus.StandardLogger())
+		operationData := "kyma-orchestration,cluster-orchestration"
+
+		// when
+		response, err := lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: operationData})
+		assert.NoError(t, err)
+
+		// then
+		assert.Equal(t, domain.InProgress, response.State)
+
+		// when
+		clusterUpgrade.State = orchestration.Failed
+		assert.NoError(t, memoryStorage.Orchestrations().Update(clusterUpgrade))
+		response, err = lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: operationData})
+		assert.NoError(t, err)
+
+		// then
+		assert.Equal(t, domain.Failed, response.State)
+
+		// when
+		response, err = lastOperationEndpoint.LastOperation(context.TODO(), instID, domain.PollDetails{OperationData: "kyma-orchestration"})
+		assert.NoError(t, err)
+
+		// then
+		assert.Equal(t, domain.Succeeded, response.State)
+
+		// when
+		_, err = lastOperationEndpoint.LastOperation(context.TODO(), "other-instance", domain.PollDetails{OperationData: "kyma-orchestration"})
+
+		// then
+		assert.Error(t, err)
+	})
+}
+
+func fixMaintenanceOrchestration(id string, orchestrationType orchestration.Type, state string) internal.Orchestration {
+	return internal.Orchestration{
+		OrchestrationID: id,
+		Type:            orchestrationType,
+		State:           state,
+		Parameters: orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{{InstanceID: instID}},
+			},
+		},
+	}
 }
 
 func fixOperation() internal.Operation {
@@ -14,8 +14,11 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/dashboard"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/euaccess"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
@@ -43,6 +46,10 @@ type UpdateEndpoint struct {
 	planDefaults PlanDefaults
 
 	dashboardConfig dashboard.Config
+
+	maintenanceUpgrader *MaintenanceUpgrader
+
+	planVisibility PlanVisibility
 }
 
 func NewUpdate(cfg Config,
@@ -56,6 +63,8 @@ func NewUpdate(cfg Config,
 	planDefaults PlanDefaults,
 	log logrus.FieldLogger,
 	dashboardConfig dashboard.Config,
+	maintenanceUpgrader *MaintenanceUpgrader,
+	planVisibility PlanVisibility,
 ) *UpdateEndpoint {
 	return &UpdateEndpoint{
 		config:                    cfg,
@@ -69,13 +78,15 @@ func NewUpdate(cfg Config,
 		updatingQueue:             queue,
 		planDefaults:              planDefaults,
 		dashboardConfig:           dashboardConfig,
+		maintenanceUpgrader:       maintenanceUpgrader,
+		planVisibility:            planVisibility,
 	}
 }
 
 // Update modifies an existing service instance
 //
 //	PATCH /v2/service_instances/{instance_id}
-func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details domain.UpdateDetails, asyncAllowed bool) (domain.UpdateServiceSpec, error) {
+func (b *UpdateEndpoint) Update(ctx context.Context, instanceID string, details domain.UpdateDetails, asyncAllowed bool) (domain.UpdateServiceSpec, error) {
 	logger := b.log.WithField("instanceID", instanceID)
 	logger.Infof("Updating instanceID: %s", instanceID)
 	logger.Infof("Updating asyncAllowed: %v", asyncAllowed)
@@ -129,6 +140,17 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 		}
 	}
 
+	if err := b.config.validateMaintenanceInfo(details.MaintenanceInfo); err != nil {
+		logger.Warnf("maintenance_info %+v does not match the catalog", details.MaintenanceInfo)
+		return domain.UpdateServiceSpec{}, err
+	}
+	if maintenanceUpgradeRequested(details) && !b.processingEnabled {
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("maintenance_info update is not supported"), http.StatusUnprocessableEntity, "")
+	}
+	if planUpgradeRequested(instance, details) && !b.processingEnabled {
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("plan upgrade is not supported"), http.StatusUnprocessableEntity, "")
+	}
+
 	dashboardURL := instance.DashboardURL
 	if b.dashboardConfig.LandscapeURL != "" {
 		dashboardURL = fmt.Sprintf("%s/?kubeconfigID=%s", b.dashboardConfig.LandscapeURL, instanceID)
@@ -144,7 +166,10 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 		// NOTE: KEB currently can't process update parameters in one call along with context update
 		// this block makes it that KEB ignores any parameters updates if context update changed suspension state
 		if !suspendStatusChange && !instance.IsExpired() {
-			return b.processUpdateParameters(instance, details, lastProvisioningOperation, asyncAllowed, ersContext, logger)
+			if maintenanceUpgradeRequested(details) {
+				return b.processMaintenanceUpgrade(instance, details, lastProvisioningOperation, asyncAllowed, logger)
+			}
+			return b.processUpdateParameters(ctx, instance, details, lastProvisioningOperation, asyncAllowed, ersContext, logger)
 		}
 	}
 
@@ -158,14 +183,33 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 	}, nil
 }
 
+// validateHighAvailability rejects changing the high availability of the instance, the zone failure tolerance of the
+// control plane cannot be lowered and the workers of an existing cluster cannot be moved to other zones
+func validateHighAvailability(instance *internal.Instance, params internal.UpdatingParametersDTO) error {
+	enabled := instance.Parameters.Parameters.IsHighAvailability()
+	switch {
+	case params.HighAvailability == nil || *params.HighAvailability == enabled:
+		return nil
+	case enabled:
+		return fmt.Errorf("high availability cannot be disabled")
+	default:
+		return fmt.Errorf("high availability can be enabled only when provisioning the instance")
+	}
+}
+
 func shouldUpdate(instance *internal.Instance, details domain.UpdateDetails, ersContext internal.ERSContext) bool {
-	if len(details.RawParameters) != 0 {
+	if len(details.RawParameters) != 0 || planUpgradeRequested(instance, details) {
 		return true
 	}
 	return ersContext.ERSUpdate()
 }
 
-func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, asyncAllowed bool, ersContext internal.ERSContext, logger logrus.FieldLogger) (domain.UpdateServiceSpec, error) {
+// planUpgradeRequested returns true if the platform requested a change of the instance plan
+func planUpgradeRequested(instance *internal.Instance, details domain.UpdateDetails) bool {
+	return details.PlanID != "" && details.PlanID != instance.ServicePlanID
+}
+
+func (b *UpdateEndpoint) processUpdateParameters(ctx context.Context, instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, asyncAllowed bool, ersContext internal.ERSContext, logger logrus.FieldLogger) (domain.UpdateServiceSpec, error) {
 	if !shouldUpdate(instance, details, ersContext) {
 		logger.Debugf("Parameters not provided, skipping processing update parameters")
 		return domain.UpdateServiceSpec{
@@ -198,11 +242,38 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 		}
 	}
 
-	operationID := uuid.New().String()
-	logger = logger.WithField("operationID", operationID)
+	if err := params.AdditionalWorkerNodePools.Validate(); err != nil {
+		logger.Errorf("invalid additional worker node pools: %s", err.Error())
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if params.MaintenanceWindow != nil {
+		if err := params.MaintenanceWindow.Validate(); err != nil {
+			logger.Errorf("invalid maintenance window: %s", err.Error())
+			return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+
+	if err := validateHighAvailability(instance, params); err != nil {
+		logger.Errorf("invalid high availability parameter: %s", err.Error())
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+
+	planUpgrade := planUpgradeRequested(instance, details)
+	if planUpgrade {
+		platformProvider, _ := middleware.ProviderFromContext(ctx)
+		platformRegion, _ := middleware.RegionFromContext(ctx)
+		scope := planvisibility.Scope{
+			GlobalAccountID: instance.GlobalAccountID,
+			SubAccountID:    instance.SubAccountID,
+			PlatformRegion:  platformRegion,
+		}
+		if err := b.validatePlanUpgrade(instance, details.PlanID, details.RawParameters, platformProvider, euaccess.IsEURestrictedAccess(platformRegion), scope); err != nil {
+			logger.Errorf("invalid plan upgrade to %s: %s", details.PlanID, err.Error())
+			return domain.UpdateServiceSpec{}, err
+		}
+	}
 
-	logger.Debugf("creating update operation %v", params)
-	operation := internal.NewUpdateOperation(operationID, instance, params)
 	planID := instance.Parameters.PlanID
 	if len(details.PlanID) != 0 {
 		planID = details.PlanID
@@ -212,6 +283,29 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 		logger.Errorf("unable to obtain plan defaults: %s", err.Error())
 		return domain.UpdateServiceSpec{}, fmt.Errorf("unable to obtain plan defaults")
 	}
+	if planUpgrade && defaults.GardenerConfig != nil {
+		// the machine type and autoscaler limits of the current plan may not be valid for the target plan
+		p := defaults.GardenerConfig
+		if params.MachineType == nil || *params.MachineType == "" {
+			params.MachineType = &p.MachineType
+		}
+		if params.AutoScalerMin == nil {
+			params.AutoScalerMin = &p.AutoScalerMin
+		}
+		if params.AutoScalerMax == nil {
+			params.AutoScalerMax = &p.AutoScalerMax
+		}
+	}
+
+	operationID := uuid.New().String()
+	logger = logger.WithField("operationID", operationID)
+
+	logger.Debugf("creating update operation %v", params)
+	operation := internal.NewUpdateOperation(operationID, instance, params)
+	if planUpgrade {
+		logger.Infof("Upgrading plan from %s to %s", PlanNamesMapping[instance.ServicePlanID], PlanNamesMapping[details.PlanID])
+		operation.ProvisioningParameters.PlanID = details.PlanID
+	}
 	var autoscalerMin, autoscalerMax int
 	if defaults.GardenerConfig != nil {
 		p := defaults.GardenerConfig
@@ -245,6 +339,20 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	if params.MachineType != nil && *params.MachineType != "" {
 		instance.Parameters.Parameters.MachineType = params.MachineType
 	}
+	if params.AdditionalWorkerNodePools != nil {
+		instance.Parameters.Parameters.AdditionalWorkerNodePools = params.AdditionalWorkerNodePools
+		updateStorage = append(updateStorage, "Additional Worker Node Pools")
+	}
+	if params.MaintenanceWindow != nil {
+		instance.Parameters.Parameters.MaintenanceWindow = params.MaintenanceWindow
+		updateStorage = append(updateStorage, "Maintenance Window")
+	}
+	if planUpgrade {
+		instance.ServicePlanID = details.PlanID
+		instance.ServicePlanName = PlanNamesMapping[details.PlanID]
+		instance.Parameters.PlanID = details.PlanID
+		updateStorage = append(updateStorage, "Plan")
+	}
 	if len(updateStorage) > 0 {
 		if err := wait.Poll(500*time.Millisecond, 2*time.Second, func() (bool, error) {
 			instance, err = b.instanceStorage.Update(*instance)
@@ -272,6 +380,46 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	}, nil
 }
 
+// maintenanceUpgradeRequested returns true if the platform requested a change of the instance maintenance_info
+func maintenanceUpgradeRequested(details domain.UpdateDetails) bool {
+	if details.MaintenanceInfo == nil {
+		return false
+	}
+	previous := details.PreviousValues.MaintenanceInfo
+	return previous == nil || !previous.Equals(*details.MaintenanceInfo)
+}
+
+func (b *UpdateEndpoint) processMaintenanceUpgrade(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, asyncAllowed bool, logger logrus.FieldLogger) (domain.UpdateServiceSpec, error) {
+	if !asyncAllowed {
+		return domain.UpdateServiceSpec{}, apiresponses.ErrAsyncRequired
+	}
+	// NOTE: the upgrade is processed by orchestrations which do not take the update parameters into account
+	if len(details.RawParameters) != 0 {
+		err := fmt.Errorf("maintenance_info update cannot be combined with parameters update")
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if planUpgradeRequested(instance, details) {
+		err := fmt.Errorf("maintenance_info update cannot be combined with plan upgrade")
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+
+	logger.Infof("Upgrading instance from maintenance_info %+v to %+v", details.PreviousValues.MaintenanceInfo, details.MaintenanceInfo)
+	operationData, err := b.maintenanceUpgrader.Upgrade(instance, details.PreviousValues.MaintenanceInfo, *details.MaintenanceInfo, logger)
+	if err != nil {
+		logger.Errorf("unable to start the upgrade: %s", err.Error())
+		return domain.UpdateServiceSpec{}, fmt.Errorf("unable to process the update")
+	}
+
+	return domain.UpdateServiceSpec{
+		IsAsync:       operationData != "",
+		DashboardURL:  instance.DashboardURL,
+		OperationData: operationData,
+		Metadata: domain.InstanceMetadata{
+			Labels: ResponseLabels(*lastProvisioningOperation, *instance, b.config.URL, b.config.EnableKubeconfigURLLabel),
+		},
+	}, nil
+}
+
 func (b *UpdateEndpoint) processContext(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, logger logrus.FieldLogger) (*internal.Instance, bool, error) {
 	var ersContext internal.ERSContext
 	err := json.Unmarshal(details.RawContext, &ersContext)
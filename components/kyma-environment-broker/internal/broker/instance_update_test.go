@@ -15,10 +15,13 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
@@ -65,7 +68,7 @@ func TestUpdateEndpoint_UpdateSuspension(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, &q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, &q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -114,7 +117,7 @@ func TestUpdateEndpoint_UpdateExpirationOfTrial(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -167,7 +170,7 @@ func TestUpdateEndpoint_UpdateExpirationOfExpiredTrial(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -219,7 +222,7 @@ func TestUpdateEndpoint_UpdateOfExpiredTrial(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -261,7 +264,7 @@ func TestUpdateEndpoint_UpdateUnsuspension(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -316,7 +319,7 @@ func TestUpdateEndpoint_UpdateInstanceWithWrongActiveValue(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -344,7 +347,7 @@ func TestUpdateEndpoint_UpdateNonExistingInstance(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -403,7 +406,7 @@ func TestUpdateEndpoint_UpdateGlobalAccountID(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, &q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, &q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -443,7 +446,7 @@ func TestUpdateEndpoint_UpdateParameters(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, &q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, &q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	t.Run("Should fail on invalid OIDC params", func(t *testing.T) {
 		// given
@@ -540,6 +543,160 @@ func TestUpdateEndpoint_UpdateParameters(t *testing.T) {
 		assert.Equal(t, expectedErr.ValidatedStatusCode(nil), apierr.ValidatedStatusCode(nil))
 		assert.Equal(t, expectedErr.LoggerAction(), apierr.LoggerAction())
 	})
+
+	t.Run("Should fail on additional worker node pools with duplicated names", func(t *testing.T) {
+		// given
+		poolParams := `{"name":"mem-worker","machineType":"Standard_D8_v3","autoScalerMin":1,"autoScalerMax":3}`
+		errMsg := fmt.Errorf("name of additional worker node pool mem-worker is not unique")
+		expectedErr := apiresponses.NewFailureResponse(errMsg, http.StatusUnprocessableEntity, errMsg.Error())
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			ServiceID:       "",
+			PlanID:          AzurePlanID,
+			RawParameters:   json.RawMessage("{\"additionalWorkerNodePools\":[" + poolParams + "," + poolParams + "]}"),
+			PreviousValues:  domain.PreviousValues{},
+			RawContext:      json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+			MaintenanceInfo: nil,
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, expectedErr.ValidatedStatusCode(nil), apierr.ValidatedStatusCode(nil))
+		assert.Equal(t, errMsg.Error(), apierr.Error())
+	})
+}
+
+func TestUpdateEndpoint_UpdateHighAvailability(t *testing.T) {
+	// given
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	update := func(svc *UpdateEndpoint, params string) error {
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(params),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+		return err
+	}
+
+	t.Run("Should fail on enabling high availability", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(fixture.FixInstance(instanceID))
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, &automock.Queue{}, planDefaults, logrus.New(), dashboardConfig, nil, nil)
+
+		// when
+		err := update(svc, `{"highAvailability":true}`)
+
+		// then
+		require.Error(t, err)
+		apierr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+		assert.Equal(t, "high availability can be enabled only when provisioning the instance", apierr.Error())
+		instance, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.False(t, instance.Parameters.Parameters.IsHighAvailability())
+	})
+
+	t.Run("Should accept unchanged high availability", func(t *testing.T) {
+		// given
+		instance := fixture.FixInstance(instanceID)
+		instance.Parameters.Parameters.HighAvailability = ptr.Bool(true)
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(instance)
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+		q := &automock.Queue{}
+		q.On("Add", mock.AnythingOfType("string"))
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
+
+		// when
+		err := update(svc, `{"highAvailability":true}`)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should fail on disabling high availability", func(t *testing.T) {
+		// given
+		instance := fixture.FixInstance(instanceID)
+		instance.Parameters.Parameters.HighAvailability = ptr.Bool(true)
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(instance)
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, &automock.Queue{}, planDefaults, logrus.New(), dashboardConfig, nil, nil)
+
+		// when
+		err := update(svc, `{"highAvailability":false}`)
+
+		// then
+		require.Error(t, err)
+		apierr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+		assert.Equal(t, "high availability cannot be disabled", apierr.Error())
+	})
+}
+
+func TestUpdateEndpoint_UpdateMaintenanceWindow(t *testing.T) {
+	// given
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	update := func(svc *UpdateEndpoint, params string) error {
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(params),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+		return err
+	}
+
+	t.Run("Should store maintenance window", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(fixture.FixInstance(instanceID))
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+		q := &automock.Queue{}
+		q.On("Add", mock.AnythingOfType("string"))
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
+
+		// when
+		err := update(svc, `{"maintenanceWindow":{"days":["Sat","Sun"],"begin":"22:00","end":"23:00","timeZone":"Europe/Berlin"}}`)
+
+		// then
+		require.NoError(t, err)
+		instance, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, &internal.MaintenanceWindowDTO{
+			Days:     []string{"Sat", "Sun"},
+			Begin:    "22:00",
+			End:      "23:00",
+			TimeZone: "Europe/Berlin",
+		}, instance.Parameters.Parameters.MaintenanceWindow)
+	})
+
+	t.Run("Should fail on invalid maintenance window", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(fixture.FixInstance(instanceID))
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, &automock.Queue{}, planDefaults, logrus.New(), dashboardConfig, nil, nil)
+
+		// when
+		err := update(svc, `{"maintenanceWindow":{"begin":"22:00","end":"23:00","timeZone":"Europe/Nowhere"}}`)
+
+		// then
+		require.Error(t, err)
+		apierr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+	})
 }
 
 func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
@@ -569,7 +726,7 @@ func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, &q, planDefaults, logrus.New(), dashboardConfig)
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, &q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -591,3 +748,327 @@ func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
 	// check if the API response is correct
 	assert.Regexp(t, `^https:\/\/dashboard\.example\.com\/\?kubeconfigID=`, response.DashboardURL)
 }
+
+func TestUpdateEndpoint_UpdateMaintenanceInfo(t *testing.T) {
+	cfg := Config{EnableMaintenanceInfo: true, KymaVersion: "2.11.0", KubernetesVersion: "1.24.8"}
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	newEndpoint := func(cfg Config) (*UpdateEndpoint, storage.BrokerStorage, *automock.Queue, *automock.Queue) {
+		instance := fixture.FixInstance(instanceID)
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(instance)
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+		kymaQueue, clusterQueue := &automock.Queue{}, &automock.Queue{}
+		upgrader := NewMaintenanceUpgrader(st.Orchestrations(), st.RuntimeStates(), kymaQueue, clusterQueue)
+		svc := NewUpdate(cfg, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, &automock.Queue{}, planDefaults, logrus.New(), dashboardConfig, upgrader, nil)
+		return svc, st, kymaQueue, clusterQueue
+	}
+
+	t.Run("should upgrade Kyma and cluster", func(t *testing.T) {
+		// given
+		svc, st, kymaQueue, clusterQueue := newEndpoint(cfg)
+		kymaQueue.On("Add", mock.AnythingOfType("string")).Once()
+		clusterQueue.On("Add", mock.AnythingOfType("string")).Once()
+
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:          AzurePlanID,
+			RawContext:      json.RawMessage("{}"),
+			PreviousValues:  domain.PreviousValues{MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.10.1+k8s.1.23.9"}},
+			MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.11.0+k8s.1.24.8"},
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, response.IsAsync)
+		kymaQueue.AssertExpectations(t)
+		clusterQueue.AssertExpectations(t)
+
+		orchestrations, _, _, err := st.Orchestrations().List(dbmodel.OrchestrationFilter{})
+		require.NoError(t, err)
+		require.Len(t, orchestrations, 2)
+		for _, o := range orchestrations {
+			assert.Contains(t, response.OperationData, o.OrchestrationID)
+			assert.Equal(t, instanceID, o.Parameters.Targets.Include[0].InstanceID)
+			if o.Type == orchestration.UpgradeKymaOrchestration {
+				assert.Equal(t, "2.11.0", o.Parameters.Kyma.Version)
+			}
+		}
+	})
+
+	t.Run("should upgrade only cluster", func(t *testing.T) {
+		// given
+		svc, st, kymaQueue, clusterQueue := newEndpoint(cfg)
+		clusterQueue.On("Add", mock.AnythingOfType("string")).Once()
+
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:          AzurePlanID,
+			RawContext:      json.RawMessage("{}"),
+			PreviousValues:  domain.PreviousValues{MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.11.0+k8s.1.23.9"}},
+			MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.11.0+k8s.1.24.8"},
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, response.IsAsync)
+		kymaQueue.AssertNotCalled(t, "Add", mock.Anything)
+		clusterQueue.AssertExpectations(t)
+
+		o, err := st.Orchestrations().GetByID(response.OperationData)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.UpgradeClusterOrchestration, o.Type)
+	})
+
+	t.Run("should compare with the runtime versions when previous maintenance_info is not provided", func(t *testing.T) {
+		// given
+		svc, st, kymaQueue, clusterQueue := newEndpoint(cfg)
+		clusterQueue.On("Add", mock.AnythingOfType("string")).Once()
+
+		runtimeID := fixture.FixInstance(instanceID).RuntimeID
+		provisioned := fixture.FixRuntimeState("state-01", runtimeID, "01")
+		provisioned.CreatedAt = time.Now().Add(-time.Hour)
+		provisioned.KymaVersion = "2.10.1"
+		provisioned.ClusterConfig.KubernetesVersion = "1.23.9"
+		upgraded := fixture.FixRuntimeState("state-02", runtimeID, "02")
+		upgraded.KymaVersion = "2.11.0"
+		require.NoError(t, st.RuntimeStates().Insert(provisioned))
+		require.NoError(t, st.RuntimeStates().Insert(upgraded))
+
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:          AzurePlanID,
+			RawContext:      json.RawMessage("{}"),
+			MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.11.0+k8s.1.24.8"},
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, response.IsAsync)
+		kymaQueue.AssertNotCalled(t, "Add", mock.Anything)
+		clusterQueue.AssertExpectations(t)
+
+		o, err := st.Orchestrations().GetByID(response.OperationData)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.UpgradeClusterOrchestration, o.Type)
+	})
+
+	t.Run("should not upgrade when maintenance_info is not changed", func(t *testing.T) {
+		// given
+		svc, _, kymaQueue, clusterQueue := newEndpoint(cfg)
+
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:          AzurePlanID,
+			RawContext:      json.RawMessage("{}"),
+			PreviousValues:  domain.PreviousValues{MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.11.0+k8s.1.24.8"}},
+			MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.11.0+k8s.1.24.8"},
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, response.IsAsync)
+		kymaQueue.AssertNotCalled(t, "Add", mock.Anything)
+		clusterQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+
+	t.Run("should reject maintenance_info update combined with plan upgrade", func(t *testing.T) {
+		// given
+		svc, st, kymaQueue, clusterQueue := newEndpoint(cfg)
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:          GCPPlanID,
+			RawContext:      json.RawMessage("{}"),
+			PreviousValues:  domain.PreviousValues{MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.10.1+k8s.1.23.9"}},
+			MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.11.0+k8s.1.24.8"},
+		}, true)
+
+		// then
+		require.Error(t, err)
+		require.IsType(t, &apiresponses.FailureResponse{}, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+		kymaQueue.AssertNotCalled(t, "Add", mock.Anything)
+		clusterQueue.AssertNotCalled(t, "Add", mock.Anything)
+		orchestrations, _, _, err := st.Orchestrations().List(dbmodel.OrchestrationFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, orchestrations)
+	})
+
+	t.Run("should reject maintenance_info not matching the catalog", func(t *testing.T) {
+		// given
+		svc, _, _, _ := newEndpoint(cfg)
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:          AzurePlanID,
+			RawContext:      json.RawMessage("{}"),
+			MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.12.0+k8s.1.24.8"},
+		}, true)
+
+		// then
+		assert.Equal(t, apiresponses.ErrMaintenanceInfoConflict, err)
+	})
+
+	t.Run("should reject maintenance_info when disabled", func(t *testing.T) {
+		// given
+		svc, _, _, _ := newEndpoint(Config{})
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:          AzurePlanID,
+			RawContext:      json.RawMessage("{}"),
+			MaintenanceInfo: &domain.MaintenanceInfo{Version: "2.11.0+k8s.1.24.8"},
+		}, true)
+
+		// then
+		assert.Equal(t, apiresponses.ErrMaintenanceInfoNilConflict, err)
+	})
+}
+
+func TestUpdateEndpoint_UpdatePlan(t *testing.T) {
+	cfg := Config{
+		EnablePlans: []string{"azure", "azure_lite", "aws", "gcp"},
+	}
+	require.NoError(t, cfg.PlanUpgrades.Unmarshal("azure_lite:azure,azure_lite:aws,azure:gcp"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		if planID == AzurePlanID {
+			return &gqlschema.ClusterConfigInput{
+				GardenerConfig: &gqlschema.GardenerConfigInput{MachineType: "Standard_D8_v3", AutoScalerMin: 3, AutoScalerMax: 20},
+			}, nil
+		}
+		return &gqlschema.ClusterConfigInput{
+			GardenerConfig: &gqlschema.GardenerConfigInput{MachineType: "Standard_D4_v3", AutoScalerMin: 2, AutoScalerMax: 10},
+		}, nil
+	}
+	newEndpoint := func() (*UpdateEndpoint, storage.BrokerStorage, *automock.Queue) {
+		instance := fixture.FixInstance(instanceID)
+		instance.ServicePlanID = AzureLitePlanID
+		instance.ServicePlanName = AzureLitePlanName
+		instance.Parameters.PlanID = AzureLitePlanID
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(instance)
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+		q := &automock.Queue{}
+		svc := NewUpdate(cfg, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, q, planDefaults, logrus.New(), dashboardConfig, nil, nil)
+		return svc, st, q
+	}
+
+	t.Run("should upgrade the plan", func(t *testing.T) {
+		// given
+		svc, st, q := newEndpoint()
+		q.On("Add", mock.AnythingOfType("string")).Once()
+
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawContext:    json.RawMessage("{}"),
+			RawParameters: json.RawMessage(`{"autoScalerMax": 15}`),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, response.IsAsync)
+		q.AssertExpectations(t)
+
+		instance, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, AzurePlanID, instance.ServicePlanID)
+		assert.Equal(t, AzurePlanName, instance.ServicePlanName)
+		assert.Equal(t, AzurePlanID, instance.Parameters.PlanID)
+		assert.Equal(t, "Standard_D8_v3", *instance.Parameters.Parameters.MachineType)
+
+		operation, err := st.Operations().GetOperationByID(response.OperationData)
+		require.NoError(t, err)
+		assert.Equal(t, AzurePlanID, operation.ProvisioningParameters.PlanID)
+		assert.Equal(t, "Standard_D8_v3", *operation.UpdatingParameters.MachineType)
+		assert.Equal(t, 3, *operation.UpdatingParameters.AutoScalerMin)
+		assert.Equal(t, 15, *operation.UpdatingParameters.AutoScalerMax)
+	})
+
+	t.Run("should reject not allowed plan upgrade", func(t *testing.T) {
+		// given
+		svc, _, _ := newEndpoint()
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:     GCPPlanID,
+			RawContext: json.RawMessage("{}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		apierr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("should reject plan upgrade to plan not available for the global account", func(t *testing.T) {
+		// given
+		svc, _, q := newEndpoint()
+		svc.planVisibility = unavailablePlans{AzurePlanName: true}
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:     AzurePlanID,
+			RawContext: json.RawMessage("{}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		apierr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+		assert.Contains(t, err.Error(), "is not available")
+		q.AssertNotCalled(t, "Add", mock.Anything)
+	})
+
+	t.Run("should reject plan upgrade to other hyperscaler", func(t *testing.T) {
+		// given
+		svc, _, _ := newEndpoint()
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:     AWSPlanID,
+			RawContext: json.RawMessage("{}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires the AWS provider")
+	})
+
+	t.Run("should reject parameters not valid for the target plan", func(t *testing.T) {
+		// given
+		svc, _, _ := newEndpoint()
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawContext:    json.RawMessage("{}"),
+			RawParameters: json.RawMessage(`{"machineType": "n2-standard-4"}`),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		apierr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+	})
+}
+
+// unavailablePlans is a PlanVisibility which offers all plans except the given ones
+type unavailablePlans map[string]bool
+
+func (u unavailablePlans) InCatalog(planName, _ string) bool {
+	return !u[planName]
+}
+
+func (u unavailablePlans) Available(planName string, _ planvisibility.Scope) bool {
+	return !u[planName]
+}
+
+func (u unavailablePlans) Features(string, planvisibility.Scope) []string {
+	return nil
+}
@@ -0,0 +1,195 @@
+package broker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+// kubernetesBuildMetadata prefixes the Kubernetes version stored as a build metadata of the maintenance_info version
+const kubernetesBuildMetadata = "k8s."
+
+// MaintenanceInfoVersion returns the maintenance_info version of the Kyma and Kubernetes versions bundle, e.g. 2.11.0+k8s.1.24.8.
+// The Kubernetes version is a build metadata, so only the Kyma version determines the precedence of the bundles.
+func MaintenanceInfoVersion(kymaVersion, kubernetesVersion string) string {
+	if kubernetesVersion == "" {
+		return kymaVersion
+	}
+	return fmt.Sprintf("%s+%s%s", kymaVersion, kubernetesBuildMetadata, kubernetesVersion)
+}
+
+// ParseMaintenanceInfoVersion returns the Kyma and Kubernetes versions of the bundle
+func ParseMaintenanceInfoVersion(version string) (kymaVersion string, kubernetesVersion string) {
+	kymaVersion, metadata, found := strings.Cut(version, "+")
+	if !found {
+		return kymaVersion, ""
+	}
+	return kymaVersion, strings.TrimPrefix(metadata, kubernetesBuildMetadata)
+}
+
+// maintenanceInfo returns the maintenance_info advertised in the catalog, nil if the feature is disabled
+func (c Config) maintenanceInfo() *domain.MaintenanceInfo {
+	if !c.EnableMaintenanceInfo || c.KymaVersion == "" {
+		return nil
+	}
+	return &domain.MaintenanceInfo{
+		Version:     MaintenanceInfoVersion(c.KymaVersion, c.KubernetesVersion),
+		Description: fmt.Sprintf("Kyma %s on Kubernetes %s", c.KymaVersion, c.KubernetesVersion),
+	}
+}
+
+// validateMaintenanceInfo checks if the maintenance_info passed in a request matches the one from the catalog
+func (c Config) validateMaintenanceInfo(requested *domain.MaintenanceInfo) error {
+	if requested == nil {
+		return nil
+	}
+	current := c.maintenanceInfo()
+	if current == nil {
+		return apiresponses.ErrMaintenanceInfoNilConflict
+	}
+	if !current.Equals(*requested) {
+		return apiresponses.ErrMaintenanceInfoConflict
+	}
+	return nil
+}
+
+// MaintenanceUpgrader starts the upgrade orchestrations of a single instance when a platform updates its maintenance_info
+type MaintenanceUpgrader struct {
+	orchestrations storage.Orchestrations
+	runtimeStates  storage.RuntimeStates
+	kymaQueue      Queue
+	clusterQueue   Queue
+}
+
+func NewMaintenanceUpgrader(orchestrations storage.Orchestrations, runtimeStates storage.RuntimeStates, kymaQueue, clusterQueue Queue) *MaintenanceUpgrader {
+	return &MaintenanceUpgrader{
+		orchestrations: orchestrations,
+		runtimeStates:  runtimeStates,
+		kymaQueue:      kymaQueue,
+		clusterQueue:   clusterQueue,
+	}
+}
+
+// Upgrade creates an upgrade kyma orchestration if the Kyma version of the bundle changed and an upgrade cluster orchestration
+// if the Kubernetes version changed. If the platform did not send the previous maintenance_info, the versions stored
+// in the runtime states of the instance are compared instead.
+// The returned operation data contains comma-separated IDs of the created orchestrations.
+func (u *MaintenanceUpgrader) Upgrade(instance *internal.Instance, previous *domain.MaintenanceInfo, requested domain.MaintenanceInfo, log logrus.FieldLogger) (string, error) {
+	kymaVersion, kubernetesVersion := ParseMaintenanceInfoVersion(requested.Version)
+	var previousKymaVersion, previousKubernetesVersion string
+	if previous != nil {
+		previousKymaVersion, previousKubernetesVersion = ParseMaintenanceInfoVersion(previous.Version)
+	} else {
+		var err error
+		previousKymaVersion, previousKubernetesVersion, err = u.currentVersions(instance.RuntimeID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var orchestrationIDs []string
+	if kymaVersion != previousKymaVersion {
+		o := newSingleInstanceOrchestration(orchestration.UpgradeKymaOrchestration, instance.InstanceID)
+		o.Parameters.Kyma = &orchestration.KymaParameters{Version: kymaVersion}
+		if err := u.orchestrations.Insert(o); err != nil {
+			return "", fmt.Errorf("while inserting upgrade kyma orchestration: %w", err)
+		}
+		log.Infof("Created upgrade kyma orchestration %s to version %s", o.OrchestrationID, kymaVersion)
+		u.kymaQueue.Add(o.OrchestrationID)
+		orchestrationIDs = append(orchestrationIDs, o.OrchestrationID)
+	}
+	if kubernetesVersion != previousKubernetesVersion {
+		o := newSingleInstanceOrchestration(orchestration.UpgradeClusterOrchestration, instance.InstanceID)
+		if err := u.orchestrations.Insert(o); err != nil {
+			return "", fmt.Errorf("while inserting upgrade cluster orchestration: %w", err)
+		}
+		log.Infof("Created upgrade cluster orchestration %s to Kubernetes version %s", o.OrchestrationID, kubernetesVersion)
+		u.clusterQueue.Add(o.OrchestrationID)
+		orchestrationIDs = append(orchestrationIDs, o.OrchestrationID)
+	}
+
+	return strings.Join(orchestrationIDs, ","), nil
+}
+
+// currentVersions returns the latest Kyma and Kubernetes versions stored in the runtime states,
+// an empty version means it is unknown and the upgrade is started
+func (u *MaintenanceUpgrader) currentVersions(runtimeID string) (kymaVersion string, kubernetesVersion string, err error) {
+	states, err := u.runtimeStates.ListByRuntimeID(runtimeID)
+	switch {
+	case dberr.IsNotFound(err):
+		return "", "", nil
+	case err != nil:
+		return "", "", fmt.Errorf("while listing runtime states of runtime %s: %w", runtimeID, err)
+	}
+
+	for _, state := range states {
+		if kymaVersion == "" {
+			kymaVersion = state.GetKymaVersion()
+		}
+		if kubernetesVersion == "" {
+			kubernetesVersion = state.ClusterConfig.KubernetesVersion
+		}
+	}
+	return kymaVersion, kubernetesVersion, nil
+}
+
+func newSingleInstanceOrchestration(orchestrationType orchestration.Type, instanceID string) internal.Orchestration {
+	now := time.Now()
+	return internal.Orchestration{
+		OrchestrationID: uuid.New().String(),
+		Type:            orchestrationType,
+		State:           orchestration.Pending,
+		Description:     "queued for processing",
+		Parameters: orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{{InstanceID: instanceID}},
+			},
+			Strategy: orchestration.StrategySpec{
+				Type:     orchestration.ParallelStrategy,
+				Schedule: string(orchestration.Immediate),
+				Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+			},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// maintenanceLastOperation maps the states of the orchestrations started by a maintenance_info update to the OSB last operation state.
+// The update is in progress until all orchestrations are finished and fails if any of them failed or was canceled.
+func maintenanceLastOperation(orchestrations []internal.Orchestration) domain.LastOperation {
+	inProgress, failed := false, false
+	var descriptions []string
+	for _, o := range orchestrations {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", o.Type, o.Description))
+		switch o.State {
+		case orchestration.Failed, orchestration.Canceled:
+			failed = true
+		case orchestration.Succeeded:
+		default:
+			inProgress = true
+		}
+	}
+
+	state := domain.Succeeded
+	switch {
+	case inProgress:
+		state = domain.InProgress
+	case failed:
+		state = domain.Failed
+	}
+	return domain.LastOperation{
+		State:       state,
+		Description: strings.Join(descriptions, "; "),
+	}
+}
@@ -0,0 +1,121 @@
+package broker
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
+
+// PlanUpgrades defines the allowed plan changes of existing instances, maps the source plan ID to the target plan IDs
+type PlanUpgrades map[string][]string
+
+// Unmarshal provides custom parsing of allowed plan changes, e.g. "azure_lite:azure,free:azure,free:aws".
+// Implements envconfig.Unmarshal interface.
+func (p *PlanUpgrades) Unmarshal(in string) error {
+	upgrades := PlanUpgrades{}
+	for _, entry := range strings.Split(in, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		from, to, found := strings.Cut(entry, ":")
+		if !found {
+			return fmt.Errorf("plan upgrade %q must have the <from>:<to> format", entry)
+		}
+		fromID, exists := PlanIDsMapping[from]
+		if !exists {
+			return fmt.Errorf("unrecognized %v plan name", from)
+		}
+		toID, exists := PlanIDsMapping[to]
+		if !exists {
+			return fmt.Errorf("unrecognized %v plan name", to)
+		}
+		for _, planID := range []string{fromID, toID} {
+			if IsTrialPlan(planID) || IsOwnClusterPlan(planID) {
+				return fmt.Errorf("plan upgrade %q is not supported for the %s plan", entry, PlanNamesMapping[planID])
+			}
+		}
+		upgrades[fromID] = append(upgrades[fromID], toID)
+	}
+
+	*p = upgrades
+	return nil
+}
+
+// IsUpdatable returns true if the plan of an instance can be changed
+func (p PlanUpgrades) IsUpdatable(planID string) bool {
+	return len(p[planID]) > 0
+}
+
+// IsAllowed returns true if the plan change is configured
+func (p PlanUpgrades) IsAllowed(fromPlanID, toPlanID string) bool {
+	for _, planID := range p[fromPlanID] {
+		if planID == toPlanID {
+			return true
+		}
+	}
+	return false
+}
+
+// planProviders holds the hyperscalers of the plans bound to a single one
+var planProviders = map[string]internal.CloudProvider{
+	AWSPlanID:       internal.AWS,
+	PreviewPlanID:   internal.AWS,
+	AzurePlanID:     internal.Azure,
+	AzureLitePlanID: internal.Azure,
+	GCPPlanID:       internal.GCP,
+	OpenStackPlanID: internal.Openstack,
+}
+
+// validatePlanUpgrade checks if the instance can be moved to the target plan with the given update parameters
+func (b *UpdateEndpoint) validatePlanUpgrade(instance *internal.Instance, targetPlanID string, rawParameters []byte, platformProvider internal.CloudProvider, euAccessRestricted bool, scope planvisibility.Scope) error {
+	if !b.config.PlanUpgrades.IsAllowed(instance.ServicePlanID, targetPlanID) {
+		err := fmt.Errorf("plan upgrade from %s to %s is not allowed", PlanNamesMapping[instance.ServicePlanID], PlanNamesMapping[targetPlanID])
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if !b.isPlanEnabled(targetPlanID) {
+		err := fmt.Errorf("plan %s is not enabled", PlanNamesMapping[targetPlanID])
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if b.planVisibility != nil && !b.planVisibility.Available(PlanNamesMapping[targetPlanID], scope) {
+		err := fmt.Errorf("plan %s is not available for the global account %s", PlanNamesMapping[targetPlanID], scope.GlobalAccountID)
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if provider, bound := planProviders[targetPlanID]; bound && provider != instance.Provider {
+		err := fmt.Errorf("plan %s requires the %s provider, the instance runs on %s", PlanNamesMapping[targetPlanID], provider, instance.Provider)
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if len(rawParameters) == 0 {
+		return nil
+	}
+	plan := Plans(PlansConfig{}, platformProvider, b.config.IncludeAdditionalParamsInSchema, euAccessRestricted)[targetPlanID]
+	validator, err := jsonschema.NewValidatorFromStringSchema(string(Marshal(plan.Schemas.Instance.Update.Parameters)))
+	if err != nil {
+		return fmt.Errorf("while creating plan validator: %w", err)
+	}
+	result, err := validator.ValidateString(string(rawParameters))
+	if err != nil {
+		return fmt.Errorf("while executing JSON schema validator: %w", err)
+	}
+	if !result.Valid {
+		err := fmt.Errorf("while validating input parameters against the %s plan: %w", PlanNamesMapping[targetPlanID], result.Error)
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	return nil
+}
+
+func (b *UpdateEndpoint) isPlanEnabled(planID string) bool {
+	for _, planName := range b.config.EnablePlans {
+		if PlanIDsMapping[planName] == planID {
+			return true
+		}
+	}
+	return false
+}
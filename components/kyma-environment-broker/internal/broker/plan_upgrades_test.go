@@ -0,0 +1,44 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanUpgrades_Unmarshal(t *testing.T) {
+	t.Run("should parse plan upgrades", func(t *testing.T) {
+		// given
+		var upgrades PlanUpgrades
+
+		// when
+		err := upgrades.Unmarshal("azure_lite:azure, free:azure,free:aws")
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, upgrades.IsAllowed(AzureLitePlanID, AzurePlanID))
+		assert.True(t, upgrades.IsAllowed(FreemiumPlanID, AWSPlanID))
+		assert.False(t, upgrades.IsAllowed(AzurePlanID, AzureLitePlanID))
+		assert.True(t, upgrades.IsUpdatable(FreemiumPlanID))
+		assert.False(t, upgrades.IsUpdatable(AzurePlanID))
+	})
+
+	for name, in := range map[string]string{
+		"unknown plan":     "azure_lite:azure_premium",
+		"missing target":   "azure_lite",
+		"trial plan":       "trial:azure",
+		"own cluster plan": "azure:own_cluster",
+	} {
+		t.Run("should reject "+name, func(t *testing.T) {
+			// given
+			var upgrades PlanUpgrades
+
+			// when
+			err := upgrades.Unmarshal(in)
+
+			// then
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package broker
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+)
+
+// PlanVisibility decides which plans are offered to a global account, subaccount or platform region and which plan
+// features are enabled for them. A nil PlanVisibility offers all enabled plans without any features.
+type PlanVisibility interface {
+	InCatalog(planName, platformRegion string) bool
+	Available(planName string, scope planvisibility.Scope) bool
+	Features(planName string, scope planvisibility.Scope) []string
+}
+
+func hasFeature(features []string, feature string) bool {
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
@@ -76,84 +76,78 @@ type JSONSchemaValidator interface {
 }
 
 func AzureRegions(euRestrictedAccess bool) []string {
-	if euRestrictedAccess {
-		return []string{
-			"switzerlandnorth",
-		}
-	}
-	return []string{
-		"eastus",
-		"centralus",
-		"westus2",
-		"uksouth",
-		"northeurope",
-		"westeurope",
-		"japaneast",
-		"southeastasia",
-	}
+	return ActiveCatalog().plan(AzurePlanName).regions(euRestrictedAccess)
 }
 
 func GCPRegions() []string {
-	return []string{
-		"europe-west3",
-		"asia-south1",
-		"us-central1"}
+	return ActiveCatalog().plan(GCPPlanName).regions(false)
 }
 
 func AWSRegions(euRestrictedAccess bool) []string {
-	// be aware of zones defined in internal/provider/aws_provider.go
-	if euRestrictedAccess {
-		return []string{"eu-central-1"}
-	}
-	return []string{"eu-central-1", "eu-west-2", "ca-central-1", "sa-east-1", "us-east-1", "us-west-1",
-		"ap-northeast-1", "ap-northeast-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2"}
+	return ActiveCatalog().plan(AWSPlanName).regions(euRestrictedAccess)
 }
 
 func OpenStackRegions() []string {
-	return []string{"eu-de-1", "ap-sa-1"}
+	return ActiveCatalog().plan(OpenStackPlanName).regions(false)
 }
 
 func OpenStackSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, OpenStackRegions(), update)
-	properties.AutoScalerMax.Maximum = 40
-	if !update {
-		properties.AutoScalerMax.Default = 8
-	}
+	properties.AutoScalerMax.Maximum = planAutoScalerBounds[OpenStackPlanName].max
+	properties.HighAvailability = nil
+	setAutoScalerDefaults(&properties, OpenStackPlanName, update)
 
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
 func GCPSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, GCPRegions(), update)
-	properties.AutoScalerMax.Minimum = 3
-	properties.AutoScalerMin.Minimum = 3
+	properties.AutoScalerMax.Minimum = planAutoScalerBounds[GCPPlanName].min
+	properties.AutoScalerMin.Minimum = planAutoScalerBounds[GCPPlanName].min
+	setAutoScalerDefaults(&properties, GCPPlanName, update)
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
 func AWSSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AWSRegions(euAccessRestricted), update)
-	properties.AutoScalerMax.Minimum = 3
-	properties.AutoScalerMin.Minimum = 3
+	properties.AutoScalerMax.Minimum = planAutoScalerBounds[AWSPlanName].min
+	properties.AutoScalerMin.Minimum = planAutoScalerBounds[AWSPlanName].min
+	setAutoScalerDefaults(&properties, AWSPlanName, update)
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
 func AzureSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool) *map[string]interface{} {
 	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AzureRegions(euAccessRestricted), update)
-	properties.AutoScalerMax.Minimum = 3
-	properties.AutoScalerMin.Minimum = 3
+	properties.AutoScalerMax.Minimum = planAutoScalerBounds[AzurePlanName].min
+	properties.AutoScalerMin.Minimum = planAutoScalerBounds[AzurePlanName].min
+	setAutoScalerDefaults(&properties, AzurePlanName, update)
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
 func AzureLiteSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AzureRegions(euAccessRestricted), update)
-	properties.AutoScalerMax.Maximum = 40
+	regions := ActiveCatalog().plan(AzureLitePlanName).regions(euAccessRestricted)
+	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, regions, update)
+	properties.AutoScalerMax.Maximum = planAutoScalerBounds[AzureLitePlanName].max
+	properties.HighAvailability = nil
+	properties.AdditionalWorkerNodePools = nil
+	properties.Networking = nil
+	setAutoScalerDefaults(&properties, AzureLitePlanName, update)
+
+	return createSchemaWithProperties(properties, additionalParams, update)
+}
 
-	if !update {
-		properties.AutoScalerMax.Default = 10
-		properties.AutoScalerMin.Default = 2
+// setAutoScalerDefaults sets the default autoscaler values of the plan catalog in the provisioning schema
+func setAutoScalerDefaults(properties *ProvisioningProperties, planName string, update bool) {
+	if update {
+		return
 	}
+	properties.AutoScalerMin.Default, properties.AutoScalerMax.Default = AutoScalerDefaults(planName)
+}
 
-	return createSchemaWithProperties(properties, additionalParams, update)
+// AutoScalerDefaults returns the default autoscaler minimum and maximum of the plan catalog
+func AutoScalerDefaults(planName string) (int, int) {
+	plan := ActiveCatalog().plan(planName)
+	return plan.AutoScalerMin, plan.AutoScalerMax
 }
 
 func FreemiumSchema(provider internal.CloudProvider, additionalParams, update bool, euAccessRestricted bool) *map[string]interface{} {
@@ -258,61 +252,34 @@ func unmarshalSchema(schema *RootSchema) *map[string]interface{} {
 	return unmarshaled
 }
 
-// Plans is designed to hold plan defaulting logic
-// keep internal/hyperscaler/azure/config.go in sync with any changes to available zones
+// Plans is designed to hold plan defaulting logic, the machine types, regions and default autoscaler values come from the active catalog
 func Plans(plans PlansConfig, provider internal.CloudProvider, includeAdditionalParamsInSchema bool, euAccessRestricted bool) map[string]domain.ServicePlan {
-	awsMachines := []string{"m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge", "m6i.xlarge", "m6i.2xlarge", "m6i.4xlarge", "m6i.8xlarge", "m6i.12xlarge"}
-	awsMachinesDisplay := map[string]string{
-		// source: https://aws.amazon.com/ec2/instance-types/m5/
-		"m5.xlarge":   "m5.xlarge (4vCPU, 16GB RAM)",
-		"m5.2xlarge":  "m5.2xlarge (8vCPU, 32GB RAM)",
-		"m5.4xlarge":  "m5.4xlarge (16vCPU, 64GB RAM)",
-		"m5.8xlarge":  "m5.8xlarge (32vCPU, 128GB RAM)",
-		"m5.12xlarge": "m5.12xlarge (48vCPU, 192GB RAM)",
-		// source: https://aws.amazon.com/ec2/instance-types/m6i/
-		"m6i.xlarge":   "m6i.xlarge (4vCPU, 16GB RAM)",
-		"m6i.2xlarge":  "m6i.2xlarge (8vCPU, 32GB RAM)",
-		"m6i.4xlarge":  "m6i.4xlarge (16vCPU, 64GB RAM)",
-		"m6i.8xlarge":  "m6i.8xlarge (32vCPU, 128GB RAM)",
-		"m6i.12xlarge": "m6i.12xlarge (48vCPU, 192GB RAM)",
-	}
+	catalog := ActiveCatalog()
+
+	aws := catalog.plan(AWSPlanName)
+	awsMachines := aws.MachineTypes
+	awsMachinesDisplay := aws.display(awsMachines)
 
 	// awsHASchema := AWSHASchema(awsMachinesDisplay, awsMachines, includeAdditionalParamsInSchema, false)
 
-	// source: https://cloud.google.com/compute/docs/general-purpose-machines#e2_limitations
-	gcpMachines := []string{"n2-standard-4", "n2-standard-8", "n2-standard-16", "n2-standard-32", "n2-standard-48"}
-	gcpMachinesDisplay := map[string]string{
-		"n2-standard-4":  "n2-standard-4 (4vCPU, 16GB RAM)",
-		"n2-standard-8":  "n2-standard-8 (8vCPU, 32GB RAM)",
-		"n2-standard-16": "n2-standard-16 (16vCPU, 64GB RAM)",
-		"n2-standard-32": "n2-standard-32 (32vCPU, 128GB RAM)",
-		"n2-standard-48": "n2-standard-48 (48vCPU, 192B RAM)",
-	}
+	gcp := catalog.plan(GCPPlanName)
+	gcpMachines := gcp.MachineTypes
+	gcpMachinesDisplay := gcp.display(gcpMachines)
 	gcpSchema := GCPSchema(gcpMachinesDisplay, gcpMachines, includeAdditionalParamsInSchema, false)
 
-	openStackMachines := []string{"g_c4_m16", "g_c8_m32"}
-	openStackMachinesDisplay := map[string]string{
-		"g_c4_m16": "g_c4_m16 (4vCPU, 16GB RAM)",
-		"g_c8_m32": "g_c8_m32 (8vCPU, 32GB RAM)",
-	}
+	openStack := catalog.plan(OpenStackPlanName)
+	openStackMachines := openStack.MachineTypes
+	openStackMachinesDisplay := openStack.display(openStackMachines)
 	openstackSchema := OpenStackSchema(openStackMachinesDisplay, openStackMachines, includeAdditionalParamsInSchema, false)
 
-	// source: https://docs.microsoft.com/en-us/azure/cloud-services/cloud-services-sizes-specs#dv3-series
-	azureMachines := []string{"Standard_D4_v3", "Standard_D8_v3", "Standard_D16_v3", "Standard_D32_v3", "Standard_D48_v3", "Standard_D64_v3"}
-	azureMachinesDisplay := map[string]string{
-		"Standard_D4_v3":  "Standard_D4_v3 (4vCPU, 16GB RAM)",
-		"Standard_D8_v3":  "Standard_D8_v3 (8vCPU, 32GB RAM)",
-		"Standard_D16_v3": "Standard_D16_v3 (16vCPU, 64GB RAM)",
-		"Standard_D32_v3": "Standard_D32_v3 (32vCPU, 128GB RAM)",
-		"Standard_D48_v3": "Standard_D48_v3 (48vCPU, 192GB RAM)",
-		"Standard_D64_v3": "Standard_D64_v3 (64vCPU, 256GB RAM)",
-	}
+	azure := catalog.plan(AzurePlanName)
+	azureMachines := azure.MachineTypes
+	azureMachinesDisplay := azure.display(azureMachines)
 	azureSchema := AzureSchema(azureMachinesDisplay, azureMachines, includeAdditionalParamsInSchema, false, euAccessRestricted)
 
-	azureLiteMachines := []string{"Standard_D4_v3"}
-	azureLiteMachinesDisplay := map[string]string{
-		"Standard_D4_v3": azureMachinesDisplay["Standard_D4_v3"],
-	}
+	azureLite := catalog.plan(AzureLitePlanName)
+	azureLiteMachines := azureLite.MachineTypes
+	azureLiteMachinesDisplay := azureLite.display(azureLiteMachines)
 	azureLiteSchema := AzureLiteSchema(azureLiteMachinesDisplay, azureLiteMachines, includeAdditionalParamsInSchema, false, euAccessRestricted)
 	freemiumSchema := FreemiumSchema(provider, includeAdditionalParamsInSchema, false, euAccessRestricted)
 	trialSchema := TrialSchema(includeAdditionalParamsInSchema, false)
@@ -320,15 +287,8 @@ func Plans(plans PlansConfig, provider internal.CloudProvider, includeAdditional
 
 	// Schemas exposed on v2/catalog endpoint - different than provisioningRawSchema to allow backwards compatibility
 	// when a machine type switch is introduced
-	// switch to m6 if m6 is available in all regions
-	awsCatalogMachines := []string{"m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge"}
-	awsCatalogMachinesDisplay := map[string]string{
-		"m5.xlarge":   awsMachinesDisplay["m5.xlarge"],
-		"m5.2xlarge":  awsMachinesDisplay["m5.2xlarge"],
-		"m5.4xlarge":  awsMachinesDisplay["m5.4xlarge"],
-		"m5.8xlarge":  awsMachinesDisplay["m5.8xlarge"],
-		"m5.12xlarge": awsMachinesDisplay["m5.12xlarge"],
-	}
+	awsCatalogMachines := aws.catalogMachineTypes()
+	awsCatalogMachinesDisplay := aws.display(awsCatalogMachines)
 	awsCatalogSchema := AWSSchema(awsCatalogMachinesDisplay, awsCatalogMachines, includeAdditionalParamsInSchema, false, euAccessRestricted)
 
 	outputPlans := map[string]domain.ServicePlan{
@@ -1,6 +1,17 @@
 package broker
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
+
+const (
+	// Matches IPv4 CIDR notation, for example 10.250.0.0/16
+	cidrPattern = "^([0-9]{1,3}\\.){3}[0-9]{1,3}/([0-9]|[1-2][0-9]|3[0-2])$"
+	// Matches time of the day in HH:MM notation, for example 22:00
+	timeOfDayPattern = "^([0-1][0-9]|2[0-3]):[0-5][0-9]$"
+)
 
 type RootSchema struct {
 	Schema string `json:"$schema"`
@@ -21,6 +32,9 @@ type ProvisioningProperties struct {
 	ShootName   *Type    `json:"shootName,omitempty"`
 	ShootDomain *Type    `json:"shootDomain,omitempty"`
 	Region      *Type    `json:"region,omitempty"`
+
+	HighAvailability *Type           `json:"highAvailability,omitempty"`
+	Networking       *NetworkingType `json:"networking,omitempty"`
 }
 
 type UpdateProperties struct {
@@ -30,6 +44,9 @@ type UpdateProperties struct {
 	OIDC           *OIDCType `json:"oidc,omitempty"`
 	Administrators *Type     `json:"administrators,omitempty"`
 	MachineType    *Type     `json:"machineType,omitempty"`
+
+	AdditionalWorkerNodePools *AdditionalWorkerNodePoolsType `json:"additionalWorkerNodePools,omitempty"`
+	MaintenanceWindow         *MaintenanceWindowType         `json:"maintenanceWindow,omitempty"`
 }
 
 func (up *UpdateProperties) IncludeAdditional() {
@@ -52,6 +69,48 @@ type OIDCType struct {
 	Required   []string       `json:"required"`
 }
 
+type AdditionalWorkerNodePoolsType struct {
+	Type
+	Items AdditionalWorkerNodePoolType `json:"items"`
+}
+
+type AdditionalWorkerNodePoolType struct {
+	Type
+	Properties AdditionalWorkerNodePoolProperties `json:"properties"`
+	Required   []string                           `json:"required"`
+}
+
+type AdditionalWorkerNodePoolProperties struct {
+	Name          Type `json:"name"`
+	MachineType   Type `json:"machineType"`
+	AutoScalerMin Type `json:"autoScalerMin"`
+	AutoScalerMax Type `json:"autoScalerMax"`
+}
+
+type NetworkingType struct {
+	Type
+	Properties NetworkingProperties `json:"properties"`
+}
+
+type NetworkingProperties struct {
+	Pods     Type `json:"pods"`
+	Services Type `json:"services"`
+	Nodes    Type `json:"nodes"`
+}
+
+type MaintenanceWindowType struct {
+	Type
+	Properties MaintenanceWindowProperties `json:"properties"`
+	Required   []string                    `json:"required"`
+}
+
+type MaintenanceWindowProperties struct {
+	Days     Type `json:"days"`
+	Begin    Type `json:"begin"`
+	End      Type `json:"end"`
+	TimeZone Type `json:"timeZone"`
+}
+
 type Type struct {
 	Type        string `json:"type"`
 	Title       string `json:"title,omitempty"`
@@ -147,12 +206,16 @@ func NewProvisioningProperties(machineTypesDisplay map[string]string, machineTyp
 				Enum:            ToInterfaceSlice(machineTypes),
 				EnumDisplayName: machineTypesDisplay,
 			},
+			AdditionalWorkerNodePools: NewAdditionalWorkerNodePoolsSchema(machineTypesDisplay, machineTypes),
+			MaintenanceWindow:         NewMaintenanceWindowSchema(),
 		},
 		Name: NameProperty(),
 		Region: &Type{
 			Type: "string",
 			Enum: ToInterfaceSlice(regions),
 		},
+		HighAvailability: HighAvailabilityProperty(),
+		Networking:       NewNetworkingSchema(),
 	}
 
 	if update {
@@ -184,6 +247,78 @@ func NewOIDCSchema() *OIDCType {
 	}
 }
 
+func NewAdditionalWorkerNodePoolsSchema(machineTypesDisplay map[string]string, machineTypes []string) *AdditionalWorkerNodePoolsType {
+	uniqueItems := true
+	return &AdditionalWorkerNodePoolsType{
+		Type: Type{
+			Type:        "array",
+			Description: "Specifies the list of additional worker node pools created next to the default one",
+			UniqueItems: &uniqueItems,
+		},
+		Items: AdditionalWorkerNodePoolType{
+			Type: Type{Type: "object"},
+			Properties: AdditionalWorkerNodePoolProperties{
+				Name: Type{
+					Type:        "string",
+					Description: "Specifies the unique name of the worker node pool",
+					Pattern:     internal.WorkerNodePoolNamePattern,
+					MinLength:   1,
+					MaxLength:   internal.MaxWorkerNodePoolNameLength,
+				},
+				MachineType: Type{
+					Type:            "string",
+					Enum:            ToInterfaceSlice(machineTypes),
+					EnumDisplayName: machineTypesDisplay,
+				},
+				AutoScalerMin: Type{
+					Type:        "integer",
+					Description: "Specifies the minimum number of virtual machines in the worker node pool",
+				},
+				AutoScalerMax: Type{
+					Type:        "integer",
+					Minimum:     1,
+					Maximum:     80,
+					Description: "Specifies the maximum number of virtual machines in the worker node pool",
+				},
+			},
+			Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
+		},
+	}
+}
+
+func NewNetworkingSchema() *NetworkingType {
+	return &NetworkingType{
+		Type: Type{Type: "object", Description: "Custom network ranges of the cluster. The ranges must not overlap with each other"},
+		Properties: NetworkingProperties{
+			Pods:     Type{Type: "string", Pattern: cidrPattern, Description: "Specifies the CIDR range of the pods"},
+			Services: Type{Type: "string", Pattern: cidrPattern, Description: "Specifies the CIDR range of the services"},
+			Nodes:    Type{Type: "string", Pattern: cidrPattern, Description: "Specifies the CIDR range of the nodes. It must contain the range of the worker nodes subnet"},
+		},
+	}
+}
+
+func NewMaintenanceWindowSchema() *MaintenanceWindowType {
+	uniqueItems := true
+	return &MaintenanceWindowType{
+		Type: Type{Type: "object", Description: "Specifies the preferred maintenance window of the cluster. Orchestrated upgrades are scheduled within this window"},
+		Properties: MaintenanceWindowProperties{
+			Days: Type{
+				Type:        "array",
+				Description: "Specifies the days of the week on which the maintenance is allowed. If not provided, all days are allowed",
+				Items: &Type{
+					Type: "string",
+					Enum: ToInterfaceSlice([]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}),
+				},
+				UniqueItems: &uniqueItems,
+			},
+			Begin:    Type{Type: "string", Pattern: timeOfDayPattern, Description: "Specifies the beginning of the window in the HH:MM format"},
+			End:      Type{Type: "string", Pattern: timeOfDayPattern, Description: "Specifies the end of the window in the HH:MM format"},
+			TimeZone: Type{Type: "string", Description: "Specifies the IANA time zone of the window, for example, Europe/Berlin. If not provided, UTC is used"},
+		},
+		Required: []string{"begin", "end"},
+	}
+}
+
 func NewSchemaWithOnlyNameRequired(properties interface{}, update bool) *RootSchema {
 	return NewSchemaForOwnCluster(properties, update, []string{"name"})
 }
@@ -218,7 +353,7 @@ func unmarshalOrPanic(from, to interface{}) interface{} {
 }
 
 func DefaultControlsOrder() []string {
-	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "oidc", "administrators"}
+	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "highAvailability", "additionalWorkerNodePools", "networking", "maintenanceWindow", "oidc", "administrators"}
 }
 
 func ToInterfaceSlice(input []string) []interface{} {
@@ -229,6 +364,14 @@ func ToInterfaceSlice(input []string) []interface{} {
 	return interfaces
 }
 
+func HighAvailabilityProperty() *Type {
+	return &Type{
+		Type:        "boolean",
+		Title:       "High availability",
+		Description: "Enables the zone failure tolerant control plane and spreads the worker nodes across three zones, can be enabled only when provisioning",
+	}
+}
+
 func AdministratorsProperty() *Type {
 	return &Type{
 		Type:        "array",
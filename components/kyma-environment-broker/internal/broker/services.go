@@ -3,11 +3,14 @@ package broker
 import (
 	"context"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/euaccess"
 
 	"fmt"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/sirupsen/logrus"
@@ -22,11 +25,12 @@ type ServicesEndpoint struct {
 	log            logrus.FieldLogger
 	cfg            Config
 	servicesConfig ServicesConfig
+	planVisibility PlanVisibility
 
 	enabledPlanIDs map[string]struct{}
 }
 
-func NewServices(cfg Config, servicesConfig ServicesConfig, log logrus.FieldLogger) *ServicesEndpoint {
+func NewServices(cfg Config, servicesConfig ServicesConfig, log logrus.FieldLogger, planVisibility PlanVisibility) *ServicesEndpoint {
 	enabledPlanIDs := map[string]struct{}{}
 	for _, planName := range cfg.EnablePlans {
 		id := PlanIDsMapping[planName]
@@ -37,6 +41,7 @@ func NewServices(cfg Config, servicesConfig ServicesConfig, log logrus.FieldLogg
 		log:            log.WithField("service", "ServicesEndpoint"),
 		cfg:            cfg,
 		servicesConfig: servicesConfig,
+		planVisibility: planVisibility,
 		enabledPlanIDs: enabledPlanIDs,
 	}
 }
@@ -47,19 +52,36 @@ func NewServices(cfg Config, servicesConfig ServicesConfig, log logrus.FieldLogg
 func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, error) {
 	var availableServicePlans []domain.ServicePlan
 	// we scope to the kymaruntime service only
-	class, ok := b.servicesConfig[KymaServiceName]
+	class, ok := b.services()[KymaServiceName]
 	if !ok {
 		return nil, fmt.Errorf("while getting %s class data", KymaServiceName)
 	}
 
 	provider, ok := middleware.ProviderFromContext(ctx)
 	platformRegion, ok := middleware.RegionFromContext(ctx)
-	for _, plan := range Plans(class.Plans, provider, b.cfg.IncludeAdditionalParamsInSchema, euaccess.IsEURestrictedAccess(platformRegion)) {
+	euAccessRestricted := euaccess.IsEURestrictedAccess(platformRegion)
+	var plansWithAdditionalParams map[string]domain.ServicePlan
+	for _, plan := range Plans(class.Plans, provider, b.cfg.IncludeAdditionalParamsInSchema, euAccessRestricted) {
 		// filter out not enabled plans
 		if _, exists := b.enabledPlanIDs[plan.ID]; !exists {
 			continue
 		}
+		if b.planVisibility != nil {
+			if !b.planVisibility.InCatalog(plan.Name, platformRegion) {
+				continue
+			}
+			// the additionalParams feature enabled for the platform region replaces the plan schemas
+			features := b.planVisibility.Features(plan.Name, planvisibility.Scope{PlatformRegion: platformRegion})
+			if !b.cfg.IncludeAdditionalParamsInSchema && hasFeature(features, planvisibility.AdditionalParamsFeature) {
+				if plansWithAdditionalParams == nil {
+					plansWithAdditionalParams = Plans(class.Plans, provider, true, euAccessRestricted)
+				}
+				plan = plansWithAdditionalParams[plan.ID]
+			}
+		}
 		// p := plan.PlanDefinition
+		plan.MaintenanceInfo = b.cfg.maintenanceInfo()
+		plan.PlanUpdatable = ptr.Bool(b.cfg.PlanUpgrades.IsUpdatable(plan.ID))
 
 		availableServicePlans = append(availableServicePlans, plan)
 	}
@@ -75,7 +97,8 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 				"SAP",
 				"Kyma",
 			},
-			Plans: availableServicePlans,
+			PlanUpdatable: len(b.cfg.PlanUpgrades) > 0,
+			Plans:         availableServicePlans,
 			Metadata: &domain.ServiceMetadata{
 				DisplayName:         class.Metadata.DisplayName,
 				ImageUrl:            class.Metadata.ImageUrl,
@@ -88,3 +111,12 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 		},
 	}, nil
 }
+
+// services returns the services of the catalog file loaded by the CatalogWatcher, or the services passed to the endpoint
+// if no catalog file is loaded
+func (b *ServicesEndpoint) services() ServicesConfig {
+	if services := ActiveCatalog().Services; services != nil {
+		return services
+	}
+	return b.servicesConfig
+}
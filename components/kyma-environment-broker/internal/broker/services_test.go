@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -31,7 +33,7 @@ func TestServices_Services(t *testing.T) {
 				},
 			},
 		}
-		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger())
+		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger(), nil)
 
 		// when
 		services, err := servicesEndpoint.Services(context.TODO())
@@ -63,7 +65,7 @@ func TestServices_Services(t *testing.T) {
 				},
 			},
 		}
-		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger())
+		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger(), nil)
 
 		// when
 		services, err := servicesEndpoint.Services(context.TODO())
@@ -99,7 +101,7 @@ func TestServices_Services(t *testing.T) {
 				},
 			},
 		}
-		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger())
+		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger(), nil)
 
 		// when
 		services, err := servicesEndpoint.Services(context.TODO())
@@ -115,6 +117,95 @@ func TestServices_Services(t *testing.T) {
 		assertPlansContainPropertyInSchemas(t, services[0], "oidc")
 		assertPlansContainPropertyInSchemas(t, services[0], "administrators")
 	})
+	t.Run("should advertise maintenance_info", func(t *testing.T) {
+		// given
+		cfg := broker.Config{
+			EnablePlans:           []string{"gcp", "azure"},
+			EnableMaintenanceInfo: true,
+			KymaVersion:           "2.11.0",
+			KubernetesVersion:     "1.24.8",
+		}
+		servicesConfig := map[string]broker.Service{
+			broker.KymaServiceName: {},
+		}
+		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger(), nil)
+
+		// when
+		services, err := servicesEndpoint.Services(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, services[0].Plans, 2)
+		for _, plan := range services[0].Plans {
+			require.NotNil(t, plan.MaintenanceInfo)
+			assert.Equal(t, "2.11.0+k8s.1.24.8", plan.MaintenanceInfo.Version)
+		}
+	})
+	t.Run("should mark updatable plans", func(t *testing.T) {
+		// given
+		cfg := broker.Config{
+			EnablePlans: []string{"azure", "azure_lite"},
+		}
+		require.NoError(t, cfg.PlanUpgrades.Unmarshal("azure_lite:azure"))
+		servicesConfig := map[string]broker.Service{
+			broker.KymaServiceName: {},
+		}
+		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger(), nil)
+
+		// when
+		services, err := servicesEndpoint.Services(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, services[0].PlanUpdatable)
+		for _, plan := range services[0].Plans {
+			require.NotNil(t, plan.PlanUpdatable)
+			assert.Equal(t, plan.ID == broker.AzureLitePlanID, *plan.PlanUpdatable)
+		}
+	})
+	t.Run("should apply plan visibility and features", func(t *testing.T) {
+		// given
+		cfg := broker.Config{
+			EnablePlans: []string{"aws", "preview", "own_cluster"},
+		}
+		servicesConfig := map[string]broker.Service{
+			broker.KymaServiceName: {},
+		}
+		visibility := fakePlanVisibility{
+			hidden:   map[string]bool{broker.OwnClusterPlanName: true},
+			features: map[string][]string{broker.PreviewPlanName: {planvisibility.AdditionalParamsFeature}},
+		}
+		servicesEndpoint := broker.NewServices(cfg, servicesConfig, logrus.StandardLogger(), visibility)
+
+		// when
+		services, err := servicesEndpoint.Services(middleware.AddRegionToCtx(context.TODO(), "cf-eu10"))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, services[0].Plans, 2)
+		for _, plan := range services[0].Plans {
+			properties := plan.Schemas.Instance.Create.Parameters[broker.PropertiesKey].(map[string]interface{})
+			_, exists := properties["oidc"]
+			assert.Equal(t, plan.Name == broker.PreviewPlanName, exists)
+		}
+	})
+}
+
+type fakePlanVisibility struct {
+	hidden   map[string]bool
+	features map[string][]string
+}
+
+func (f fakePlanVisibility) InCatalog(planName, _ string) bool {
+	return !f.hidden[planName]
+}
+
+func (f fakePlanVisibility) Available(planName string, _ planvisibility.Scope) bool {
+	return !f.hidden[planName]
+}
+
+func (f fakePlanVisibility) Features(planName string, _ planvisibility.Scope) []string {
+	return f.features[planName]
 }
 
 func assertPlansContainPropertyInSchemas(t *testing.T, service domain.Service, property string) {
@@ -2,6 +2,7 @@ package cis
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
@@ -38,19 +39,30 @@ func NewSubAccountCleanupService(client CisClient, brokerClient BrokerClient, st
 }
 
 func (ac *SubAccountCleanupService) Run() error {
+	_, err := ac.PerformCleanup()
+	return err
+}
+
+// PerformCleanup deprovisions the instances of the deleted subaccounts and returns the IDs of the instances with triggered deprovisioning
+func (ac *SubAccountCleanupService) PerformCleanup() ([]string, error) {
 	subaccounts, err := ac.client.FetchSubAccountsToDelete()
 	if err != nil {
-		return fmt.Errorf("while fetching subaccounts by client: %w", err)
+		return nil, fmt.Errorf("while fetching subaccounts by client: %w", err)
 	}
 
 	subaccountsBatch := chunk(ac.chunksAmount, subaccounts)
 	chunks := len(subaccountsBatch)
+	if chunks == 0 {
+		ac.log.Info("No subaccounts to clean up")
+		return nil, nil
+	}
 	errCh := make(chan error)
 	done := make(chan struct{})
 	var isDone bool
+	deprovisioned := &deprovisionedInstances{}
 
 	for _, chunk := range subaccountsBatch {
-		go ac.executeDeprovisioning(chunk, done, errCh)
+		go ac.executeDeprovisioning(chunk, deprovisioned, done, errCh)
 	}
 
 	for !isDone {
@@ -66,10 +78,21 @@ func (ac *SubAccountCleanupService) Run() error {
 	}
 
 	ac.log.Info("SubAccount cleanup process finished")
-	return nil
+	return deprovisioned.ids, nil
+}
+
+type deprovisionedInstances struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (d *deprovisionedInstances) add(instanceID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.ids = append(d.ids, instanceID)
 }
 
-func (ac *SubAccountCleanupService) executeDeprovisioning(subaccounts []string, done chan<- struct{}, errCh chan<- error) {
+func (ac *SubAccountCleanupService) executeDeprovisioning(subaccounts []string, deprovisioned *deprovisionedInstances, done chan<- struct{}, errCh chan<- error) {
 	instances, err := ac.storage.FindAllInstancesForSubAccounts(subaccounts)
 	if err != nil {
 		errCh <- fmt.Errorf("while finding all instances by subaccounts: %w", err)
@@ -83,6 +106,7 @@ func (ac *SubAccountCleanupService) executeDeprovisioning(subaccounts []string,
 			continue
 		}
 		ac.log.Infof("deprovisioning for instance %s (SubAccountID: %s) was triggered, operation: %s", instance.InstanceID, instance.SubAccountID, operation)
+		deprovisioned.add(instance.InstanceID)
 	}
 
 	done <- struct{}{}
@@ -0,0 +1,123 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+type Kind string
+
+const (
+	KindInstance      Kind = "instance"
+	KindRuntime       Kind = "runtime"
+	KindSecretBinding Kind = "secretBinding"
+)
+
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+)
+
+// Item is a resource changed by the cleanup, or which would be changed in the dry-run mode
+type Item struct {
+	Kind Kind   `json:"kind"`
+	Name string `json:"name"`
+	// Shoot is the name of the shoot the resource belongs to, if known
+	Shoot  string `json:"shoot,omitempty"`
+	Reason string `json:"reason"`
+	// Error is set when the cleanup of the resource failed
+	Error string `json:"error,omitempty"`
+}
+
+// Report describes the resources affected by a cleanup run
+type Report struct {
+	Job    string `json:"job"`
+	DryRun bool   `json:"dryRun"`
+	Items  []Item `json:"items"`
+}
+
+func NewReport(job string, dryRun bool) *Report {
+	return &Report{
+		Job:    job,
+		DryRun: dryRun,
+		Items:  []Item{},
+	}
+}
+
+func (r *Report) Add(item Item) {
+	r.Items = append(r.Items, item)
+}
+
+// SetError marks the resource as failed
+func (r *Report) SetError(kind Kind, name string, err error) {
+	for i := range r.Items {
+		if r.Items[i].Kind == kind && r.Items[i].Name == name {
+			r.Items[i].Error = err.Error()
+		}
+	}
+}
+
+// CheckLimit returns an error if the run would affect more resources than the limit, zero means no limit
+func (r *Report) CheckLimit(maxAffected int) error {
+	if maxAffected > 0 && len(r.Items) > maxAffected {
+		return fmt.Errorf("%s would affect %d resources, more than the limit of %d, aborting", r.Job, len(r.Items), maxAffected)
+	}
+	return nil
+}
+
+// Affected returns the names of the resources changed successfully, nothing is changed in the dry-run mode
+func (r *Report) Affected() []string {
+	if r.DryRun {
+		return nil
+	}
+	var names []string
+	for _, item := range r.Items {
+		if item.Error == "" {
+			names = append(names, item.Name)
+		}
+	}
+	return names
+}
+
+// Write prints the report in the table or JSON format
+func (r *Report) Write(w io.Writer, format string) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r)
+	case FormatTable, "":
+		return r.writeTable(w)
+	default:
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+func (r *Report) writeTable(w io.Writer) error {
+	mode := "executed"
+	if r.DryRun {
+		mode = "dry run"
+	}
+	if _, err := fmt.Fprintf(w, "%s (%s): %d resources\n", r.Job, mode, len(r.Items)); err != nil {
+		return err
+	}
+	if len(r.Items) == 0 {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tNAME\tSHOOT\tREASON\tERROR")
+	for _, item := range r.Items {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", item.Kind, item.Name, dashIfEmpty(item.Shoot), item.Reason, dashIfEmpty(item.Error))
+	}
+	return tw.Flush()
+}
+
+func dashIfEmpty(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,90 @@
+package cleanup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	t.Run("should return the resources changed without errors", func(t *testing.T) {
+		// given
+		report := fixReport(false)
+
+		// when
+		report.SetError(KindRuntime, "runtime-2", fmt.Errorf("provisioner unavailable"))
+
+		// then
+		assert.Equal(t, []string{"instance-1"}, report.Affected())
+		assert.Equal(t, "provisioner unavailable", report.Items[1].Error)
+	})
+
+	t.Run("should return no resources changed in the dry-run mode", func(t *testing.T) {
+		// given
+		report := fixReport(true)
+
+		// then
+		assert.Empty(t, report.Affected())
+	})
+
+	t.Run("should check the limit", func(t *testing.T) {
+		// given
+		report := fixReport(false)
+
+		// then
+		assert.NoError(t, report.CheckLimit(0))
+		assert.NoError(t, report.CheckLimit(2))
+		assert.EqualError(t, report.CheckLimit(1), "environments-cleanup would affect 2 resources, more than the limit of 1, aborting")
+	})
+
+	t.Run("should write the report as a table", func(t *testing.T) {
+		// given
+		report := fixReport(true)
+		var buf bytes.Buffer
+
+		// when
+		err := report.Write(&buf, FormatTable)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, `environments-cleanup (dry run): 2 resources
+KIND      NAME        SHOOT   REASON         ERROR
+instance  instance-1  c-1234  shoot too old  -
+runtime   runtime-2   c-5678  shoot too old  -
+`, buf.String())
+	})
+
+	t.Run("should write the report as JSON", func(t *testing.T) {
+		// given
+		report := fixReport(false)
+		var buf bytes.Buffer
+
+		// when
+		err := report.Write(&buf, FormatJSON)
+
+		// then
+		require.NoError(t, err)
+		var written Report
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &written))
+		assert.Equal(t, *report, written)
+	})
+
+	t.Run("should reject unknown format", func(t *testing.T) {
+		// given
+		report := fixReport(false)
+
+		// then
+		assert.EqualError(t, report.Write(&bytes.Buffer{}, "yaml"), `unsupported report format "yaml"`)
+	})
+}
+
+func fixReport(dryRun bool) *Report {
+	report := NewReport("environments-cleanup", dryRun)
+	report.Add(Item{Kind: KindInstance, Name: "instance-1", Shoot: "c-1234", Reason: "shoot too old"})
+	report.Add(Item{Kind: KindRuntime, Name: "runtime-2", Shoot: "c-5678", Reason: "shoot too old"})
+	return report
+}
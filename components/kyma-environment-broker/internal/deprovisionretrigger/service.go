@@ -0,0 +1,89 @@
+package deprovisionretrigger
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+)
+
+type BrokerClient interface {
+	Deprovision(instance internal.Instance) (string, error)
+}
+
+type Config struct {
+	DryRun bool `envconfig:"default=true"`
+}
+
+// Service sends the deprovisioning requests again for the instances which were not completely deprovisioned
+type Service struct {
+	cfg             Config
+	instanceStorage storage.Instances
+	brokerClient    BrokerClient
+	log             logrus.FieldLogger
+}
+
+func NewService(cfg Config, brokerClient BrokerClient, instances storage.Instances, log logrus.FieldLogger) *Service {
+	return &Service{
+		cfg:             cfg,
+		instanceStorage: instances,
+		brokerClient:    brokerClient,
+		log:             log,
+	}
+}
+
+// PerformCleanup retriggers the deprovisioning and returns the IDs of the instances with accepted requests
+func (s *Service) PerformCleanup() ([]string, error) {
+	notCompletelyDeletedFilter := dbmodel.InstanceFilter{DeletionAttempted: &[]bool{true}[0]}
+	instancesToDeprovisionAgain, _, _, err := s.instanceStorage.List(notCompletelyDeletedFilter)
+
+	if err != nil {
+		s.log.Error(fmt.Sprintf("while getting not completely deprovisioned instances: %s", err))
+		return nil, err
+	}
+
+	if s.cfg.DryRun {
+		s.logInstances(instancesToDeprovisionAgain)
+		s.log.Infof("Instances to retrigger deprovisioning: %d", len(instancesToDeprovisionAgain))
+		return nil, nil
+	}
+
+	accepted, failuresCount := s.retriggerDeprovisioningForInstances(instancesToDeprovisionAgain)
+	s.log.Infof("Instances to retrigger deprovisioning: %d, accepted requests: %d, failed requests: %d", len(instancesToDeprovisionAgain), len(accepted), failuresCount)
+
+	return accepted, nil
+}
+
+func (s *Service) retriggerDeprovisioningForInstances(instances []internal.Instance) ([]string, int) {
+	var accepted []string
+	var failuresCount int
+	for _, instance := range instances {
+		err := s.deprovisionInstance(instance)
+		if err != nil {
+			// just counting, logging and ignoring errors
+			failuresCount += 1
+			continue
+		}
+		accepted = append(accepted, instance.InstanceID)
+	}
+	return accepted, failuresCount
+}
+
+func (s *Service) deprovisionInstance(instance internal.Instance) (err error) {
+	s.log.Infof("About to deprovision instance for instanceId: %+v", instance.InstanceID)
+	operationId, err := s.brokerClient.Deprovision(instance)
+	if err != nil {
+		s.log.Error(fmt.Sprintf("while sending deprovision request for instance ID %s: %s", instance.InstanceID, err))
+		return err
+	}
+	s.log.Infof("Deprovision instance for instanceId: %s accepted, operationId: %s", instance.InstanceID, operationId)
+	return nil
+}
+
+func (s *Service) logInstances(instances []internal.Instance) {
+	for _, instance := range instances {
+		s.log.Infof("instanceId: %s, createdAt: %+v, deletedAt %+v", instance.InstanceID, instance.CreatedAt, instance.DeletedAt)
+	}
+}
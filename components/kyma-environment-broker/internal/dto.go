@@ -1,17 +1,41 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
 	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/networking"
 )
 
 const (
 	LicenceTypeLite      = "TestDevelopmentAndDemo"
 	oidcValidSigningAlgs = "RS256,RS384,RS512,ES256,ES384,ES512,PS256,PS384,PS512"
+
+	// MaintenanceWindowTimeLayout is the format of the maintenance window begin and end, for example, 22:00
+	MaintenanceWindowTimeLayout = "15:04"
+	// GardenerMaintenanceWindowTimeLayout is the format of the shoot maintenance time window, for example, 220000+0100
+	GardenerMaintenanceWindowTimeLayout = "150405-0700"
+	// Gardener limits the duration of the maintenance window
+	minMaintenanceWindowDuration = 30 * time.Minute
+	maxMaintenanceWindowDuration = 6 * time.Hour
+
+	// MainWorkerNodePoolName is reserved for the default worker node pool created by the provisioner
+	MainWorkerNodePoolName = "cpu-worker-0"
+	// MaxWorkerNodePoolNameLength is limited by the Gardener worker name length
+	MaxWorkerNodePoolNameLength = 15
+	// WorkerNodePoolNamePattern allows lower case alphanumeric characters and '-'
+	WorkerNodePoolNamePattern = `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
 )
 
+var workerNodePoolNameRegexp = regexp.MustCompile(WorkerNodePoolNamePattern)
+
+var maintenanceWindowDays = map[string]bool{"Mon": true, "Tue": true, "Wed": true, "Thu": true, "Fri": true, "Sat": true, "Sun": true}
+
 type OIDCConfigDTO struct {
 	ClientID       string   `json:"clientID" yaml:"clientID"`
 	GroupsClaim    string   `json:"groupsClaim" yaml:"groupsClaim"`
@@ -182,6 +206,15 @@ type ProvisioningParametersDTO struct {
 	ShootDomain string `json:"shootDomain,omitempty"`
 
 	OIDC *OIDCConfigDTO `json:"oidc,omitempty"`
+
+	// HighAvailability enables the zone failure tolerant control plane and spreads the workers across three zones
+	HighAvailability *bool `json:"highAvailability,omitempty"`
+
+	AdditionalWorkerNodePools AdditionalWorkerNodePools `json:"additionalWorkerNodePools,omitempty"`
+
+	Networking *NetworkingDTO `json:"networking,omitempty"`
+
+	MaintenanceWindow *MaintenanceWindowDTO `json:"maintenanceWindow,omitempty"`
 }
 
 type UpdatingParametersDTO struct {
@@ -190,6 +223,11 @@ type UpdatingParametersDTO struct {
 	OIDC                  *OIDCConfigDTO `json:"oidc,omitempty"`
 	RuntimeAdministrators []string       `json:"administrators,omitempty"`
 	MachineType           *string        `json:"machineType,omitempty"`
+	HighAvailability      *bool          `json:"highAvailability,omitempty"`
+
+	AdditionalWorkerNodePools AdditionalWorkerNodePools `json:"additionalWorkerNodePools,omitempty"`
+
+	MaintenanceWindow *MaintenanceWindowDTO `json:"maintenanceWindow,omitempty"`
 
 	// Expired - means that the trial SKR is marked as expired
 	Expired bool `json:"expired"`
@@ -216,6 +254,133 @@ func (u UpdatingParametersDTO) UpdateAutoScaler(p *ProvisioningParametersDTO) bo
 	return updated
 }
 
+// IsHighAvailability returns true if the zone failure tolerant control plane is requested
+func (p ProvisioningParametersDTO) IsHighAvailability() bool {
+	return p.HighAvailability != nil && *p.HighAvailability
+}
+
+type AdditionalWorkerNodePool struct {
+	Name          string `json:"name"`
+	MachineType   string `json:"machineType"`
+	AutoScalerMin int    `json:"autoScalerMin"`
+	AutoScalerMax int    `json:"autoScalerMax"`
+}
+
+type AdditionalWorkerNodePools []AdditionalWorkerNodePool
+
+func (p AdditionalWorkerNodePools) Validate() error {
+	errs := make([]string, 0)
+	names := make(map[string]bool, len(p))
+	for _, pool := range p {
+		switch {
+		case pool.Name == "":
+			errs = append(errs, "name of additional worker node pool must not be empty")
+		case len(pool.Name) > MaxWorkerNodePoolNameLength || !workerNodePoolNameRegexp.MatchString(pool.Name):
+			errs = append(errs, fmt.Sprintf("name of additional worker node pool %s must consist of at most %d lower case alphanumeric characters or '-'", pool.Name, MaxWorkerNodePoolNameLength))
+		case pool.Name == MainWorkerNodePoolName:
+			errs = append(errs, fmt.Sprintf("name of additional worker node pool %s is reserved for the default worker node pool", pool.Name))
+		}
+		if names[pool.Name] {
+			errs = append(errs, fmt.Sprintf("name of additional worker node pool %s is not unique", pool.Name))
+		}
+		names[pool.Name] = true
+		if pool.MachineType == "" {
+			errs = append(errs, fmt.Sprintf("machineType of additional worker node pool %s must not be empty", pool.Name))
+		}
+		if pool.AutoScalerMin < 0 {
+			errs = append(errs, fmt.Sprintf("autoScalerMin of additional worker node pool %s must not be negative", pool.Name))
+		}
+		if pool.AutoScalerMin > pool.AutoScalerMax {
+			errs = append(errs, fmt.Sprintf("autoScalerMax %d of additional worker node pool %s should be larger than autoScalerMin %d", pool.AutoScalerMax, pool.Name, pool.AutoScalerMin))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+type NetworkingDTO struct {
+	Pods     *string `json:"pods,omitempty"`
+	Services *string `json:"services,omitempty"`
+	Nodes    *string `json:"nodes,omitempty"`
+}
+
+// Validate checks if the network ranges are valid CIDRs which do not overlap with each other. The nodes range must contain
+// the workers range; if it is not provided, the defaultNodes range of the provider is used for the overlap checks.
+func (n *NetworkingDTO) Validate(workers, defaultNodes string) error {
+	return networking.CIDRs{Pods: n.Pods, Services: n.Services, Nodes: n.Nodes}.Validate(workers, defaultNodes)
+}
+
+// MaintenanceWindowDTO is the maintenance window preferred by the customer. Begin and end are local times in the TimeZone
+type MaintenanceWindowDTO struct {
+	Days     []string `json:"days,omitempty"`
+	Begin    string   `json:"begin"`
+	End      string   `json:"end"`
+	TimeZone string   `json:"timeZone,omitempty"`
+}
+
+func (w *MaintenanceWindowDTO) Validate() error {
+	for _, day := range w.Days {
+		if !maintenanceWindowDays[day] {
+			return fmt.Errorf("maintenance window day %s is invalid, supported days are: Mon, Tue, Wed, Thu, Fri, Sat, Sun", day)
+		}
+	}
+	begin, err := time.Parse(MaintenanceWindowTimeLayout, w.Begin)
+	if err != nil {
+		return fmt.Errorf("maintenance window begin %s is invalid, expected format HH:MM", w.Begin)
+	}
+	end, err := time.Parse(MaintenanceWindowTimeLayout, w.End)
+	if err != nil {
+		return fmt.Errorf("maintenance window end %s is invalid, expected format HH:MM", w.End)
+	}
+	if duration := maintenanceWindowDuration(begin, end); duration < minMaintenanceWindowDuration || duration > maxMaintenanceWindowDuration {
+		return fmt.Errorf("maintenance window from %s to %s lasts %s, it must last between %s and %s", w.Begin, w.End, duration, minMaintenanceWindowDuration, maxMaintenanceWindowDuration)
+	}
+	if _, err := w.Location(); err != nil {
+		return fmt.Errorf("maintenance window time zone %s is invalid: %w", w.TimeZone, err)
+	}
+	return nil
+}
+
+// maintenanceWindowDuration returns the duration of the window, which ends on the next day if the end is before the begin
+func maintenanceWindowDuration(begin, end time.Time) time.Duration {
+	duration := end.Sub(begin)
+	if duration < 0 {
+		duration += 24 * time.Hour
+	}
+	return duration
+}
+
+// Location returns the time zone of the window, UTC if not provided
+func (w *MaintenanceWindowDTO) Location() (*time.Location, error) {
+	return time.LoadLocation(w.TimeZone)
+}
+
+// GardenerTimeWindow returns the begin and end of the window in the Gardener format. Gardener stores a fixed offset,
+// so the offset of the time zone at the given time is used
+func (w *MaintenanceWindowDTO) GardenerTimeWindow(at time.Time) (string, string, error) {
+	location, err := w.Location()
+	if err != nil {
+		return "", "", fmt.Errorf("while loading maintenance window time zone %s: %w", w.TimeZone, err)
+	}
+	begin, err := time.Parse(MaintenanceWindowTimeLayout, w.Begin)
+	if err != nil {
+		return "", "", fmt.Errorf("while parsing maintenance window begin %s: %w", w.Begin, err)
+	}
+	end, err := time.Parse(MaintenanceWindowTimeLayout, w.End)
+	if err != nil {
+		return "", "", fmt.Errorf("while parsing maintenance window end %s: %w", w.End, err)
+	}
+
+	day := at.In(location)
+	toGardener := func(t time.Time) string {
+		return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, location).Format(GardenerMaintenanceWindowTimeLayout)
+	}
+	return toGardener(begin), toGardener(end), nil
+}
+
 type ERSContext struct {
 	TenantID              string                             `json:"tenant_id,omitempty"`
 	SubAccountID          string                             `json:"subaccount_id"`
@@ -3,6 +3,7 @@ package environmentscleanup
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/cleanup"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,6 +22,8 @@ import (
 const (
 	shootAnnotationRuntimeId = "kcp.provisioner.kyma-project.io/runtime-id"
 	shootLabelAccountId      = "account"
+
+	jobName = "environments-cleanup"
 )
 
 //go:generate mockery --name=GardenerClient --output=automock
@@ -45,11 +49,20 @@ type Service struct {
 	MaxShootAge       time.Duration
 	LabelSelector     string
 	provisionerClient ProvisionerClient
+
+	// DryRun only reports the instances and runtimes to deprovision
+	DryRun bool
+	// MaxAffected aborts the cleanup if more instances and runtimes would be deprovisioned, zero means no limit
+	MaxAffected int
+	// ReportFormat is the format of the report printed by Run, table or json
+	ReportFormat string
 }
 
 type runtime struct {
 	ID        string
 	AccountID string
+	ShootName string
+	ShootAge  time.Duration
 }
 
 func NewService(gardenerClient GardenerClient, brokerClient BrokerClient, provisionerClient ProvisionerClient, instanceStorage storage.Instances, logger *log.Logger, maxShootAge time.Duration, labelSelector string) *Service {
@@ -65,15 +78,24 @@ func NewService(gardenerClient GardenerClient, brokerClient BrokerClient, provis
 }
 
 func (s *Service) Run() error {
-	return s.PerformCleanup()
+	report, err := s.PerformCleanup()
+	if report != nil {
+		if writeErr := report.Write(os.Stdout, s.ReportFormat); writeErr != nil {
+			s.logger.Errorf("while writing cleanup report: %s", writeErr)
+		}
+	}
+	return err
 }
 
-func (s *Service) PerformCleanup() error {
+// PerformCleanup deprovisions the runtimes of the stale shoots, the report lists the KEB instances and the runtimes
+// unknown to KEB which are deprovisioned
+func (s *Service) PerformCleanup() (*cleanup.Report, error) {
+	report := cleanup.NewReport(jobName, s.DryRun)
 
 	staleShoots, err := s.getStaleShoots(s.LabelSelector)
 	if err != nil {
 		s.logger.Error(fmt.Errorf("while getting stale shoots to delete: %w", err))
-		return err
+		return report, err
 	}
 
 	runtimesToDelete := s.getRuntimes(staleShoots)
@@ -81,10 +103,43 @@ func (s *Service) PerformCleanup() error {
 	s.logger.Infof("Runtimes to process: %+v\n", runtimesToDelete)
 
 	if len(runtimesToDelete) == 0 {
-		return nil
+		return report, nil
 	}
 
-	return s.cleanUp(runtimesToDelete)
+	kebInstancesToDelete, err := s.getInstancesForRuntimes(runtimesToDelete)
+	if err != nil {
+		err = fmt.Errorf("while getting instance IDs for Runtimes: %w", err)
+		s.logger.Error(err)
+		return report, err
+	}
+
+	s.addToReport(report, runtimesToDelete, kebInstancesToDelete)
+	if err := report.CheckLimit(s.MaxAffected); err != nil {
+		s.logger.Error(err)
+		return report, err
+	}
+	if s.DryRun {
+		s.logger.Infof("Dry run: %d instances and runtimes would be deprovisioned", len(report.Items))
+		return report, nil
+	}
+
+	return report, s.cleanUp(report, runtimesToDelete, kebInstancesToDelete)
+}
+
+func (s *Service) addToReport(report *cleanup.Report, runtimes []runtime, kebInstances []internal.Instance) {
+	for _, runtime := range runtimes {
+		reason := fmt.Sprintf("shoot is %.0f hours old, older than %.0f hours", runtime.ShootAge.Hours(), s.MaxShootAge.Hours())
+		found := false
+		for _, instance := range kebInstances {
+			if instance.RuntimeID == runtime.ID {
+				report.Add(cleanup.Item{Kind: cleanup.KindInstance, Name: instance.InstanceID, Shoot: runtime.ShootName, Reason: reason})
+				found = true
+			}
+		}
+		if !found {
+			report.Add(cleanup.Item{Kind: cleanup.KindRuntime, Name: runtime.ID, Shoot: runtime.ShootName, Reason: reason + ", runtime unknown to KEB"})
+		}
+	}
 }
 
 func (s *Service) getStaleShoots(labelSelector string) ([]unstructured.Unstructured, error) {
@@ -129,22 +184,17 @@ func (s *Service) getRuntimes(shoots []unstructured.Unstructured) []runtime {
 		runtimes = append(runtimes, runtime{
 			ID:        runtimeID,
 			AccountID: accountID,
+			ShootName: shoot.GetName(),
+			ShootAge:  time.Since(shoot.GetCreationTimestamp().Time),
 		})
 	}
 
 	return runtimes
 }
 
-func (s *Service) cleanUp(runtimesToDelete []runtime) error {
-	kebInstancesToDelete, err := s.getInstancesForRuntimes(runtimesToDelete)
-	if err != nil {
-		err = fmt.Errorf("while getting instance IDs for Runtimes: %w", err)
-		s.logger.Error(err)
-		return err
-	}
-
-	kebResult := s.cleanUpKEBInstances(kebInstancesToDelete)
-	provisionerResult := s.cleanUpProvisionerInstances(runtimesToDelete, kebInstancesToDelete)
+func (s *Service) cleanUp(report *cleanup.Report, runtimesToDelete []runtime, kebInstancesToDelete []internal.Instance) error {
+	kebResult := s.cleanUpKEBInstances(report, kebInstancesToDelete)
+	provisionerResult := s.cleanUpProvisionerInstances(report, runtimesToDelete, kebInstancesToDelete)
 	result := multierror.Append(kebResult, provisionerResult)
 
 	if result != nil {
@@ -175,13 +225,14 @@ func (s *Service) getInstancesForRuntimes(runtimesToDelete []runtime) ([]interna
 	return instances, nil
 }
 
-func (s *Service) cleanUpKEBInstances(instancesToDelete []internal.Instance) *multierror.Error {
+func (s *Service) cleanUpKEBInstances(report *cleanup.Report, instancesToDelete []internal.Instance) *multierror.Error {
 	var result *multierror.Error
 
 	for _, instance := range instancesToDelete {
 		s.logger.Infof("Triggering environment deprovisioning for instance ID %q", instance.InstanceID)
 		currentErr := s.triggerEnvironmentDeprovisioning(instance)
 		if currentErr != nil {
+			report.SetError(cleanup.KindInstance, instance.InstanceID, currentErr)
 			result = multierror.Append(result, currentErr)
 		}
 	}
@@ -189,7 +240,7 @@ func (s *Service) cleanUpKEBInstances(instancesToDelete []internal.Instance) *mu
 	return result
 }
 
-func (s *Service) cleanUpProvisionerInstances(runtimesToDelete []runtime, kebInstancesToDelete []internal.Instance) *multierror.Error {
+func (s *Service) cleanUpProvisionerInstances(report *cleanup.Report, runtimesToDelete []runtime, kebInstancesToDelete []internal.Instance) *multierror.Error {
 	kebInstanceExists := func(runtimeID string) bool {
 		for _, instance := range kebInstancesToDelete {
 			if instance.RuntimeID == runtimeID {
@@ -207,6 +258,7 @@ func (s *Service) cleanUpProvisionerInstances(runtimesToDelete []runtime, kebIns
 			s.logger.Infof("Triggering runtime deprovisioning for runtimeID ID %q", runtime.ID)
 			err := s.triggerRuntimeDeprovisioning(runtime)
 			if err != nil {
+				report.SetError(cleanup.KindRuntime, runtime.ID, err)
 				result = multierror.Append(result, err)
 			}
 		}
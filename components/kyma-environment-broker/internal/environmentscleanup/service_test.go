@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/cleanup"
 	mocks "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/environmentscleanup/automock"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/sirupsen/logrus"
@@ -55,13 +56,73 @@ func TestService_PerformCleanup(t *testing.T) {
 		svc := NewService(gcMock, bcMock, pMock, memoryStorage.Instances(), logger, maxShootAge, shootLabelSelector)
 
 		// when
-		err := svc.PerformCleanup()
+		report, err := svc.PerformCleanup()
 
 		// then
 		bcMock.AssertExpectations(t)
 		gcMock.AssertExpectations(t)
 		pMock.AssertExpectations(t)
 		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{fixInstanceID1, fixInstanceID2, fixRuntimeID3}, report.Affected())
+	})
+
+	t.Run("should only report the instances and runtimes in the dry-run mode", func(t *testing.T) {
+		// given
+		gcMock := &mocks.GardenerClient{}
+		gcMock.On("List", mock.Anything, mock.AnythingOfType("v1.ListOptions")).Return(fixShootList(), nil)
+		bcMock := &mocks.BrokerClient{}
+		pMock := &mocks.ProvisionerClient{}
+
+		memoryStorage := storage.NewMemoryStorage()
+		memoryStorage.Instances().Insert(internal.Instance{
+			InstanceID: fixInstanceID1,
+			RuntimeID:  fixRuntimeID1,
+		})
+		logger := logrus.New()
+
+		svc := NewService(gcMock, bcMock, pMock, memoryStorage.Instances(), logger, maxShootAge, shootLabelSelector)
+		svc.DryRun = true
+
+		// when
+		report, err := svc.PerformCleanup()
+
+		// then
+		bcMock.AssertNotCalled(t, "Deprovision", mock.Anything)
+		pMock.AssertNotCalled(t, "DeprovisionRuntime", mock.Anything, mock.Anything)
+		assert.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Empty(t, report.Affected())
+		require.Len(t, report.Items, 3)
+		assert.Equal(t, cleanup.Item{Kind: cleanup.KindInstance, Name: fixInstanceID1, Shoot: "az-1234", Reason: report.Items[0].Reason}, report.Items[0])
+		assert.Equal(t, cleanup.KindRuntime, report.Items[1].Kind)
+		assert.Equal(t, fixRuntimeID2, report.Items[1].Name)
+	})
+
+	t.Run("should abort when more resources would be affected than the limit", func(t *testing.T) {
+		// given
+		gcMock := &mocks.GardenerClient{}
+		gcMock.On("List", mock.Anything, mock.AnythingOfType("v1.ListOptions")).Return(fixShootList(), nil)
+		bcMock := &mocks.BrokerClient{}
+		pMock := &mocks.ProvisionerClient{}
+
+		memoryStorage := storage.NewMemoryStorage()
+		memoryStorage.Instances().Insert(internal.Instance{
+			InstanceID: fixInstanceID1,
+			RuntimeID:  fixRuntimeID1,
+		})
+		logger := logrus.New()
+
+		svc := NewService(gcMock, bcMock, pMock, memoryStorage.Instances(), logger, maxShootAge, shootLabelSelector)
+		svc.MaxAffected = 2
+
+		// when
+		report, err := svc.PerformCleanup()
+
+		// then
+		bcMock.AssertNotCalled(t, "Deprovision", mock.Anything)
+		pMock.AssertNotCalled(t, "DeprovisionRuntime", mock.Anything, mock.Anything)
+		assert.EqualError(t, err, "environments-cleanup would affect 3 resources, more than the limit of 2, aborting")
+		assert.Len(t, report.Items, 3)
 	})
 
 	t.Run("should fail when unable to fetch shoots from gardener", func(t *testing.T) {
@@ -78,7 +139,7 @@ func TestService_PerformCleanup(t *testing.T) {
 		svc := NewService(gcMock, bcMock, pMock, memoryStorage.Instances(), logger, maxShootAge, shootLabelSelector)
 
 		// when
-		err := svc.PerformCleanup()
+		_, err := svc.PerformCleanup()
 
 		// then
 		bcMock.AssertExpectations(t)
@@ -103,7 +164,7 @@ func TestService_PerformCleanup(t *testing.T) {
 		svc := NewService(gcMock, bcMock, pMock, memoryStorage.Instances(), logger, maxShootAge, shootLabelSelector)
 
 		// when
-		err := svc.PerformCleanup()
+		_, err := svc.PerformCleanup()
 
 		// then
 		bcMock.AssertExpectations(t)
@@ -140,7 +201,7 @@ func TestService_PerformCleanup(t *testing.T) {
 		svc := NewService(gcMock, bcMock, pMock, memoryStorage.Instances(), logger, maxShootAge, shootLabelSelector)
 
 		// when
-		err := svc.PerformCleanup()
+		_, err := svc.PerformCleanup()
 
 		// then
 		bcMock.AssertExpectations(t)
@@ -170,7 +231,7 @@ func TestService_PerformCleanup(t *testing.T) {
 		svc := NewService(gcMock, bcMock, pMock, memoryStorage.Instances(), logger, maxShootAge, shootLabelSelector)
 
 		// when
-		err := svc.PerformCleanup()
+		_, err := svc.PerformCleanup()
 
 		// then
 		bcMock.AssertExpectations(t)
@@ -236,7 +297,7 @@ func TestService_PerformCleanup(t *testing.T) {
 		svc := NewService(gcMock, bcMock, pMock, memoryStorage.Instances(), logger, maxShootAge, shootLabelSelector)
 
 		// when
-		err := svc.PerformCleanup()
+		_, err := svc.PerformCleanup()
 
 		// then
 		bcMock.AssertExpectations(t)
@@ -40,6 +40,7 @@ type SimpleInputCreator struct {
 	ShootDomain       string
 	shootDnsProviders gardener.DNSProvidersData
 	CloudProvider     internal.CloudProvider
+	ClusterRegion     string
 	RuntimeID         string
 	Config            *internal.ConfigForPlan
 }
@@ -464,6 +465,10 @@ func (c *SimpleInputCreator) Provider() internal.CloudProvider {
 	return c.CloudProvider
 }
 
+func (c *SimpleInputCreator) Region() string {
+	return c.ClusterRegion
+}
+
 func (c *SimpleInputCreator) Configuration() *internal.ConfigForPlan {
 	return c.Config
 }
@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	jobsapi "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+)
+
+type Handler struct {
+	scheduler      *Scheduler
+	runs           storage.JobRuns
+	defaultMaxPage int
+	log            logrus.FieldLogger
+}
+
+func NewHandler(scheduler *Scheduler, runs storage.JobRuns, defaultMaxPage int, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		scheduler:      scheduler,
+		runs:           runs,
+		defaultMaxPage: defaultMaxPage,
+		log:            log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/jobs", h.listJobs).Methods(http.MethodGet)
+	router.HandleFunc("/jobs/{job_name}/runs", h.listRuns).Methods(http.MethodGet)
+	router.HandleFunc("/jobs/{job_name}/runs", h.triggerRun).Methods(http.MethodPost)
+}
+
+func (h *Handler) listJobs(w http.ResponseWriter, req *http.Request) {
+	jobs, err := h.scheduler.Jobs()
+	if err != nil {
+		h.log.Errorf("while listing jobs: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while listing jobs: %w", err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, jobs)
+}
+
+func (h *Handler) listRuns(w http.ResponseWriter, req *http.Request) {
+	jobName := mux.Vars(req)["job_name"]
+	if !h.scheduler.IsRegistered(jobName) {
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("job %s not found", jobName))
+		return
+	}
+
+	pageSize, page, err := pagination.ExtractPaginationConfigFromRequest(req, h.defaultMaxPage)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while getting query parameters: %w", err))
+		return
+	}
+	params, err := jobsapi.ParseListParameters(req.URL.Query())
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	params.Page = page
+	params.PageSize = pageSize
+	params.JobNames = []string{jobName}
+
+	runs, count, totalCount, err := h.runs.List(params)
+	if err != nil {
+		h.log.Errorf("while listing runs of the %s job: %v", jobName, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while listing runs of the %s job: %w", jobName, err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, jobsapi.RunsPage{
+		Data:       runs,
+		Count:      count,
+		TotalCount: totalCount,
+	})
+}
+
+func (h *Handler) triggerRun(w http.ResponseWriter, req *http.Request) {
+	jobName := mux.Vars(req)["job_name"]
+	if !h.scheduler.IsRegistered(jobName) {
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("job %s not found", jobName))
+		return
+	}
+
+	caller, _ := audit.ResolveCaller(req)
+	run, err := h.scheduler.Trigger(jobName, caller)
+	if err != nil {
+		h.log.Errorf("while triggering run of the %s job: %v", jobName, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while triggering run of the %s job: %w", jobName, err))
+		return
+	}
+	h.log.Infof("Run %s of the %s job triggered by %s", run.ID, jobName, caller)
+
+	httputil.WriteResponse(w, http.StatusAccepted, run)
+}
@@ -0,0 +1,133 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// Leader decides which of the KEB replicas runs the jobs
+type Leader interface {
+	// Run takes part in the leader election until the context is done. Every time the replica becomes the leader
+	// lead is called with a context cancelled when the leadership is lost, lead must return after the context is done.
+	Run(ctx context.Context, lead func(ctx context.Context))
+}
+
+type LeaderElectionConfig struct {
+	Enabled       bool          `envconfig:"default=true"`
+	Namespace     string        `envconfig:"default=kcp-system"`
+	LeaseName     string        `envconfig:"default=kcp-kyma-environment-broker-jobs"`
+	LeaseDuration time.Duration `envconfig:"default=30s"`
+	RenewDeadline time.Duration `envconfig:"default=20s"`
+	RetryPeriod   time.Duration `envconfig:"default=5s"`
+}
+
+// alwaysLeader is used when the leader election is disabled, e.g. with a single replica or the in-memory storage
+type alwaysLeader struct{}
+
+func (alwaysLeader) Run(ctx context.Context, lead func(ctx context.Context)) {
+	lead(ctx)
+}
+
+// LeaseElector holds a Kubernetes lease while the replica is the leader
+type LeaseElector struct {
+	elector  *leaderelection.LeaderElector
+	identity string
+	log      logrus.FieldLogger
+
+	mu   sync.Mutex
+	lead func(ctx context.Context)
+	// cancel cancels the context of the current leadership
+	cancel context.CancelFunc
+	// done is closed when lead returned for the current leadership
+	done chan struct{}
+}
+
+// NewLeader returns the leader elector for the configuration, every replica is the leader if the election is disabled
+func NewLeader(cfg LeaderElectionConfig, k8sClient kubernetes.Interface, identity string, log logrus.FieldLogger) (Leader, error) {
+	if !cfg.Enabled {
+		return alwaysLeader{}, nil
+	}
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      cfg.LeaseName,
+			Namespace: cfg.Namespace,
+		},
+		Client: k8sClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+	leader := &LeaseElector{identity: identity, log: log}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   cfg.LeaseDuration,
+		RenewDeadline:   cfg.RenewDeadline,
+		RetryPeriod:     cfg.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            cfg.LeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: leader.onStartedLeading,
+			OnStoppedLeading: leader.onStoppedLeading,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("while creating leader elector: %w", err)
+	}
+	leader.elector = elector
+
+	return leader, nil
+}
+
+// Run tries to acquire the lease again after it is lost until the context is done
+func (e *LeaseElector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	e.mu.Lock()
+	e.lead = lead
+	e.mu.Unlock()
+
+	for ctx.Err() == nil {
+		e.elector.Run(ctx)
+		// the next leadership starts after the jobs of the lost one are stopped
+		e.mu.Lock()
+		done := e.done
+		e.mu.Unlock()
+		if done != nil {
+			<-done
+		}
+	}
+}
+
+func (e *LeaseElector) onStartedLeading(ctx context.Context) {
+	e.log.Infof("%s became the leader of the jobs scheduler", e.identity)
+
+	e.mu.Lock()
+	leaderCtx, cancel := context.WithCancel(ctx)
+	e.cancel = cancel
+	done := make(chan struct{})
+	e.done = done
+	lead := e.lead
+	e.mu.Unlock()
+
+	defer close(done)
+	defer cancel()
+	lead(leaderCtx)
+}
+
+func (e *LeaseElector) onStoppedLeading() {
+	e.log.Infof("%s stopped leading the jobs scheduler", e.identity)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cancel != nil {
+		e.cancel()
+		e.cancel = nil
+	}
+}
@@ -0,0 +1,160 @@
+package jobs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a standard cron expression with the minute, hour, day of month, month and day of week fields,
+// e.g. "0,15,30,45 * * * *". The fields support lists, ranges, steps and the "*" wildcard.
+type Schedule struct {
+	spec string
+
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar hold if the day fields are wildcards, a day matches any of the restricted day fields
+	domStar, dowStar bool
+}
+
+type bounds struct {
+	min, max uint
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	// day of week accepts both 0 and 7 as Sunday
+	dowBounds = bounds{0, 7}
+)
+
+// maxScheduleYears limits the search of the next activation of schedules which never match, e.g. "0 0 30 2 *"
+const maxScheduleYears = 5
+
+func ParseSchedule(spec string) (Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("schedule %q must have 5 fields, got %d", spec, len(fields))
+	}
+
+	s := Schedule{
+		spec:    spec,
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}
+	var err error
+	for _, f := range []struct {
+		field  string
+		bounds bounds
+		bits   *uint64
+	}{
+		{fields[0], minuteBounds, &s.minute},
+		{fields[1], hourBounds, &s.hour},
+		{fields[2], domBounds, &s.dom},
+		{fields[3], monthBounds, &s.month},
+		{fields[4], dowBounds, &s.dow},
+	} {
+		*f.bits, err = parseField(f.field, f.bounds)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("while parsing schedule %q: %w", spec, err)
+		}
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := uint(1)
+		if hasStep {
+			parsed, err := strconv.ParseUint(stepPart, 10, 8)
+			if err != nil || parsed == 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			step = uint(parsed)
+		}
+
+		start, end := b.min, b.max
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			value, err := parseValue(from, b)
+			if err != nil {
+				return 0, err
+			}
+			start, end = value, value
+			if isRange {
+				if end, err = parseValue(to, b); err != nil {
+					return 0, err
+				}
+				if end < start {
+					return 0, fmt.Errorf("invalid range %q", rangePart)
+				}
+			} else if hasStep {
+				end = b.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(value string, b bounds) (uint, error) {
+	parsed, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if uint(parsed) < b.min || uint(parsed) > b.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", parsed, b.min, b.max)
+	}
+	return uint(parsed), nil
+}
+
+func (s Schedule) String() string {
+	return s.spec
+}
+
+// Next returns the first activation time (in UTC) of the schedule after the given time, the zero time if the schedule never matches
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxScheduleYears, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
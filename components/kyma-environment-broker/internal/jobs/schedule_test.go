@@ -0,0 +1,89 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	// Wednesday
+	from := time.Date(2023, time.March, 15, 10, 7, 30, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		spec     string
+		expected time.Time
+	}{
+		"every minute": {
+			spec:     "* * * * *",
+			expected: time.Date(2023, time.March, 15, 10, 8, 0, 0, time.UTC),
+		},
+		"list of minutes": {
+			spec:     "0,15,30,45 * * * *",
+			expected: time.Date(2023, time.March, 15, 10, 15, 0, 0, time.UTC),
+		},
+		"step": {
+			spec:     "*/20 * * * *",
+			expected: time.Date(2023, time.March, 15, 10, 20, 0, 0, time.UTC),
+		},
+		"daily": {
+			spec:     "0 2 * * *",
+			expected: time.Date(2023, time.March, 16, 2, 0, 0, 0, time.UTC),
+		},
+		"hours list": {
+			spec:     "0 2,14 * * *",
+			expected: time.Date(2023, time.March, 15, 14, 0, 0, 0, time.UTC),
+		},
+		"range of weekdays": {
+			spec:     "30 9 * * 6-7",
+			expected: time.Date(2023, time.March, 18, 9, 30, 0, 0, time.UTC),
+		},
+		"day of month or weekday": {
+			spec:     "0 0 1 * 5",
+			expected: time.Date(2023, time.March, 17, 0, 0, 0, 0, time.UTC),
+		},
+		"next year": {
+			spec:     "0 0 1 1 *",
+			expected: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"never": {
+			spec:     "0 0 30 2 *",
+			expected: time.Time{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			schedule, err := ParseSchedule(tc.spec)
+			require.NoError(t, err)
+
+			// when
+			next := schedule.Next(from)
+
+			// then
+			assert.Equal(t, tc.expected, next)
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+	} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := ParseSchedule(spec)
+
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	jobsapi "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+// Job is a periodic task of KEB, Run returns the IDs of the instances (or other resources) changed by the run
+type Job struct {
+	Name     string
+	Schedule string
+	Run      func(ctx context.Context, log logrus.FieldLogger) ([]string, error)
+}
+
+type scheduledJob struct {
+	Job
+	schedule Schedule
+}
+
+// Scheduler runs the registered jobs on their schedules and the runs triggered manually.
+// Only the leader replica runs the jobs, the run history is stored in the job_runs table.
+type Scheduler struct {
+	jobs            map[string]scheduledJob
+	runs            storage.JobRuns
+	leader          Leader
+	pollingInterval time.Duration
+	startedAt       time.Time
+	log             logrus.FieldLogger
+
+	mu      sync.Mutex
+	running map[string]bool
+	wg      sync.WaitGroup
+}
+
+func NewScheduler(runs storage.JobRuns, leader Leader, pollingInterval time.Duration, log logrus.FieldLogger) *Scheduler {
+	return &Scheduler{
+		jobs:            map[string]scheduledJob{},
+		runs:            runs,
+		leader:          leader,
+		pollingInterval: pollingInterval,
+		startedAt:       time.Now(),
+		log:             log,
+		running:         map[string]bool{},
+	}
+}
+
+func (s *Scheduler) Register(job Job) error {
+	if _, exists := s.jobs[job.Name]; exists {
+		return fmt.Errorf("job %s is already registered", job.Name)
+	}
+	schedule, err := ParseSchedule(job.Schedule)
+	if err != nil {
+		return fmt.Errorf("while registering job %s: %w", job.Name, err)
+	}
+	s.jobs[job.Name] = scheduledJob{Job: job, schedule: schedule}
+	s.log.Infof("Registered job %s with schedule %q", job.Name, job.Schedule)
+	return nil
+}
+
+// Run takes part in the leader election until the context is done, the jobs are run only while the replica is the leader
+func (s *Scheduler) Run(ctx context.Context) {
+	s.leader.Run(ctx, s.lead)
+}
+
+// lead checks the schedules and the pending runs until the leadership is lost and waits for the started runs,
+// which get the leadership context and are cancelled with it
+func (s *Scheduler) lead(ctx context.Context) {
+	s.failStaleRuns()
+
+	ticker := time.NewTicker(s.pollingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.wg.Wait()
+			return
+		case <-ticker.C:
+			s.tick(ctx, time.Now())
+		}
+	}
+}
+
+// failStaleRuns marks the runs left running by the previous leader as failed, the runs of this replica are finished
+// before it leads again
+func (s *Scheduler) failStaleRuns() {
+	stale, _, _, err := s.runs.List(jobsapi.ListParameters{States: []jobsapi.RunState{jobsapi.Running}})
+	if err != nil {
+		s.log.Errorf("while listing running job runs: %v", err)
+		return
+	}
+	for _, run := range stale {
+		finishedAt := time.Now()
+		run.FinishedAt = &finishedAt
+		run.State = jobsapi.Failed
+		run.Error = "the run was interrupted, the replica running it stopped or lost the leadership"
+		if err := s.runs.Update(run); err != nil {
+			s.log.Errorf("while failing stale run %s of the %s job: %v", run.ID, run.JobName, err)
+			continue
+		}
+		s.log.Warnf("Marked stale run %s of the %s job as failed", run.ID, run.JobName)
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	pending, _, _, err := s.runs.List(jobsapi.ListParameters{States: []jobsapi.RunState{jobsapi.Pending}})
+	if err != nil {
+		s.log.Errorf("while listing pending job runs: %v", err)
+		return
+	}
+	for _, run := range pending {
+		job, found := s.jobs[run.JobName]
+		if !found {
+			continue
+		}
+		s.start(ctx, job, run)
+	}
+
+	for _, name := range s.names() {
+		job := s.jobs[name]
+		activation, err := s.dueActivation(job, now)
+		if err != nil {
+			s.log.Errorf("while checking schedule of the %s job: %v", name, err)
+			continue
+		}
+		if activation.IsZero() {
+			continue
+		}
+		if s.isRunning(name) {
+			s.log.Warnf("Skipping scheduled run of the %s job, the previous run is still in progress", name)
+			continue
+		}
+
+		run := jobsapi.RunDTO{
+			ID:          uuid.New().String(),
+			JobName:     name,
+			State:       jobsapi.Pending,
+			TriggeredBy: jobsapi.TriggeredBySchedule,
+			CreatedAt:   activation,
+		}
+		if err := s.runs.Insert(run); err != nil {
+			s.log.Errorf("while inserting scheduled run of the %s job: %v", name, err)
+			continue
+		}
+		s.start(ctx, job, run)
+	}
+}
+
+// dueActivation returns the latest activation of the schedule missed since the last scheduled run, the zero time if the job is not due
+func (s *Scheduler) dueActivation(job scheduledJob, now time.Time) (time.Time, error) {
+	last, err := s.lastActivation(job.Name)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var activation time.Time
+	for next := job.schedule.Next(last); !next.IsZero() && !next.After(now); next = job.schedule.Next(next) {
+		activation = next
+	}
+	return activation, nil
+}
+
+func (s *Scheduler) lastActivation(jobName string) (time.Time, error) {
+	last, err := s.runs.GetLastScheduled(jobName)
+	switch {
+	case dberr.IsNotFound(err):
+		return s.startedAt, nil
+	case err != nil:
+		return time.Time{}, err
+	}
+	return last.CreatedAt, nil
+}
+
+func (s *Scheduler) start(ctx context.Context, job scheduledJob, run jobsapi.RunDTO) {
+	s.mu.Lock()
+	if s.running[job.Name] {
+		s.mu.Unlock()
+		return
+	}
+	s.running[job.Name] = true
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			s.mu.Lock()
+			delete(s.running, job.Name)
+			s.mu.Unlock()
+		}()
+		s.execute(ctx, job, run)
+	}()
+}
+
+func (s *Scheduler) execute(ctx context.Context, job scheduledJob, run jobsapi.RunDTO) {
+	log := s.log.WithField("job", job.Name).WithField("runID", run.ID)
+
+	startedAt := time.Now()
+	run.State = jobsapi.Running
+	run.StartedAt = &startedAt
+	if err := s.runs.Update(run); err != nil {
+		log.Errorf("while updating job run: %v", err)
+		return
+	}
+	log.Infof("Starting job run triggered by %s", run.TriggeredBy)
+
+	affected, err := job.Run(ctx, log)
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	run.Affected = affected
+	run.State = jobsapi.Succeeded
+	if err != nil {
+		run.State = jobsapi.Failed
+		run.Error = err.Error()
+		log.Errorf("Job run failed after %s: %v", finishedAt.Sub(startedAt), err)
+	} else {
+		log.Infof("Job run succeeded after %s, affected %d resources", finishedAt.Sub(startedAt), len(affected))
+	}
+	if err := s.runs.Update(run); err != nil {
+		log.Errorf("while updating job run: %v", err)
+	}
+}
+
+func (s *Scheduler) isRunning(jobName string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running[jobName]
+}
+
+func (s *Scheduler) names() []string {
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Trigger requests a run of the job, the run is executed by the leader with the next check of the schedules
+func (s *Scheduler) Trigger(jobName, triggeredBy string) (jobsapi.RunDTO, error) {
+	if _, found := s.jobs[jobName]; !found {
+		return jobsapi.RunDTO{}, fmt.Errorf("job %s not found", jobName)
+	}
+	run := jobsapi.RunDTO{
+		ID:          uuid.New().String(),
+		JobName:     jobName,
+		State:       jobsapi.Pending,
+		TriggeredBy: triggeredBy,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.runs.Insert(run); err != nil {
+		return jobsapi.RunDTO{}, fmt.Errorf("while inserting run of the %s job: %w", jobName, err)
+	}
+	return run, nil
+}
+
+// Jobs returns the registered jobs with their next scheduled activation and latest run
+func (s *Scheduler) Jobs() ([]jobsapi.JobDTO, error) {
+	result := make([]jobsapi.JobDTO, 0, len(s.jobs))
+	for _, name := range s.names() {
+		job := s.jobs[name]
+		last, err := s.lastActivation(name)
+		if err != nil {
+			return nil, fmt.Errorf("while getting last scheduled run of the %s job: %w", name, err)
+		}
+		dto := jobsapi.JobDTO{
+			Name:     name,
+			Schedule: job.Schedule,
+			NextRun:  job.schedule.Next(last),
+		}
+
+		runs, _, _, err := s.runs.List(jobsapi.ListParameters{JobNames: []string{name}, Page: 1, PageSize: 1})
+		if err != nil {
+			return nil, fmt.Errorf("while getting latest run of the %s job: %w", name, err)
+		}
+		if len(runs) > 0 {
+			dto.LastRun = &runs[0]
+		}
+		result = append(result, dto)
+	}
+	return result, nil
+}
+
+// IsRegistered returns true if the job with the given name is known to the scheduler
+func (s *Scheduler) IsRegistered(jobName string) bool {
+	_, found := s.jobs[jobName]
+	return found
+}
@@ -0,0 +1,181 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jobsapi "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+)
+
+type fakeLeader struct{}
+
+func (l *fakeLeader) Run(ctx context.Context, lead func(ctx context.Context)) {
+	lead(ctx)
+}
+
+func TestScheduler(t *testing.T) {
+	t.Run("should run the job on its schedule and record the run", func(t *testing.T) {
+		// given
+		runs := storage.NewMemoryStorage().JobRuns()
+		scheduler := NewScheduler(runs, &fakeLeader{}, time.Minute, logrus.New())
+		scheduler.startedAt = time.Date(2023, time.March, 15, 10, 7, 0, 0, time.UTC)
+		calls := 0
+		require.NoError(t, scheduler.Register(Job{
+			Name:     "cleanup",
+			Schedule: "*/15 * * * *",
+			Run: func(ctx context.Context, log logrus.FieldLogger) ([]string, error) {
+				calls++
+				return []string{"inst-1", "inst-2"}, nil
+			},
+		}))
+
+		// when
+		scheduler.tick(context.Background(), time.Date(2023, time.March, 15, 10, 14, 0, 0, time.UTC))
+		scheduler.wg.Wait()
+
+		// then
+		assert.Equal(t, 0, calls)
+
+		// when
+		scheduler.tick(context.Background(), time.Date(2023, time.March, 15, 10, 31, 0, 0, time.UTC))
+		scheduler.wg.Wait()
+
+		// then
+		assert.Equal(t, 1, calls)
+		last, err := runs.GetLastScheduled("cleanup")
+		require.NoError(t, err)
+		assert.Equal(t, jobsapi.Succeeded, last.State)
+		assert.Equal(t, time.Date(2023, time.March, 15, 10, 30, 0, 0, time.UTC), last.CreatedAt)
+		assert.Equal(t, []string{"inst-1", "inst-2"}, last.Affected)
+		assert.NotNil(t, last.StartedAt)
+		assert.NotNil(t, last.FinishedAt)
+
+		// when
+		scheduler.tick(context.Background(), time.Date(2023, time.March, 15, 10, 32, 0, 0, time.UTC))
+		scheduler.wg.Wait()
+
+		// then
+		assert.Equal(t, 1, calls)
+		jobs, err := scheduler.Jobs()
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, time.Date(2023, time.March, 15, 10, 45, 0, 0, time.UTC), jobs[0].NextRun)
+		assert.Equal(t, last.ID, jobs[0].LastRun.ID)
+	})
+
+	t.Run("should run the triggered job and record the failure", func(t *testing.T) {
+		// given
+		runs := storage.NewMemoryStorage().JobRuns()
+		scheduler := NewScheduler(runs, &fakeLeader{}, time.Minute, logrus.New())
+		require.NoError(t, scheduler.Register(Job{
+			Name:     "cleanup",
+			Schedule: "0 0 1 1 *",
+			Run: func(ctx context.Context, log logrus.FieldLogger) ([]string, error) {
+				return []string{"inst-1"}, fmt.Errorf("broker unavailable")
+			},
+		}))
+
+		// when
+		run, err := scheduler.Trigger("cleanup", "admin@example.com")
+		require.NoError(t, err)
+		scheduler.tick(context.Background(), time.Now())
+		scheduler.wg.Wait()
+
+		// then
+		result, _, _, err := runs.List(jobsapi.ListParameters{JobNames: []string{"cleanup"}})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, run.ID, result[0].ID)
+		assert.Equal(t, jobsapi.Failed, result[0].State)
+		assert.Equal(t, "admin@example.com", result[0].TriggeredBy)
+		assert.Equal(t, "broker unavailable", result[0].Error)
+		assert.Equal(t, []string{"inst-1"}, result[0].Affected)
+	})
+
+	t.Run("should fail the runs left running by the previous leader", func(t *testing.T) {
+		// given
+		runs := storage.NewMemoryStorage().JobRuns()
+		startedAt := time.Now().Add(-time.Hour)
+		require.NoError(t, runs.Insert(jobsapi.RunDTO{ID: "run-1", JobName: "cleanup", State: jobsapi.Running, CreatedAt: startedAt, StartedAt: &startedAt}))
+		require.NoError(t, runs.Insert(jobsapi.RunDTO{ID: "run-2", JobName: "cleanup", State: jobsapi.Succeeded, CreatedAt: startedAt}))
+		scheduler := NewScheduler(runs, &fakeLeader{}, time.Minute, logrus.New())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// when
+		scheduler.Run(ctx)
+
+		// then
+		result, _, _, err := runs.List(jobsapi.ListParameters{States: []jobsapi.RunState{jobsapi.Failed}})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "run-1", result[0].ID)
+		assert.NotNil(t, result[0].FinishedAt)
+		assert.NotEmpty(t, result[0].Error)
+	})
+
+	t.Run("should cancel the runs when the leadership is lost", func(t *testing.T) {
+		// given
+		runs := storage.NewMemoryStorage().JobRuns()
+		scheduler := NewScheduler(runs, &fakeLeader{}, time.Millisecond, logrus.New())
+		started := make(chan struct{})
+		require.NoError(t, scheduler.Register(Job{
+			Name:     "cleanup",
+			Schedule: "0 0 1 1 *",
+			Run: func(ctx context.Context, log logrus.FieldLogger) ([]string, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		}))
+		_, err := scheduler.Trigger("cleanup", "admin@example.com")
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		stopped := make(chan struct{})
+		go func() {
+			scheduler.Run(ctx)
+			close(stopped)
+		}()
+
+		// when
+		<-started
+		cancel()
+		<-stopped
+
+		// then
+		result, _, _, err := runs.List(jobsapi.ListParameters{JobNames: []string{"cleanup"}})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, jobsapi.Failed, result[0].State)
+		assert.Equal(t, context.Canceled.Error(), result[0].Error)
+	})
+
+	t.Run("should not trigger unknown job", func(t *testing.T) {
+		// given
+		scheduler := NewScheduler(storage.NewMemoryStorage().JobRuns(), &fakeLeader{}, time.Minute, logrus.New())
+
+		// when
+		_, err := scheduler.Trigger("unknown", "admin@example.com")
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject invalid schedule", func(t *testing.T) {
+		// given
+		scheduler := NewScheduler(storage.NewMemoryStorage().JobRuns(), &fakeLeader{}, time.Minute, logrus.New())
+
+		// when
+		err := scheduler.Register(Job{Name: "cleanup", Schedule: "every hour"})
+
+		// then
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// HyperscalerAccountsCollector provides the state of the hyperscaler account pools:
+// - compass_keb_hyperscaler_accounts_total - number of secret bindings by hyperscaler type, EU access and state
+// - compass_keb_hyperscaler_accounts_below_low_watermark - 1 if the pool has fewer free secret bindings than the low watermark
+// - compass_keb_hyperscaler_accounts_oldest_dirty_seconds - time since the oldest secret binding of the pool was marked as dirty
+type HyperscalerAccountsCollector struct {
+	inventory AccountInventory
+
+	accountsDesc          *prometheus.Desc
+	belowLowWatermarkDesc *prometheus.Desc
+	oldestDirtyDesc       *prometheus.Desc
+}
+
+type AccountInventory interface {
+	Get() (hyperscaler.InventoryDTO, error)
+}
+
+func NewHyperscalerAccountsCollector(inventory AccountInventory) *HyperscalerAccountsCollector {
+	return &HyperscalerAccountsCollector{
+		inventory: inventory,
+
+		accountsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "hyperscaler_accounts_total"),
+			"The number of hyperscaler secret bindings by state",
+			[]string{"hyperscaler_type", "eu_access", "state"},
+			nil),
+		belowLowWatermarkDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "hyperscaler_accounts_below_low_watermark"),
+			"1 if the pool has fewer free hyperscaler secret bindings than the low watermark, 0 otherwise",
+			[]string{"hyperscaler_type", "eu_access"},
+			nil),
+		oldestDirtyDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "hyperscaler_accounts_oldest_dirty_seconds"),
+			"The time since the oldest hyperscaler secret binding of the pool was marked as dirty",
+			[]string{"hyperscaler_type", "eu_access"},
+			nil),
+	}
+}
+
+func (c *HyperscalerAccountsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.accountsDesc
+	ch <- c.belowLowWatermarkDesc
+	ch <- c.oldestDirtyDesc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *HyperscalerAccountsCollector) Collect(ch chan<- prometheus.Metric) {
+	inventory, err := c.inventory.Get()
+	if err != nil {
+		logrus.Errorf("while getting hyperscaler accounts inventory: %s", err)
+		return
+	}
+
+	for _, pool := range inventory.Pools {
+		hyperscalerType := string(pool.HyperscalerType)
+		euAccess := strconv.FormatBool(pool.EUAccess)
+		for state, count := range map[hyperscaler.AccountState]int{
+			hyperscaler.AccountFree:        pool.Free,
+			hyperscaler.AccountAssigned:    pool.Assigned,
+			hyperscaler.AccountDirty:       pool.Dirty,
+			hyperscaler.AccountQuarantined: pool.Quarantined,
+			hyperscaler.AccountShared:      pool.Shared,
+		} {
+			collect(ch, c.accountsDesc, count, hyperscalerType, euAccess, string(state))
+		}
+
+		belowLowWatermark := 0
+		if pool.BelowLowWatermark {
+			belowLowWatermark = 1
+		}
+		collect(ch, c.belowLowWatermarkDesc, belowLowWatermark, hyperscalerType, euAccess)
+
+		oldestDirty := 0
+		if pool.OldestDirtySince != nil {
+			oldestDirty = int(time.Since(*pool.OldestDirtySince).Seconds())
+		}
+		collect(ch, c.oldestDirtyDesc, oldestDirty, hyperscalerType, euAccess)
+	}
+}
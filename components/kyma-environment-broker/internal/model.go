@@ -37,6 +37,8 @@ type ProvisionerInputCreator interface {
 	EnableOptionalComponent(componentName string) ProvisionerInputCreator
 	DisableOptionalComponent(componentName string) ProvisionerInputCreator
 	Provider() CloudProvider
+	// Region returns the region of the cluster resolved from the plan defaults and the provisioning parameters
+	Region() string
 	Configuration() *ConfigForPlan
 
 	CreateClusterConfiguration() (reconcilerApi.Cluster, error)
@@ -550,6 +552,14 @@ func NewUpdateOperation(operationID string, instance *Instance, updatingParams U
 		op.ProvisioningParameters.Parameters.MachineType = updatingParams.MachineType
 	}
 
+	if updatingParams.AdditionalWorkerNodePools != nil {
+		op.ProvisioningParameters.Parameters.AdditionalWorkerNodePools = updatingParams.AdditionalWorkerNodePools
+	}
+
+	if updatingParams.MaintenanceWindow != nil {
+		op.ProvisioningParameters.Parameters.MaintenanceWindow = updatingParams.MaintenanceWindow
+	}
+
 	return op
 }
 
@@ -2,7 +2,9 @@ package internal
 
 import (
 	"testing"
+	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,3 +56,257 @@ func countStageOccurrences(operation ProvisioningOperation, stage string) int {
 	}
 	return foundStages
 }
+
+func TestNewUpdateOperation(t *testing.T) {
+	t.Run("should replace additional worker node pools", func(t *testing.T) {
+		// given
+		instance := &Instance{
+			InstanceID: "instance-id",
+			Parameters: ProvisioningParameters{
+				Parameters: ProvisioningParametersDTO{
+					AdditionalWorkerNodePools: AdditionalWorkerNodePools{{Name: "mem-worker", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+				},
+			},
+		}
+		pools := AdditionalWorkerNodePools{{Name: "gpu-worker", MachineType: "g4dn.xlarge", AutoScalerMin: 0, AutoScalerMax: 2}}
+
+		// when
+		operation := NewUpdateOperation("operation-id", instance, UpdatingParametersDTO{AdditionalWorkerNodePools: pools})
+
+		// then
+		assert.Equal(t, pools, operation.ProvisioningParameters.Parameters.AdditionalWorkerNodePools)
+	})
+
+	t.Run("should keep additional worker node pools when not provided", func(t *testing.T) {
+		// given
+		pools := AdditionalWorkerNodePools{{Name: "mem-worker", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}}
+		instance := &Instance{
+			InstanceID: "instance-id",
+			Parameters: ProvisioningParameters{
+				Parameters: ProvisioningParametersDTO{AdditionalWorkerNodePools: pools},
+			},
+		}
+
+		// when
+		operation := NewUpdateOperation("operation-id", instance, UpdatingParametersDTO{})
+
+		// then
+		assert.Equal(t, pools, operation.ProvisioningParameters.Parameters.AdditionalWorkerNodePools)
+	})
+
+	t.Run("should replace maintenance window", func(t *testing.T) {
+		// given
+		instance := &Instance{
+			InstanceID: "instance-id",
+			Parameters: ProvisioningParameters{
+				Parameters: ProvisioningParametersDTO{
+					MaintenanceWindow: &MaintenanceWindowDTO{Begin: "01:00", End: "02:00"},
+				},
+			},
+		}
+		window := &MaintenanceWindowDTO{Days: []string{"Sat"}, Begin: "22:00", End: "23:00", TimeZone: "Europe/Berlin"}
+
+		// when
+		operation := NewUpdateOperation("operation-id", instance, UpdatingParametersDTO{MaintenanceWindow: window})
+
+		// then
+		assert.Equal(t, window, operation.ProvisioningParameters.Parameters.MaintenanceWindow)
+	})
+}
+
+func TestAdditionalWorkerNodePools_Validate(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		pools   AdditionalWorkerNodePools
+		wantErr bool
+	}{
+		"valid pools": {
+			pools: AdditionalWorkerNodePools{
+				{Name: "mem-worker", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3},
+				{Name: "gpu-worker", MachineType: "g4dn.xlarge", AutoScalerMin: 0, AutoScalerMax: 2},
+			},
+		},
+		"no pools": {},
+		"duplicated name": {
+			pools: AdditionalWorkerNodePools{
+				{Name: "mem-worker", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3},
+				{Name: "mem-worker", MachineType: "m5.2xlarge", AutoScalerMin: 1, AutoScalerMax: 3},
+			},
+			wantErr: true,
+		},
+		"missing machine type": {
+			pools:   AdditionalWorkerNodePools{{Name: "mem-worker", AutoScalerMin: 1, AutoScalerMax: 3}},
+			wantErr: true,
+		},
+		"min larger than max": {
+			pools:   AdditionalWorkerNodePools{{Name: "mem-worker", MachineType: "m5.xlarge", AutoScalerMin: 4, AutoScalerMax: 3}},
+			wantErr: true,
+		},
+		"name reserved for the default pool": {
+			pools:   AdditionalWorkerNodePools{{Name: "cpu-worker-0", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+			wantErr: true,
+		},
+		"name longer than 15 characters": {
+			pools:   AdditionalWorkerNodePools{{Name: "memory-worker-16", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+			wantErr: true,
+		},
+		"name with upper case characters": {
+			pools:   AdditionalWorkerNodePools{{Name: "Mem-worker", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+			wantErr: true,
+		},
+		"name ending with a dash": {
+			pools:   AdditionalWorkerNodePools{{Name: "mem-worker-", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+			wantErr: true,
+		},
+		"name of 15 characters": {
+			pools: AdditionalWorkerNodePools{{Name: "memory-worker-1", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := testCase.pools.Validate()
+
+			// then
+			assert.Equal(t, testCase.wantErr, err != nil)
+		})
+	}
+}
+
+func TestNetworkingDTO_Validate(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		networking NetworkingDTO
+		wantErr    bool
+	}{
+		"valid ranges": {
+			networking: NetworkingDTO{
+				Pods:     ptr.String("100.64.0.0/12"),
+				Services: ptr.String("100.104.0.0/13"),
+				Nodes:    ptr.String("10.250.0.0/16"),
+			},
+		},
+		"pods range only": {
+			networking: NetworkingDTO{Pods: ptr.String("100.64.0.0/12")},
+		},
+		"invalid services range": {
+			networking: NetworkingDTO{Services: ptr.String("100.104.0.0")},
+			wantErr:    true,
+		},
+		"pods overlapping with services": {
+			networking: NetworkingDTO{Pods: ptr.String("100.64.0.0/12"), Services: ptr.String("100.72.0.0/16")},
+			wantErr:    true,
+		},
+		"pods overlapping with default nodes range": {
+			networking: NetworkingDTO{Pods: ptr.String("10.250.128.0/17")},
+			wantErr:    true,
+		},
+		"nodes not containing workers range": {
+			networking: NetworkingDTO{Nodes: ptr.String("10.251.0.0/16")},
+			wantErr:    true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := testCase.networking.Validate("10.250.0.0/19", "10.250.0.0/16")
+
+			// then
+			assert.Equal(t, testCase.wantErr, err != nil)
+		})
+	}
+}
+
+func TestMaintenanceWindowDTO_Validate(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		window  MaintenanceWindowDTO
+		wantErr bool
+	}{
+		"valid window": {
+			window: MaintenanceWindowDTO{Days: []string{"Sat", "Sun"}, Begin: "22:00", End: "02:00", TimeZone: "Europe/Berlin"},
+		},
+		"window in UTC on all days": {
+			window: MaintenanceWindowDTO{Begin: "01:00", End: "02:30"},
+		},
+		"invalid day": {
+			window:  MaintenanceWindowDTO{Days: []string{"Saturday"}, Begin: "22:00", End: "23:00"},
+			wantErr: true,
+		},
+		"invalid begin": {
+			window:  MaintenanceWindowDTO{Begin: "2200", End: "23:00"},
+			wantErr: true,
+		},
+		"invalid end": {
+			window:  MaintenanceWindowDTO{Begin: "22:00", End: "24:00"},
+			wantErr: true,
+		},
+		"empty window": {
+			window:  MaintenanceWindowDTO{Begin: "22:00", End: "22:00"},
+			wantErr: true,
+		},
+		"window of 30 minutes": {
+			window: MaintenanceWindowDTO{Begin: "22:00", End: "22:30"},
+		},
+		"window shorter than 30 minutes": {
+			window:  MaintenanceWindowDTO{Begin: "22:00", End: "22:10"},
+			wantErr: true,
+		},
+		"window of 6 hours ending on the next day": {
+			window: MaintenanceWindowDTO{Begin: "22:00", End: "04:00"},
+		},
+		"window longer than 6 hours ending on the next day": {
+			window:  MaintenanceWindowDTO{Begin: "20:00", End: "04:00"},
+			wantErr: true,
+		},
+		"window longer than 6 hours": {
+			window:  MaintenanceWindowDTO{Begin: "01:00", End: "07:01"},
+			wantErr: true,
+		},
+		"invalid time zone": {
+			window:  MaintenanceWindowDTO{Begin: "22:00", End: "23:00", TimeZone: "Europe/Nowhere"},
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := testCase.window.Validate()
+
+			// then
+			assert.Equal(t, testCase.wantErr, err != nil)
+		})
+	}
+}
+
+func TestMaintenanceWindowDTO_GardenerTimeWindow(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		window    MaintenanceWindowDTO
+		at        time.Time
+		wantBegin string
+		wantEnd   string
+	}{
+		"window in UTC": {
+			window:    MaintenanceWindowDTO{Begin: "01:00", End: "02:30"},
+			at:        time.Date(2022, time.January, 10, 12, 0, 0, 0, time.UTC),
+			wantBegin: "010000+0000",
+			wantEnd:   "023000+0000",
+		},
+		"window in winter time": {
+			window:    MaintenanceWindowDTO{Begin: "22:00", End: "02:00", TimeZone: "Europe/Berlin"},
+			at:        time.Date(2022, time.January, 10, 12, 0, 0, 0, time.UTC),
+			wantBegin: "220000+0100",
+			wantEnd:   "020000+0100",
+		},
+		"window in summer time": {
+			window:    MaintenanceWindowDTO{Begin: "22:00", End: "02:00", TimeZone: "Europe/Berlin"},
+			at:        time.Date(2022, time.July, 10, 12, 0, 0, 0, time.UTC),
+			wantBegin: "220000+0200",
+			wantEnd:   "020000+0200",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			begin, end, err := testCase.window.GardenerTimeWindow(testCase.at)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantBegin, begin)
+			assert.Equal(t, testCase.wantEnd, end)
+		})
+	}
+}
@@ -404,6 +404,10 @@ func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, s
 
 // resolves the next exact maintenance window time for the runtime
 func resolveMaintenanceWindowTime(r orchestration.Runtime, policy orchestration.MaintenancePolicy, after time.Time) (time.Time, time.Time, []string) {
+	// The maintenance window requested by the customer takes precedence over the maintenance policy
+	if r.CustomMaintenanceWindow {
+		policy = orchestration.MaintenancePolicy{}
+	}
 	ruleMatched := false
 
 	for _, p := range policy.Rules {
@@ -468,6 +472,10 @@ func resolveMaintenanceWindowTime(r orchestration.Runtime, policy orchestration.
 	if after.After(n) {
 		n = after
 	}
+	// The days of the customer window refer to the time zone of the window
+	if r.CustomMaintenanceWindow {
+		n = n.In(r.MaintenanceWindowBegin.Location())
+	}
 	availableDays := orchestration.ConvertSliceOfDaysToMap(r.MaintenanceDays)
 	start := time.Date(n.Year(), n.Month(), n.Day(), r.MaintenanceWindowBegin.Hour(), r.MaintenanceWindowBegin.Minute(), r.MaintenanceWindowBegin.Second(), r.MaintenanceWindowBegin.Nanosecond(), r.MaintenanceWindowBegin.Location())
 	end := time.Date(n.Year(), n.Month(), n.Day(), r.MaintenanceWindowEnd.Hour(), r.MaintenanceWindowEnd.Minute(), r.MaintenanceWindowEnd.Second(), r.MaintenanceWindowEnd.Nanosecond(), r.MaintenanceWindowEnd.Location())
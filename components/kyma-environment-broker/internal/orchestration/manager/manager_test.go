@@ -0,0 +1,64 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveMaintenanceWindowTime(t *testing.T) {
+	policy := orchestration.MaintenancePolicy{
+		Default: orchestration.MaintenancePolicyEntry{
+			Days:      []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
+			TimeBegin: "010000+0000",
+			TimeEnd:   "020000+0000",
+		},
+	}
+	// Wednesday
+	after := time.Now().AddDate(0, 1, 0)
+	after = time.Date(after.Year(), after.Month(), after.Day(), 12, 0, 0, 0, time.UTC)
+	after = after.AddDate(0, 0, int(time.Wednesday-after.Weekday()))
+
+	t.Run("should apply the maintenance policy", func(t *testing.T) {
+		// given
+		r := orchestration.Runtime{
+			MaintenanceWindowBegin: time.Date(0, time.January, 1, 3, 0, 0, 0, time.UTC),
+			MaintenanceWindowEnd:   time.Date(0, time.January, 1, 4, 0, 0, 0, time.UTC),
+			MaintenanceDays:        []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
+		}
+
+		// when
+		begin, end, days := resolveMaintenanceWindowTime(r, policy, after)
+
+		// then
+		assert.Equal(t, time.Thursday, begin.Weekday())
+		assert.Equal(t, 1, begin.UTC().Hour())
+		assert.Equal(t, 2, end.UTC().Hour())
+		assert.Equal(t, policy.Default.Days, days)
+	})
+
+	t.Run("should prefer the maintenance window requested by the customer", func(t *testing.T) {
+		// given
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+		r := orchestration.Runtime{
+			MaintenanceWindowBegin:  time.Date(0, time.January, 1, 22, 0, 0, 0, berlin),
+			MaintenanceWindowEnd:    time.Date(0, time.January, 1, 23, 0, 0, 0, berlin),
+			MaintenanceDays:         []string{"Sat"},
+			CustomMaintenanceWindow: true,
+		}
+
+		// when
+		begin, end, days := resolveMaintenanceWindowTime(r, policy, after)
+
+		// then
+		assert.Equal(t, time.Saturday, begin.Weekday())
+		assert.Equal(t, 22, begin.Hour())
+		assert.Equal(t, 23, end.Hour())
+		assert.Equal(t, berlin, begin.Location())
+		assert.Equal(t, []string{"Sat"}, days)
+	})
+}
@@ -0,0 +1,139 @@
+package planvisibility
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type Handler struct {
+	service *Service
+	rules   storage.PlanVisibility
+	log     logrus.FieldLogger
+}
+
+func NewHandler(service *Service, rules storage.PlanVisibility, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		service: service,
+		rules:   rules,
+		log:     log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/plan-visibility", h.listRules).Methods(http.MethodGet)
+	router.HandleFunc("/plan-visibility/{plan_name}/{scope_type}/{scope_value}", h.setRule).Methods(http.MethodPut)
+	router.HandleFunc("/plan-visibility/{plan_name}/{scope_type}/{scope_value}", h.deleteRule).Methods(http.MethodDelete)
+}
+
+func (h *Handler) listRules(w http.ResponseWriter, req *http.Request) {
+	params, err := planvisibility.ParseListParameters(req.URL.Query())
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rules, err := h.rules.List(params)
+	if err != nil {
+		h.log.Errorf("while listing plan visibility rules: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while listing plan visibility rules: %w", err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, planvisibility.RulesDTO{
+		RestrictedPlans: h.service.RestrictedPlans(),
+		Rules:           rules,
+	})
+}
+
+func (h *Handler) setRule(w http.ResponseWriter, req *http.Request) {
+	planName, scopeType, scopeValue, err := h.ruleKey(req)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var body planvisibility.RuleRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %w", err))
+		return
+	}
+	if err := planvisibility.ValidateFeatures(body.Features); err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	caller, _ := audit.ResolveCaller(req)
+	now := time.Now()
+	rule := planvisibility.RuleDTO{
+		PlanName:   planName,
+		ScopeType:  scopeType,
+		ScopeValue: scopeValue,
+		Visible:    body.Visible,
+		Features:   body.Features,
+		UpdatedBy:  caller,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := h.rules.Upsert(rule); err != nil {
+		h.log.Errorf("while saving visibility rule of the plan %s for %s %s: %v", planName, scopeType, scopeValue, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while saving plan visibility rule: %w", err))
+		return
+	}
+	h.service.Invalidate()
+	h.log.Infof("Visibility rule of the plan %s for %s %s set by %s: visible=%t, features=%v", planName, scopeType, scopeValue, caller, body.Visible, body.Features)
+
+	httputil.WriteResponse(w, http.StatusOK, rule)
+}
+
+func (h *Handler) deleteRule(w http.ResponseWriter, req *http.Request) {
+	planName, scopeType, scopeValue, err := h.ruleKey(req)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = h.rules.Delete(planName, scopeType, scopeValue)
+	switch {
+	case dberr.IsNotFound(err):
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("visibility rule of the plan %s for %s %s not found", planName, scopeType, scopeValue))
+		return
+	case err != nil:
+		h.log.Errorf("while deleting visibility rule of the plan %s for %s %s: %v", planName, scopeType, scopeValue, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while deleting plan visibility rule: %w", err))
+		return
+	}
+	h.service.Invalidate()
+	caller, _ := audit.ResolveCaller(req)
+	h.log.Infof("Visibility rule of the plan %s for %s %s deleted by %s", planName, scopeType, scopeValue, caller)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ruleKey(req *http.Request) (string, planvisibility.ScopeType, string, error) {
+	vars := mux.Vars(req)
+	planName := vars["plan_name"]
+	if _, exists := broker.PlanIDsMapping[planName]; !exists {
+		return "", "", "", fmt.Errorf("unknown plan %q", planName)
+	}
+	scopeType, err := planvisibility.ParseScopeType(vars["scope_type"])
+	if err != nil {
+		return "", "", "", err
+	}
+	scopeValue := vars["scope_value"]
+	if scopeValue == "" {
+		return "", "", "", fmt.Errorf("scope value cannot be empty")
+	}
+	return planName, scopeType, scopeValue, nil
+}
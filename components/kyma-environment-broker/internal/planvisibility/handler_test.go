@@ -0,0 +1,74 @@
+package planvisibility
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+)
+
+func TestHandler(t *testing.T) {
+	// given
+	rules := storage.NewMemoryStorage().PlanVisibility()
+	svc := NewService(Config{RestrictedPlans: []string{"preview"}, CacheTTL: time.Hour}, rules, logrus.New())
+	router := mux.NewRouter()
+	NewHandler(svc, rules, logrus.New()).AttachRoutes(router)
+	call := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+	scope := planvisibility.Scope{GlobalAccountID: "ga-1"}
+	require.False(t, svc.Available("preview", scope))
+
+	t.Run("should set the rule and invalidate the cache", func(t *testing.T) {
+		// when
+		rr := call(http.MethodPut, "/plan-visibility/preview/globalAccount/ga-1", `{"visible": true, "features": ["additionalParams"]}`)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.True(t, svc.Available("preview", scope))
+		assert.Equal(t, []string{planvisibility.AdditionalParamsFeature}, svc.Features("preview", scope))
+	})
+
+	t.Run("should list the rules", func(t *testing.T) {
+		// when
+		rr := call(http.MethodGet, "/plan-visibility?plan=preview&scope_type=globalAccount", "")
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response planvisibility.RulesDTO
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, []string{"preview"}, response.RestrictedPlans)
+		require.Len(t, response.Rules, 1)
+		assert.Equal(t, "ga-1", response.Rules[0].ScopeValue)
+	})
+
+	t.Run("should reject invalid rules", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, call(http.MethodPut, "/plan-visibility/unknown/globalAccount/ga-1", `{"visible": true}`).Code)
+		assert.Equal(t, http.StatusBadRequest, call(http.MethodPut, "/plan-visibility/preview/account/ga-1", `{"visible": true}`).Code)
+		assert.Equal(t, http.StatusBadRequest, call(http.MethodPut, "/plan-visibility/preview/globalAccount/ga-1", `{"features": ["unknown"]}`).Code)
+		assert.Equal(t, http.StatusBadRequest, call(http.MethodGet, "/plan-visibility?scope_type=account", "").Code)
+	})
+
+	t.Run("should delete the rule", func(t *testing.T) {
+		// when
+		rr := call(http.MethodDelete, "/plan-visibility/preview/globalAccount/ga-1", "")
+
+		// then
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		assert.False(t, svc.Available("preview", scope))
+		assert.Equal(t, http.StatusNotFound, call(http.MethodDelete, "/plan-visibility/preview/globalAccount/ga-1", "").Code)
+	})
+}
@@ -0,0 +1,146 @@
+package planvisibility
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+)
+
+type Config struct {
+	// RestrictedPlans are offered only to the global accounts, subaccounts and platform regions with a visible rule
+	RestrictedPlans []string      `envconfig:"optional"`
+	CacheTTL        time.Duration `envconfig:"default=1m"`
+}
+
+// Service decides about the plan visibility and the plan features using the rules stored in the database. The rules
+// are cached for the CacheTTL, the cache is invalidated when the rules are changed with the admin API.
+type Service struct {
+	restrictedPlans map[string]struct{}
+	rules           storage.PlanVisibility
+	cacheTTL        time.Duration
+	log             logrus.FieldLogger
+
+	mu       sync.Mutex
+	cached   []planvisibility.RuleDTO
+	loadedAt time.Time
+}
+
+func NewService(cfg Config, rules storage.PlanVisibility, log logrus.FieldLogger) *Service {
+	restrictedPlans := map[string]struct{}{}
+	for _, planName := range cfg.RestrictedPlans {
+		restrictedPlans[planName] = struct{}{}
+	}
+
+	return &Service{
+		restrictedPlans: restrictedPlans,
+		rules:           rules,
+		cacheTTL:        cfg.CacheTTL,
+		log:             log.WithField("service", "PlanVisibility"),
+	}
+}
+
+// RestrictedPlans returns the names of the restricted plans in alphabetical order
+func (s *Service) RestrictedPlans() []string {
+	names := make([]string, 0, len(s.restrictedPlans))
+	for name := range s.restrictedPlans {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Service) IsRestricted(planName string) bool {
+	_, restricted := s.restrictedPlans[planName]
+	return restricted
+}
+
+// InCatalog returns true if the plan is offered in the catalog of the platform region. The catalog request does not
+// identify the account, so a restricted plan is offered if it is visible in the platform region or to any account,
+// and the account is checked when the instance is provisioned.
+func (s *Service) InCatalog(planName, platformRegion string) bool {
+	if !s.IsRestricted(planName) {
+		return true
+	}
+	for _, rule := range s.planRules(planName) {
+		if !rule.Visible {
+			continue
+		}
+		if rule.ScopeType != planvisibility.PlatformRegionScope || rule.ScopeValue == platformRegion {
+			return true
+		}
+	}
+	return false
+}
+
+// Available returns true if the plan can be provisioned in the scope
+func (s *Service) Available(planName string, scope planvisibility.Scope) bool {
+	if !s.IsRestricted(planName) {
+		return true
+	}
+	for _, rule := range s.planRules(planName) {
+		if rule.Visible && scope.Matches(rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// Features returns the features of the plan enabled by the rules matching the scope
+func (s *Service) Features(planName string, scope planvisibility.Scope) []string {
+	enabled := map[string]struct{}{}
+	for _, rule := range s.planRules(planName) {
+		if !scope.Matches(rule) {
+			continue
+		}
+		for _, feature := range rule.Features {
+			enabled[feature] = struct{}{}
+		}
+	}
+
+	features := make([]string, 0, len(enabled))
+	for feature := range enabled {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+	return features
+}
+
+// Invalidate makes the next check load the rules from the database
+func (s *Service) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadedAt = time.Time{}
+}
+
+func (s *Service) planRules(planName string) []planvisibility.RuleDTO {
+	var rules []planvisibility.RuleDTO
+	for _, rule := range s.allRules() {
+		if rule.PlanName == planName {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// allRules returns the cached rules, the previously loaded rules are used when the database is not available
+func (s *Service) allRules() []planvisibility.RuleDTO {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) < s.cacheTTL {
+		return s.cached
+	}
+	rules, err := s.rules.List(planvisibility.ListParameters{})
+	if err != nil {
+		s.log.Errorf("while loading plan visibility rules, using the previously loaded rules: %s", err)
+		return s.cached
+	}
+	s.cached = rules
+	s.loadedAt = time.Now()
+	return s.cached
+}
@@ -0,0 +1,61 @@
+package planvisibility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+)
+
+func TestService(t *testing.T) {
+	// given
+	rules := storage.NewMemoryStorage().PlanVisibility()
+	for _, rule := range []planvisibility.RuleDTO{
+		{PlanName: "preview", ScopeType: planvisibility.GlobalAccountScope, ScopeValue: "ga-1", Visible: true},
+		{PlanName: "preview", ScopeType: planvisibility.PlatformRegionScope, ScopeValue: "cf-eu10", Visible: true},
+		{PlanName: "own_cluster", ScopeType: planvisibility.SubAccountScope, ScopeValue: "sa-1", Visible: false},
+		{PlanName: "aws", ScopeType: planvisibility.GlobalAccountScope, ScopeValue: "ga-1", Features: []string{planvisibility.AdditionalParamsFeature}},
+		{PlanName: "aws", ScopeType: planvisibility.PlatformRegionScope, ScopeValue: "cf-eu10", Features: []string{planvisibility.AdditionalParamsFeature}},
+	} {
+		require.NoError(t, rules.Upsert(rule))
+	}
+	svc := NewService(Config{RestrictedPlans: []string{"preview", "own_cluster"}, CacheTTL: time.Hour}, rules, logrus.New())
+
+	t.Run("should offer restricted plans with visible rules in the catalog", func(t *testing.T) {
+		assert.True(t, svc.InCatalog("aws", "cf-us10"))
+		assert.True(t, svc.InCatalog("preview", "cf-eu10"))
+		// visible to the ga-1 global account, which can use any region
+		assert.True(t, svc.InCatalog("preview", "cf-us10"))
+		assert.False(t, svc.InCatalog("own_cluster", "cf-eu10"))
+	})
+
+	t.Run("should allow restricted plans only for the matching scopes", func(t *testing.T) {
+		assert.True(t, svc.Available("aws", planvisibility.Scope{GlobalAccountID: "ga-2"}))
+		assert.True(t, svc.Available("preview", planvisibility.Scope{GlobalAccountID: "ga-1", SubAccountID: "sa-2", PlatformRegion: "cf-us10"}))
+		assert.True(t, svc.Available("preview", planvisibility.Scope{GlobalAccountID: "ga-2", SubAccountID: "sa-2", PlatformRegion: "cf-eu10"}))
+		assert.False(t, svc.Available("preview", planvisibility.Scope{GlobalAccountID: "ga-2", SubAccountID: "sa-2", PlatformRegion: "cf-us10"}))
+		assert.False(t, svc.Available("own_cluster", planvisibility.Scope{GlobalAccountID: "ga-1", SubAccountID: "sa-1"}))
+	})
+
+	t.Run("should return the features of the matching rules", func(t *testing.T) {
+		assert.Equal(t, []string{planvisibility.AdditionalParamsFeature}, svc.Features("aws", planvisibility.Scope{GlobalAccountID: "ga-1", PlatformRegion: "cf-eu10"}))
+		assert.Empty(t, svc.Features("aws", planvisibility.Scope{GlobalAccountID: "ga-2", PlatformRegion: "cf-us10"}))
+	})
+
+	t.Run("should reload the rules after invalidation", func(t *testing.T) {
+		// given
+		require.NoError(t, rules.Upsert(planvisibility.RuleDTO{PlanName: "own_cluster", ScopeType: planvisibility.SubAccountScope, ScopeValue: "sa-1", Visible: true}))
+		assert.False(t, svc.Available("own_cluster", planvisibility.Scope{SubAccountID: "sa-1"}))
+
+		// when
+		svc.Invalidate()
+
+		// then
+		assert.True(t, svc.Available("own_cluster", planvisibility.Scope{SubAccountID: "sa-1"}))
+	})
+}
@@ -121,6 +121,20 @@ func (_m *CreatorForPlan) IsPlanSupport(planID string) bool {
 	return r0
 }
 
+// ValidateHighAvailability provides a mock function with given fields: parameters
+func (_m *CreatorForPlan) ValidateHighAvailability(parameters internal.ProvisioningParameters) error {
+	ret := _m.Called(parameters)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(internal.ProvisioningParameters) error); ok {
+		r0 = rf(parameters)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewCreatorForPlan interface {
 	mock.TestingT
 	Cleanup(func())
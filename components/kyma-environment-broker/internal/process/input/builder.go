@@ -45,6 +45,7 @@ type (
 		CreateUpgradeInput(parameters internal.ProvisioningParameters, version internal.RuntimeVersionData) (internal.ProvisionerInputCreator, error)
 		CreateUpgradeShootInput(parameters internal.ProvisioningParameters, version internal.RuntimeVersionData) (internal.ProvisionerInputCreator, error)
 		GetPlanDefaults(planID string, platformProvider internal.CloudProvider, parametersProvider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error)
+		ValidateHighAvailability(parameters internal.ProvisioningParameters) error
 	}
 
 	ComponentListProvider interface {
@@ -114,6 +115,44 @@ func (f *InputBuilderFactory) GetPlanDefaults(planID string, platformProvider in
 	return h.Defaults(), nil
 }
 
+// ValidateHighAvailability checks if the plan supports the zone failure tolerant control plane and if the workers
+// can be spread across enough zones of the requested region
+func (f *InputBuilderFactory) ValidateHighAvailability(parameters internal.ProvisioningParameters) error {
+	planName := broker.PlanNamesMapping[parameters.PlanID]
+	provider, err := f.getHyperscalerProviderForPlanID(parameters.PlanID, parameters.PlatformProvider, parameters.Parameters.Provider)
+	if err != nil {
+		return err
+	}
+	switch provider.(type) {
+	case *cloudProvider.AWSInput, *cloudProvider.GcpInput, *cloudProvider.AzureInput:
+	default:
+		return fmt.Errorf("high availability is not supported for the plan %s", planName)
+	}
+
+	clusterConfig := provider.Defaults()
+	if region := parameters.Parameters.Region; region != nil && *region != "" {
+		clusterConfig.GardenerConfig.Region = *region
+	}
+	provider.ApplyParameters(clusterConfig, parameters)
+
+	regionZones := map[string]struct{}{}
+	for _, zone := range cloudProvider.RegionZones(clusterConfig) {
+		regionZones[zone] = struct{}{}
+	}
+	zones := cloudProvider.WorkerZones(clusterConfig)
+	for _, zone := range zones {
+		if _, found := regionZones[zone]; !found {
+			return fmt.Errorf("high availability requires workers in the zones of the region %s, but the zone %s is not available",
+				clusterConfig.GardenerConfig.Region, zone)
+		}
+	}
+	if len(zones) < cloudProvider.HighAvailabilityZonesCount {
+		return fmt.Errorf("high availability requires workers in %d zones, but only %d zones are available in the region %s",
+			cloudProvider.HighAvailabilityZonesCount, len(zones), clusterConfig.GardenerConfig.Region)
+	}
+	return nil
+}
+
 func (f *InputBuilderFactory) getHyperscalerProviderForPlanID(planID string, platformProvider internal.CloudProvider, parametersProvider *internal.CloudProvider) (HyperscalerInputProvider, error) {
 	var provider HyperscalerInputProvider
 	switch planID {
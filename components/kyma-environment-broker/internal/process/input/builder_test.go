@@ -271,3 +271,91 @@ func fixProvisioningParameters(planID, kymaVersion string) internal.Provisioning
 func fixTrialRegionMapping() map[string]string {
 	return map[string]string{}
 }
+
+func TestInputBuilderFactory_ValidateHighAvailability(t *testing.T) {
+	ibf, err := NewInputBuilderFactory(nil, runtime.NewDisabledComponentsProvider(), &automock.ComponentListProvider{},
+		mockConfigProvider(), Config{}, "1.10", fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	require.NoError(t, err)
+
+	for name, testCase := range map[string]struct {
+		planID  string
+		region  string
+		zones   []string
+		wantErr bool
+	}{
+		"aws with default region": {
+			planID: broker.AWSPlanID,
+		},
+		"aws region with three zones": {
+			planID: broker.AWSPlanID,
+			region: "us-east-1",
+		},
+		"aws region with two zones": {
+			planID:  broker.AWSPlanID,
+			region:  "us-west-1",
+			wantErr: true,
+		},
+		"aws explicit zones": {
+			planID:  broker.AWSPlanID,
+			zones:   []string{"eu-central-1a", "eu-central-1b"},
+			wantErr: true,
+		},
+		"gcp": {
+			planID: broker.GCPPlanID,
+			region: "us-central1",
+		},
+		"gcp explicit zones": {
+			planID: broker.GCPPlanID,
+			region: "us-central1",
+			zones:  []string{"us-central1-a", "us-central1-b", "us-central1-f"},
+		},
+		"gcp explicit zone missing in the region": {
+			planID:  broker.GCPPlanID,
+			region:  "europe-west3",
+			zones:   []string{"europe-west3-a", "europe-west3-b", "europe-west3-f"},
+			wantErr: true,
+		},
+		"gcp region without zones": {
+			planID:  broker.GCPPlanID,
+			region:  "europe-north1",
+			wantErr: true,
+		},
+		"azure": {
+			planID: broker.AzurePlanID,
+			region: "westeurope",
+		},
+		"azure region without zones": {
+			planID:  broker.AzurePlanID,
+			region:  "westus",
+			wantErr: true,
+		},
+		"azure lite": {
+			planID:  broker.AzureLitePlanID,
+			wantErr: true,
+		},
+		"openstack": {
+			planID:  broker.OpenStackPlanID,
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			pp := internal.ProvisioningParameters{
+				PlanID: testCase.planID,
+				Parameters: internal.ProvisioningParametersDTO{
+					HighAvailability: ptr.Bool(true),
+					Zones:            testCase.zones,
+				},
+			}
+			if testCase.region != "" {
+				pp.Parameters.Region = ptr.String(testCase.region)
+			}
+
+			// when
+			err := ibf.ValidateHighAvailability(pp)
+
+			// then
+			assert.Equal(t, testCase.wantErr, err != nil, "unexpected result: %v", err)
+		})
+	}
+}
@@ -325,6 +325,18 @@ func (r *RuntimeInput) Provider() internal.CloudProvider {
 	return r.hyperscalerInputProvider.Provider()
 }
 
+func (r *RuntimeInput) Region() string {
+	clusterConfig := r.hyperscalerInputProvider.Defaults()
+	if clusterConfig == nil || clusterConfig.GardenerConfig == nil {
+		return ""
+	}
+	if region := r.provisioningParameters.Parameters.Region; region != nil && *region != "" {
+		clusterConfig.GardenerConfig.Region = *region
+	}
+	r.hyperscalerInputProvider.ApplyParameters(clusterConfig, r.provisioningParameters)
+	return clusterConfig.GardenerConfig.Region
+}
+
 func (r *RuntimeInput) CreateClusterConfiguration() (reconcilerApi.Cluster, error) {
 	data, err := r.CreateProvisionRuntimeInput()
 	if err != nil {
@@ -455,6 +467,19 @@ func (r *RuntimeInput) applyProvisioningParametersForProvisionRuntime() error {
 	if params.LicenceType != nil {
 		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.LicenceType = params.LicenceType
 	}
+	r.provisionRuntimeInput.ClusterConfig.GardenerConfig.WorkerPools = workerPoolsInput(params.AdditionalWorkerNodePools)
+	if params.Networking != nil {
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.Networking = &gqlschema.NetworkingInput{
+			Pods:     params.Networking.Pods,
+			Services: params.Networking.Services,
+			Nodes:    params.Networking.Nodes,
+		}
+	}
+	maintenanceWindow, err := maintenanceWindowInput(params.MaintenanceWindow, time.Now())
+	if err != nil {
+		return err
+	}
+	r.provisionRuntimeInput.ClusterConfig.GardenerConfig.MaintenanceWindow = maintenanceWindow
 
 	// admins parameter check
 	if len(r.provisioningParameters.Parameters.RuntimeAdministrators) == 0 {
@@ -492,6 +517,12 @@ func (r *RuntimeInput) applyProvisioningParametersForUpgradeShoot() error {
 
 	updateInt(r.upgradeShootInput.GardenerConfig.MaxSurge, r.provisioningParameters.Parameters.MaxSurge)
 	updateInt(r.upgradeShootInput.GardenerConfig.MaxUnavailable, r.provisioningParameters.Parameters.MaxUnavailable)
+	r.upgradeShootInput.GardenerConfig.WorkerPools = workerPoolsInput(r.provisioningParameters.Parameters.AdditionalWorkerNodePools)
+	maintenanceWindow, err := maintenanceWindowInput(r.provisioningParameters.Parameters.MaintenanceWindow, time.Now())
+	if err != nil {
+		return err
+	}
+	r.upgradeShootInput.GardenerConfig.MaintenanceWindow = maintenanceWindow
 
 	return nil
 }
@@ -771,6 +802,36 @@ func updateInt(toUpdate *int, value *int) {
 	}
 }
 
+// workerPoolsInput returns nil if no additional worker node pools were requested, so that the provisioner keeps the existing ones
+func workerPoolsInput(pools internal.AdditionalWorkerNodePools) []*gqlschema.WorkerPoolInput {
+	if pools == nil {
+		return nil
+	}
+	result := make([]*gqlschema.WorkerPoolInput, 0, len(pools))
+	for _, pool := range pools {
+		result = append(result, &gqlschema.WorkerPoolInput{
+			Name:          pool.Name,
+			MachineType:   pool.MachineType,
+			AutoScalerMin: pool.AutoScalerMin,
+			AutoScalerMax: pool.AutoScalerMax,
+		})
+	}
+	return result
+}
+
+// maintenanceWindowInput returns nil if no maintenance window was requested, so that the provisioner keeps the existing one.
+// Gardener applies the window every day, the days are used only when scheduling orchestrations
+func maintenanceWindowInput(window *internal.MaintenanceWindowDTO, at time.Time) (*gqlschema.MaintenanceWindowInput, error) {
+	if window == nil {
+		return nil, nil
+	}
+	begin, end, err := window.GardenerTimeWindow(at)
+	if err != nil {
+		return nil, fmt.Errorf("while converting the maintenance window: %w", err)
+	}
+	return &gqlschema.MaintenanceWindowInput{Begin: begin, End: end}, nil
+}
+
 func randomString(n int) string {
 	var letters = []rune("abcdefghijklmnopqrstuvwxyz")
 
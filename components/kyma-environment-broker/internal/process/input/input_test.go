@@ -1110,6 +1110,113 @@ func TestCreateProvisionRuntimeInput_ConfigureAdmins(t *testing.T) {
 	})
 }
 
+func TestCreateProvisionRuntimeInput_AdditionalWorkerNodePools(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("*internal.ConfigForPlan")).Return(fixKymaComponentList(), nil)
+
+	configProvider := mockConfigProvider()
+
+	inputBuilder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(),
+		componentsProvider, configProvider, Config{}, "1.24.0",
+		fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.AdditionalWorkerNodePools = internal.AdditionalWorkerNodePools{
+		{Name: "workers", MachineType: "m5.2xlarge", AutoScalerMin: 1, AutoScalerMax: 5},
+	}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []*gqlschema.WorkerPoolInput{
+		{Name: "workers", MachineType: "m5.2xlarge", AutoScalerMin: 1, AutoScalerMax: 5},
+	}, input.ClusterConfig.GardenerConfig.WorkerPools)
+}
+
+func TestCreateProvisionRuntimeInput_Networking(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("*internal.ConfigForPlan")).Return(fixKymaComponentList(), nil)
+
+	configProvider := mockConfigProvider()
+
+	inputBuilder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(),
+		componentsProvider, configProvider, Config{}, "1.24.0",
+		fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.Networking = &internal.NetworkingDTO{
+		Pods:     ptr.String("10.64.0.0/12"),
+		Services: ptr.String("10.128.0.0/16"),
+	}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, &gqlschema.NetworkingInput{
+		Pods:     ptr.String("10.64.0.0/12"),
+		Services: ptr.String("10.128.0.0/16"),
+	}, input.ClusterConfig.GardenerConfig.Networking)
+}
+
+func TestCreateProvisionRuntimeInput_MaintenanceWindow(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("*internal.ConfigForPlan")).Return(fixKymaComponentList(), nil)
+
+	configProvider := mockConfigProvider()
+
+	inputBuilder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(),
+		componentsProvider, configProvider, Config{}, "1.24.0",
+		fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.MaintenanceWindow = &internal.MaintenanceWindowDTO{
+		Days:  []string{"Sat"},
+		Begin: "22:00",
+		End:   "23:30",
+	}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, &gqlschema.MaintenanceWindowInput{
+		Begin: "220000+0000",
+		End:   "233000+0000",
+	}, input.ClusterConfig.GardenerConfig.MaintenanceWindow)
+}
+
 func assertAllConfigsContainsGlobals(t *testing.T, components []reconcilerApi.Component, domainName string) {
 	for _, cmp := range components {
 		found := false
@@ -1395,3 +1502,85 @@ func mockConfigProvider() ConfigurationProvider {
 		}, nil)
 	return configProvider
 }
+
+func TestCreateUpgradeShootInput_MaintenanceWindow(t *testing.T) {
+	// given
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("*internal.ConfigForPlan")).Return(fixKymaComponentList(), nil)
+
+	configProvider := mockConfigProvider()
+
+	ibf, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(),
+		componentsProvider, configProvider, Config{}, "1.24.0",
+		fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	pp := fixProvisioningParameters(broker.GCPPlanID, "")
+	pp.Parameters.MaintenanceWindow = &internal.MaintenanceWindowDTO{Begin: "01:00", End: "02:00", TimeZone: "UTC"}
+	ver := internal.RuntimeVersionData{
+		Version: "2.4.0",
+		Origin:  internal.Defaults,
+	}
+
+	rtinput, err := ibf.CreateUpgradeShootInput(pp, ver)
+	require.NoError(t, err)
+	rtinput = rtinput.SetProvisioningParameters(pp)
+
+	// when
+	input, err := rtinput.CreateUpgradeShootInput()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, &gqlschema.MaintenanceWindowInput{
+		Begin: "010000+0000",
+		End:   "020000+0000",
+	}, input.GardenerConfig.MaintenanceWindow)
+}
+
+func TestRuntimeInput_Region(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		planID         string
+		region         *string
+		expectedRegion string
+	}{
+		"should return the default region of the trial plan": {
+			planID:         broker.TrialPlanID,
+			expectedRegion: "eastus",
+		},
+		"should map the abstract region of the trial plan": {
+			planID:         broker.TrialPlanID,
+			region:         ptr.String("europe"),
+			expectedRegion: "westeurope",
+		},
+		"should return the region parameter": {
+			planID:         broker.AzurePlanID,
+			region:         ptr.String("northeurope"),
+			expectedRegion: "northeurope",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+			componentsProvider := &automock.ComponentListProvider{}
+			componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("*internal.ConfigForPlan")).Return(fixKymaComponentList(), nil)
+
+			builder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(),
+				componentsProvider, mockConfigProvider(), Config{}, "",
+				fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+			require.NoError(t, err)
+
+			pp := fixProvisioningParameters(testCase.planID, "")
+			pp.PlatformRegion = ""
+			pp.Parameters.Region = testCase.region
+			creator, err := builder.CreateProvisionInput(pp, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+			require.NoError(t, err)
+
+			// when
+			region := creator.Region()
+
+			// then
+			assert.Equal(t, testCase.expectedRegion, region)
+		})
+	}
+}
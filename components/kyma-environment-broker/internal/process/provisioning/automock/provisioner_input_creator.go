@@ -215,6 +215,20 @@ func (_m *ProvisionerInputCreator) Provider() internal.CloudProvider {
 	return r0
 }
 
+// Region provides a mock function with given fields:
+func (_m *ProvisionerInputCreator) Region() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // SetClusterName provides a mock function with given fields: name
 func (_m *ProvisionerInputCreator) SetClusterName(name string) internal.ProvisionerInputCreator {
 	ret := _m.Called(name)
@@ -55,6 +55,10 @@ func (c *simpleInputCreator) Provider() internal.CloudProvider {
 	return internal.Azure
 }
 
+func (c *simpleInputCreator) Region() string {
+	return ""
+}
+
 func (c *simpleInputCreator) SetLabel(key, val string) internal.ProvisionerInputCreator {
 	c.labels[key] = val
 	return c
@@ -50,8 +50,9 @@ func (s *ResolveCredentialsStep) Run(operation internal.Operation, log logrus.Fi
 	if !broker.IsTrialPlan(operation.ProvisioningParameters.PlanID) {
 		secretName, err = s.accountProvider.GardenerSecretName(hypType, operation.ProvisioningParameters.ErsContext.GlobalAccountID, euAccess)
 	} else {
-		log.Infof("HAP lookup for shared secret binding")
-		secretName, err = s.accountProvider.GardenerSharedSecretName(hypType, euAccess)
+		region := operation.InputCreator.Region()
+		log.Infof("HAP lookup for shared secret binding in region %s", region)
+		secretName, err = s.accountProvider.GardenerSharedSecretName(hypType, euAccess, region)
 	}
 	if err != nil {
 		msg := fmt.Sprintf("HAP lookup for secret binding to provision cluster for global account ID %s on Hyperscaler %s has failed", operation.ProvisioningParameters.ErsContext.GlobalAccountID, hypType)
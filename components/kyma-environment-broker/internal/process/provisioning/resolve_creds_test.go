@@ -119,11 +119,12 @@ func TestResolveCredentialsStepHappyPathTrialDefaultProvider_Run(t *testing.T) {
 	memoryStorage := storage.NewMemoryStorage()
 
 	operation := fixOperationRuntimeStatus(broker.TrialPlanID, internal.Azure)
+	operation.InputCreator.(*fixture.SimpleInputCreator).ClusterRegion = "eastus"
 	err := memoryStorage.Operations().InsertOperation(operation)
 	assert.NoError(t, err)
 
 	accountProviderMock := &hyperscalerMocks.AccountProvider{}
-	accountProviderMock.On("GardenerSharedSecretName", hyperscaler.Azure, false).Return("gardener-secret-azure", nil)
+	accountProviderMock.On("GardenerSharedSecretName", hyperscaler.Azure, false, "eastus").Return("gardener-secret-azure", nil)
 
 	step := NewResolveCredentialsStep(memoryStorage.Operations(), accountProviderMock)
 
@@ -151,7 +152,7 @@ func TestResolveCredentialsStepHappyPathTrialGivenProvider_Run(t *testing.T) {
 	assert.NoError(t, err)
 
 	accountProviderMock := &hyperscalerMocks.AccountProvider{}
-	accountProviderMock.On("GardenerSharedSecretName", hyperscaler.GCP, false).Return("gardener-secret-gcp", nil)
+	accountProviderMock.On("GardenerSharedSecretName", hyperscaler.GCP, false, "").Return("gardener-secret-gcp", nil)
 
 	step := NewResolveCredentialsStep(memoryStorage.Operations(), accountProviderMock)
 
@@ -210,7 +211,7 @@ func TestResolveCredentials_IntegrationAWS(t *testing.T) {
 	gc := gardener.NewDynamicFakeClient(
 		fixSecretBinding("s1aws", "aws"),
 		fixSecretBinding("s1azure", "azure"))
-	accountProvider := hyperscaler.NewAccountProvider(hyperscaler.NewAccountPool(gc, namespace), hyperscaler.NewSharedGardenerAccountPool(gc, namespace))
+	accountProvider := hyperscaler.NewAccountProvider(hyperscaler.NewAccountPool(gc, namespace), hyperscaler.NewSharedGardenerAccountPool(gc, namespace, hyperscaler.SharedPoolConfig{}))
 
 	op := fixOperationWithPlatformRegion("cf-us10", internal.AWS)
 	memoryStorage.Operations().InsertOperation(op)
@@ -234,7 +235,7 @@ func TestResolveCredentials_IntegrationAWSEuAccess(t *testing.T) {
 		fixSecretBinding("azure", "azure"),
 		fixEuAccessSecretBinding("awseu", "aws"),
 		fixEuAccessSecretBinding("azureeu", "azure"))
-	accountProvider := hyperscaler.NewAccountProvider(hyperscaler.NewAccountPool(gc, namespace), hyperscaler.NewSharedGardenerAccountPool(gc, namespace))
+	accountProvider := hyperscaler.NewAccountProvider(hyperscaler.NewAccountPool(gc, namespace), hyperscaler.NewSharedGardenerAccountPool(gc, namespace, hyperscaler.SharedPoolConfig{}))
 
 	op := fixOperationWithPlatformRegion("cf-eu11", internal.AWS)
 	memoryStorage.Operations().InsertOperation(op)
@@ -256,7 +257,7 @@ func TestResolveCredentials_IntegrationAzure(t *testing.T) {
 	gc := gardener.NewDynamicFakeClient(
 		fixSecretBinding("s1aws", "aws"),
 		fixSecretBinding("s1azure", "azure"))
-	accountProvider := hyperscaler.NewAccountProvider(hyperscaler.NewAccountPool(gc, namespace), hyperscaler.NewSharedGardenerAccountPool(gc, namespace))
+	accountProvider := hyperscaler.NewAccountProvider(hyperscaler.NewAccountPool(gc, namespace), hyperscaler.NewSharedGardenerAccountPool(gc, namespace, hyperscaler.SharedPoolConfig{}))
 
 	op := fixOperationWithPlatformRegion("cf-eu21", internal.Azure)
 	memoryStorage.Operations().InsertOperation(op)
@@ -280,7 +281,7 @@ func TestResolveCredentials_IntegrationAzureEuAccess(t *testing.T) {
 		fixSecretBinding("azure", "azure"),
 		fixEuAccessSecretBinding("awseu", "aws"),
 		fixEuAccessSecretBinding("azureeu", "azure"))
-	accountProvider := hyperscaler.NewAccountProvider(hyperscaler.NewAccountPool(gc, namespace), hyperscaler.NewSharedGardenerAccountPool(gc, namespace))
+	accountProvider := hyperscaler.NewAccountProvider(hyperscaler.NewAccountPool(gc, namespace), hyperscaler.NewSharedGardenerAccountPool(gc, namespace, hyperscaler.SharedPoolConfig{}))
 
 	op := fixOperationWithPlatformRegion("cf-ch20", internal.Azure)
 	memoryStorage.Operations().InsertOperation(op)
@@ -114,6 +114,12 @@ func (s *UpgradeShootStep) createUpgradeShootInput(operation internal.Operation)
 		Administrators: fullInput.Administrators,
 	}
 	result.GardenerConfig.ShootNetworkingFilterDisabled = operation.ProvisioningParameters.ErsContext.DisableEnterprisePolicyFilter()
+	if operation.UpdatingParameters.AdditionalWorkerNodePools != nil {
+		result.GardenerConfig.WorkerPools = fullInput.GardenerConfig.WorkerPools
+	}
+	if operation.UpdatingParameters.MaintenanceWindow != nil {
+		result.GardenerConfig.MaintenanceWindow = fullInput.GardenerConfig.MaintenanceWindow
+	}
 
 	return result, nil
 }
@@ -76,6 +76,37 @@ func TestUpgradeShootStep_Run(t *testing.T) {
 	assert.NotEmpty(t, newOperation.ProvisionerOperationID)
 }
 
+func TestUpgradeShootStep_RunWithMaintenanceWindow(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	os := memoryStorage.Operations()
+	rs := memoryStorage.RuntimeStates()
+	cli := provisioner.NewFakeClient()
+	step := NewUpgradeShootStep(os, rs, cli)
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id")
+	operation.RuntimeID = "runtime-id"
+	operation.ProvisionerOperationID = ""
+	window := &internal.MaintenanceWindowDTO{Days: []string{"Sat"}, Begin: "22:00", End: "23:00", TimeZone: "UTC"}
+	operation.UpdatingParameters.MaintenanceWindow = window
+	operation.ProvisioningParameters.Parameters.MaintenanceWindow = window
+	operation.InputCreator = fixInputCreator(t)
+	os.InsertOperation(operation.Operation)
+	runtimeState := fixture.FixRuntimeState("runtime-id", "runtime-id", "provisioning-op-1")
+	runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{ClientID: "clientID", IssuerURL: "https://issuer.url"}
+	rs.Insert(runtimeState)
+
+	// when
+	_, _, err := step.Run(operation.Operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	req, _ := cli.LastShootUpgrade("runtime-id")
+	assert.Equal(t, &gqlschema.MaintenanceWindowInput{
+		Begin: "220000+0000",
+		End:   "230000+0000",
+	}, req.GardenerConfig.MaintenanceWindow)
+}
+
 func fixInputCreator(t *testing.T) internal.ProvisionerInputCreator {
 	optComponentsSvc := &inputAutomock.OptionalComponentService{}
 
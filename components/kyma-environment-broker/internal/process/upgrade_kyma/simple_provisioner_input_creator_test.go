@@ -102,6 +102,10 @@ func (c *simpleInputCreator) Provider() internal.CloudProvider {
 	return internal.GCP
 }
 
+func (c *simpleInputCreator) Region() string {
+	return ""
+}
+
 func (c *simpleInputCreator) AppendGlobalOverrides(overrides []*gqlschema.ConfigEntryInput) internal.ProvisionerInputCreator {
 	return c
 }
@@ -49,6 +49,7 @@ func (p *AWSInput) Defaults() *gqlschema.ClusterConfigInput {
 	if p.ControlPlaneFailureTolerance != "" {
 		controlPlaneFailureTolerance = &p.ControlPlaneFailureTolerance
 	}
+	autoScalerMin, autoScalerMax := broker.AutoScalerDefaults(broker.AWSPlanName)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("gp2"),
@@ -57,8 +58,8 @@ func (p *AWSInput) Defaults() *gqlschema.ClusterConfigInput {
 			Region:         DefaultAWSRegion,
 			Provider:       "aws",
 			WorkerCidr:     "10.250.0.0/16",
-			AutoScalerMin:  3,
-			AutoScalerMax:  20,
+			AutoScalerMin:  autoScalerMin,
+			AutoScalerMax:  autoScalerMax,
 			MaxSurge:       1,
 			MaxUnavailable: 0,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
@@ -169,6 +170,13 @@ func (p *AWSInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp inter
 	case internal.IsEuAccess(pp.PlatformRegion):
 		updateRegionWithZones(input, DefaultEuAccessAWSRegion)
 	}
+
+	if pp.Parameters.IsHighAvailability() {
+		applyHighAvailability(input)
+		if len(pp.Parameters.Zones) == 0 {
+			input.GardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZones = generateMultipleAWSZones(MultipleZonesForAWSRegion(input.GardenerConfig.Region, HighAvailabilityZonesCount))
+		}
+	}
 }
 
 func (p *AWSInput) Profile() gqlschema.KymaProfile {
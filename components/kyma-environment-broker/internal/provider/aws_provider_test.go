@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAWSZones(t *testing.T) {
@@ -265,3 +266,85 @@ func TestAWSTrialInput_ApplyParameters(t *testing.T) {
 		assert.Contains(t, DefaultEuAccessAWSRegion, input.GardenerConfig.Region)
 	})
 }
+
+func TestAWSInput_HighAvailability_ApplyParameters(t *testing.T) {
+	// given
+	svc := AWSInput{}
+
+	// when
+	t.Run("spread the workers across three zones of the region", func(t *testing.T) {
+		// given
+		input := svc.Defaults()
+		input.GardenerConfig.Region = "ap-northeast-1"
+
+		// when
+		svc.ApplyParameters(input, internal.ProvisioningParameters{
+			Parameters: internal.ProvisioningParametersDTO{
+				Region:           ptr.String("ap-northeast-1"),
+				HighAvailability: ptr.Bool(true),
+			},
+		})
+
+		//then
+		assert.Len(t, WorkerZones(input), HighAvailabilityZonesCount)
+		for _, zone := range input.GardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZones {
+			assert.Equal(t, "ap-northeast-1", zone.Name[:len(zone.Name)-1])
+		}
+		assert.Equal(t, ControlPlaneFailureToleranceZone, *input.GardenerConfig.ControlPlaneFailureTolerance)
+	})
+
+	// when
+	t.Run("keep the zones list input parameter", func(t *testing.T) {
+		// given
+		input := svc.Defaults()
+		zones := []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}
+
+		// when
+		svc.ApplyParameters(input, internal.ProvisioningParameters{
+			Parameters: internal.ProvisioningParametersDTO{
+				Zones:            zones,
+				HighAvailability: ptr.Bool(true),
+			},
+		})
+
+		//then
+		assert.Equal(t, zones, WorkerZones(input))
+		assert.Equal(t, ControlPlaneFailureToleranceZone, *input.GardenerConfig.ControlPlaneFailureTolerance)
+	})
+
+	// when
+	t.Run("do not change the control plane without high availability", func(t *testing.T) {
+		// given
+		input := svc.Defaults()
+
+		// when
+		svc.ApplyParameters(input, internal.ProvisioningParameters{
+			Parameters: internal.ProvisioningParametersDTO{
+				HighAvailability: ptr.Bool(false),
+			},
+		})
+
+		//then
+		assert.Len(t, WorkerZones(input), 1)
+		assert.Nil(t, input.GardenerConfig.ControlPlaneFailureTolerance)
+	})
+}
+
+func TestAWSInput_Defaults(t *testing.T) {
+	t.Run("should use the default autoscaler values of the plan catalog", func(t *testing.T) {
+		// given
+		defaultCatalog := broker.ActiveCatalog()
+		t.Cleanup(func() { broker.SetActiveCatalog(defaultCatalog) })
+		catalog, err := broker.ParseCatalog([]byte("services:\n  kymaruntime: {}\nplans:\n  aws:\n    autoScalerMin: 4\n    autoScalerMax: 30\n"))
+		require.NoError(t, err)
+		broker.SetActiveCatalog(catalog)
+		svc := AWSInput{}
+
+		// when
+		input := svc.Defaults()
+
+		// then
+		assert.Equal(t, 4, input.GardenerConfig.AutoScalerMin)
+		assert.Equal(t, 30, input.GardenerConfig.AutoScalerMax)
+	})
+}
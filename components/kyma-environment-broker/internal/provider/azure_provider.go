@@ -50,6 +50,7 @@ func (p *AzureInput) Defaults() *gqlschema.ClusterConfigInput {
 	if p.ControlPlaneFailureTolerance != "" {
 		controlPlaneFailureTolerance = &p.ControlPlaneFailureTolerance
 	}
+	autoScalerMin, autoScalerMax := broker.AutoScalerDefaults(broker.AzurePlanName)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("Standard_LRS"),
@@ -58,14 +59,14 @@ func (p *AzureInput) Defaults() *gqlschema.ClusterConfigInput {
 			Region:         DefaultAzureRegion,
 			Provider:       "azure",
 			WorkerCidr:     "10.250.0.0/16",
-			AutoScalerMin:  3,
-			AutoScalerMax:  20,
+			AutoScalerMin:  autoScalerMin,
+			AutoScalerMax:  autoScalerMax,
 			MaxSurge:       1,
 			MaxUnavailable: 0,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AzureConfig: &gqlschema.AzureProviderConfigInput{
 					VnetCidr:         "10.250.0.0/16",
-					AzureZones:       generateMultipleAzureZones(generateRandomAzureZones(zonesCount)),
+					AzureZones:       generateMultipleAzureZones(zonesForAzureRegion(DefaultAzureRegion, zonesCount)),
 					EnableNatGateway: ptr.Bool(true),
 				},
 			},
@@ -75,6 +76,12 @@ func (p *AzureInput) Defaults() *gqlschema.ClusterConfigInput {
 }
 
 func (p *AzureInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp internal.ProvisioningParameters) {
+	// the explicit zones list below takes precedence over the zones of the region
+	if pp.Parameters.IsHighAvailability() {
+		applyHighAvailability(input)
+		input.GardenerConfig.ProviderSpecificConfig.AzureConfig.AzureZones = generateMultipleAzureZones(zonesForAzureRegion(input.GardenerConfig.Region, HighAvailabilityZonesCount))
+	}
+
 	if internal.IsEuAccess(pp.PlatformRegion) {
 		updateString(&input.GardenerConfig.Region, ptr.String(DefaultEuAccessAzureRegion))
 		return
@@ -103,6 +110,7 @@ func (p *AzureInput) Provider() internal.CloudProvider {
 }
 
 func (p *AzureLiteInput) Defaults() *gqlschema.ClusterConfigInput {
+	autoScalerMin, autoScalerMax := broker.AutoScalerDefaults(broker.AzureLitePlanName)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("Standard_LRS"),
@@ -111,8 +119,8 @@ func (p *AzureLiteInput) Defaults() *gqlschema.ClusterConfigInput {
 			Region:         DefaultAzureRegion,
 			Provider:       "azure",
 			WorkerCidr:     "10.250.0.0/19",
-			AutoScalerMin:  2,
-			AutoScalerMax:  10,
+			AutoScalerMin:  autoScalerMin,
+			AutoScalerMax:  autoScalerMax,
 			MaxSurge:       1,
 			MaxUnavailable: 0,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
@@ -120,7 +128,7 @@ func (p *AzureLiteInput) Defaults() *gqlschema.ClusterConfigInput {
 					VnetCidr: "10.250.0.0/19",
 					AzureZones: []*gqlschema.AzureZoneInput{
 						{
-							Name: generateRandomAzureZone(),
+							Name: zonesForAzureRegion(DefaultAzureRegion, 1)[0],
 							Cidr: "10.250.0.0/19",
 						},
 					},
@@ -168,7 +176,7 @@ func azureTrialDefaults() *gqlschema.ClusterConfigInput {
 					VnetCidr: "10.250.0.0/19",
 					AzureZones: []*gqlschema.AzureZoneInput{
 						{
-							Name: generateRandomAzureZone(),
+							Name: zonesForAzureRegion(DefaultAzureRegion, 1)[0],
 							Cidr: "10.250.0.0/19",
 						},
 					},
@@ -225,27 +233,34 @@ func (p *AzureFreemiumInput) Provider() internal.CloudProvider {
 	return internal.Azure
 }
 
-func generateRandomAzureZone() int {
-	const (
-		min = 1
-		max = 3
-	)
-
-	// generates random number from 1-3 range
-	getRandomNumber := func() int {
-		return rand.Intn(max-min+1) + min
-	}
-
-	return getRandomNumber()
+// azureZones defines the availability zones of given Azure regions
+// The table is tested in a unit test to check if all necessary regions are covered
+var azureZones = map[string]string{
+	"eastus":           "123",
+	"centralus":        "123",
+	"westus2":          "123",
+	"uksouth":          "123",
+	"northeurope":      "123",
+	"westeurope":       "123",
+	"japaneast":        "123",
+	"southeastasia":    "123",
+	"switzerlandnorth": "123",
 }
 
-func generateRandomAzureZones(zonesCount int) []int {
-	zones := []int{1, 2, 3}
-	if zonesCount > 3 {
-		zonesCount = 3
+func zonesForAzureRegion(region string, zonesCount int) []int {
+	codes, found := azureZones[region]
+	if !found {
+		codes = "1"
 	}
 
+	var zones []int
+	for _, code := range codes {
+		zones = append(zones, int(code-'0'))
+	}
 	rand.Shuffle(len(zones), func(i, j int) { zones[i], zones[j] = zones[j], zones[i] })
+	if zonesCount > len(zones) {
+		zonesCount = len(zones)
+	}
 	return zones[:zonesCount]
 }
 
@@ -5,10 +5,47 @@ import (
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func TestAzureZones(t *testing.T) {
+	for _, euAccess := range []bool{false, true} {
+		for _, region := range broker.AzureRegions(euAccess) {
+			_, exists := azureZones[region]
+			assert.True(t, exists)
+		}
+	}
+	for _, region := range toAzureSpecific {
+		_, exists := azureZones[*region]
+		assert.True(t, exists)
+	}
+	_, exists := azureZones[DefaultAzureRegion]
+	assert.True(t, exists)
+	_, exists = azureZones[DefaultEuAccessAzureRegion]
+	assert.True(t, exists)
+}
+
+func TestZonesForAzureRegion(t *testing.T) {
+	t.Run("should use the zones of the region", func(t *testing.T) {
+		// when
+		zones := zonesForAzureRegion("westeurope", 4)
+
+		// then
+		assert.ElementsMatch(t, []int{1, 2, 3}, zones)
+	})
+
+	t.Run("should use a single zone for an unknown region", func(t *testing.T) {
+		// when
+		zones := zonesForAzureRegion("westus", 3)
+
+		// then
+		assert.Equal(t, []int{1}, zones)
+	})
+}
+
 func TestAzureTrialInput_ApplyParametersWithRegion(t *testing.T) { //TODO apply EU Access for trials
 	// given
 	svc := AzureTrialInput{
@@ -249,3 +286,30 @@ func azureZoneNames(zones []*gqlschema.AzureZoneInput) []int {
 
 	return zoneNames
 }
+
+func TestAzureInputs_Defaults(t *testing.T) {
+	// given
+	defaultCatalog := broker.ActiveCatalog()
+	t.Cleanup(func() { broker.SetActiveCatalog(defaultCatalog) })
+	catalog, err := broker.ParseCatalog([]byte("services:\n  kymaruntime: {}\nplans:\n  azure:\n    autoScalerMax: 30\n  azure_lite:\n    autoScalerMin: 3\n"))
+	require.NoError(t, err)
+	broker.SetActiveCatalog(catalog)
+
+	t.Run("should use the default autoscaler values of the azure plan catalog", func(t *testing.T) {
+		// when
+		input := (&AzureInput{}).Defaults()
+
+		// then
+		assert.Equal(t, 3, input.GardenerConfig.AutoScalerMin)
+		assert.Equal(t, 30, input.GardenerConfig.AutoScalerMax)
+	})
+
+	t.Run("should use the default autoscaler values of the azure_lite plan catalog", func(t *testing.T) {
+		// when
+		input := (&AzureLiteInput{}).Defaults()
+
+		// then
+		assert.Equal(t, 3, input.GardenerConfig.AutoScalerMin)
+		assert.Equal(t, 10, input.GardenerConfig.AutoScalerMax)
+	})
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 
@@ -47,6 +48,7 @@ func (p *GcpInput) Defaults() *gqlschema.ClusterConfigInput {
 	if p.ControlPlaneFailureTolerance != "" {
 		controlPlaneFailureTolerance = &p.ControlPlaneFailureTolerance
 	}
+	autoScalerMin, autoScalerMax := broker.AutoScalerDefaults(broker.GCPPlanName)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("pd-standard"),
@@ -55,8 +57,8 @@ func (p *GcpInput) Defaults() *gqlschema.ClusterConfigInput {
 			Region:         DefaultGCPRegion,
 			Provider:       "gcp",
 			WorkerCidr:     "10.250.0.0/19",
-			AutoScalerMin:  3,
-			AutoScalerMax:  20,
+			AutoScalerMin:  autoScalerMin,
+			AutoScalerMax:  autoScalerMax,
 			MaxSurge:       1,
 			MaxUnavailable: 0,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
@@ -82,6 +84,13 @@ func (p *GcpInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp inter
 		}
 		updateSlice(&input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones, ZonesForGCPRegion(*pp.Parameters.Region, zonesCount))
 	}
+
+	if pp.Parameters.IsHighAvailability() {
+		applyHighAvailability(input)
+		if len(pp.Parameters.Zones) == 0 {
+			updateSlice(&input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones, ZonesForGCPRegion(input.GardenerConfig.Region, HighAvailabilityZonesCount))
+		}
+	}
 }
 
 func (p *GcpInput) Profile() gqlschema.KymaProfile {
@@ -146,8 +155,20 @@ func (p *GcpTrialInput) Provider() internal.CloudProvider {
 	return internal.GCP
 }
 
+// gcpZones defines a possible suffixes for given GCP regions
+// The table is tested in a unit test to check if all necessary regions are covered
+var gcpZones = map[string]string{
+	"europe-west3": "abc",
+	"asia-south1":  "abc",
+	"us-central1":  "abcf",
+}
+
 func ZonesForGCPRegion(region string, zonesCount int) []string {
-	zoneCodes := []string{"a", "b", "c"}
+	codes, found := gcpZones[region]
+	if !found {
+		codes = "a"
+	}
+	zoneCodes := strings.Split(codes, "")
 	var zones []string
 	rand.Shuffle(len(zoneCodes), func(i, j int) { zoneCodes[i], zoneCodes[j] = zoneCodes[j], zoneCodes[i] })
 
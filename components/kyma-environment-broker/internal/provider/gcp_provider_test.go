@@ -4,10 +4,43 @@ import (
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestGCPZones(t *testing.T) {
+	regions := broker.GCPRegions()
+	for _, region := range regions {
+		_, exists := gcpZones[region]
+		assert.True(t, exists)
+	}
+	for _, region := range toGCPSpecific {
+		_, exists := gcpZones[*region]
+		assert.True(t, exists)
+	}
+	_, exists := gcpZones[DefaultGCPRegion]
+	assert.True(t, exists)
+}
+
+func TestZonesForGCPRegion(t *testing.T) {
+	t.Run("should use the zones of the region", func(t *testing.T) {
+		// when
+		zones := ZonesForGCPRegion("us-central1", 4)
+
+		// then
+		assert.ElementsMatch(t, []string{"us-central1-a", "us-central1-b", "us-central1-c", "us-central1-f"}, zones)
+	})
+
+	t.Run("should use a single zone for an unknown region", func(t *testing.T) {
+		// when
+		zones := ZonesForGCPRegion("europe-north1", 3)
+
+		// then
+		assert.Equal(t, []string{"europe-north1-a"}, zones)
+	})
+}
+
 func TestGcpTrialInput_ApplyParametersWithRegion(t *testing.T) {
 	// given
 	svc := GcpTrialInput{
@@ -109,7 +142,7 @@ func TestGcpInput_SingleZone_ApplyParameters(t *testing.T) {
 
 		// then
 		assert.Len(t, input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones, 1)
-		assert.Subset(t, []string{"us-central1-a", "us-central1-b", "us-central1-c"}, input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones)
+		assert.Subset(t, []string{"us-central1-a", "us-central1-b", "us-central1-c", "us-central1-f"}, input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones)
 	})
 }
 
@@ -151,7 +184,7 @@ func TestGcpInput_MultiZone_ApplyParameters(t *testing.T) {
 
 		// then
 		assert.Len(t, input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones, 3)
-		assert.Subset(t, []string{"us-central1-a", "us-central1-b", "us-central1-c"}, input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones)
+		assert.Subset(t, []string{"us-central1-a", "us-central1-b", "us-central1-c", "us-central1-f"}, input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones)
 		assert.Equal(t, "zone", *input.GardenerConfig.ControlPlaneFailureTolerance)
 	})
 }
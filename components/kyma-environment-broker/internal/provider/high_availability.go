@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	// ControlPlaneFailureToleranceZone makes Gardener spread the control plane across zones of the region
+	ControlPlaneFailureToleranceZone = "zone"
+	HighAvailabilityZonesCount       = 3
+)
+
+func applyHighAvailability(input *gqlschema.ClusterConfigInput) {
+	input.GardenerConfig.ControlPlaneFailureTolerance = ptr.String(ControlPlaneFailureToleranceZone)
+}
+
+// WorkerZones returns the distinct zones the workers of the cluster are spread across
+func WorkerZones(input *gqlschema.ClusterConfigInput) []string {
+	if input == nil || input.GardenerConfig == nil || input.GardenerConfig.ProviderSpecificConfig == nil {
+		return nil
+	}
+	config := input.GardenerConfig.ProviderSpecificConfig
+
+	var zones []string
+	switch {
+	case config.AwsConfig != nil:
+		for _, zone := range config.AwsConfig.AwsZones {
+			zones = append(zones, zone.Name)
+		}
+	case config.GcpConfig != nil:
+		zones = append(zones, config.GcpConfig.Zones...)
+	case config.AzureConfig != nil:
+		for _, zone := range config.AzureConfig.AzureZones {
+			zones = append(zones, strconv.Itoa(zone.Name))
+		}
+		zones = append(zones, config.AzureConfig.Zones...)
+	}
+
+	seen := map[string]struct{}{}
+	var distinct []string
+	for _, zone := range zones {
+		if _, found := seen[zone]; found {
+			continue
+		}
+		seen[zone] = struct{}{}
+		distinct = append(distinct, zone)
+	}
+	return distinct
+}
+
+// RegionZones returns the zones of the cluster region the workers can be spread across, in the format of WorkerZones
+func RegionZones(input *gqlschema.ClusterConfigInput) []string {
+	if input == nil || input.GardenerConfig == nil || input.GardenerConfig.ProviderSpecificConfig == nil {
+		return nil
+	}
+	config := input.GardenerConfig.ProviderSpecificConfig
+	region := input.GardenerConfig.Region
+
+	switch {
+	case config.AwsConfig != nil:
+		return regionZones(awsZones, region, "a", region+"%s")
+	case config.GcpConfig != nil:
+		return regionZones(gcpZones, region, "a", region+"-%s")
+	case config.AzureConfig != nil:
+		return regionZones(azureZones, region, "1", "%s")
+	}
+	return nil
+}
+
+func regionZones(table map[string]string, region, fallback, format string) []string {
+	codes, found := table[region]
+	if !found {
+		codes = fallback
+	}
+
+	var zones []string
+	for _, code := range strings.Split(codes, "") {
+		zones = append(zones, fmt.Sprintf(format, code))
+	}
+	return zones
+}
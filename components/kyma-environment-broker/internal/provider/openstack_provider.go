@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
@@ -20,6 +21,7 @@ type OpenStackInput struct {
 }
 
 func (p *OpenStackInput) Defaults() *gqlschema.ClusterConfigInput {
+	autoScalerMin, autoScalerMax := broker.AutoScalerDefaults(broker.OpenStackPlanName)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:          nil,
@@ -27,8 +29,8 @@ func (p *OpenStackInput) Defaults() *gqlschema.ClusterConfigInput {
 			Region:            DefaultOpenStackRegion,
 			Provider:          "openstack",
 			WorkerCidr:        "10.250.0.0/19",
-			AutoScalerMin:     4,
-			AutoScalerMax:     8,
+			AutoScalerMin:     autoScalerMin,
+			AutoScalerMax:     autoScalerMax,
 			MaxSurge:          1,
 			MaxUnavailable:    0,
 			ExposureClassName: ptr.String(DefaultExposureClass),
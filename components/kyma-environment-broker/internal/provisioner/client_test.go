@@ -506,6 +506,10 @@ func (tr testResolver) Query() schema.QueryResolver {
 	return &testQueryResolver{t: tr.t, runtime: tr.runtime, failed: tr.failed}
 }
 
+func (tr testResolver) Subscription() schema.SubscriptionResolver {
+	return &testSubscriptionResolver{}
+}
+
 func (tr testResolver) getRuntime() *testRuntime {
 	return tr.runtime
 }
@@ -573,6 +577,10 @@ func (tmr testMutationResolver) HibernateRuntime(ctx context.Context, id string)
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (tmr testMutationResolver) WakeUpRuntime(ctx context.Context, id string) (*schema.OperationStatus, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (tmr testMutationResolver) RollBackUpgradeOperation(_ context.Context, id string) (*schema.RuntimeStatus, error) {
 	return nil, nil
 }
@@ -640,6 +648,28 @@ func (tqr testQueryResolver) RuntimeOperationStatus(_ context.Context, id string
 	return nil, nil
 }
 
+func (tqr testQueryResolver) Runtimes(_ context.Context, filter *schema.RuntimesFilter, first *int, after *string) (*schema.RuntimesPage, error) {
+	return nil, nil
+}
+
+func (tqr testQueryResolver) Operations(_ context.Context, filter *schema.OperationsFilter, first *int, after *string) (*schema.OperationsPage, error) {
+	return nil, nil
+}
+
+func (tqr testQueryResolver) RuntimeDrift(_ context.Context, id string) (*schema.RuntimeDrift, error) {
+	return nil, nil
+}
+
+type testSubscriptionResolver struct{}
+
+func (tsr testSubscriptionResolver) OperationStatusChanged(_ context.Context, id string) (<-chan *schema.OperationStatus, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (tsr testSubscriptionResolver) RuntimeStatusChanged(_ context.Context, id string) (<-chan *schema.RuntimeStatus, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func fixProvisionRuntimeInput() schema.ProvisionRuntimeInput {
 	disabled := false
 	return schema.ProvisionRuntimeInput{
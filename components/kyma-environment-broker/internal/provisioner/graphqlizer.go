@@ -137,9 +137,69 @@ func (g *Graphqlizer) GardenerConfigInputToGraphQL(in gqlschema.GardenerConfigIn
 		{{- if .EuAccess }}
 		euAccess: {{ .EuAccess }},
 		{{- end }}
+		{{- with .WorkerPools }}
+		workerPools: [
+			{{- range . }}
+			{{ WorkerPoolInputToGraphQL . }},
+			{{- end }}
+		],
+		{{- end }}
+		{{- with .Networking }}
+		networking: {
+			{{- if .Pods }}
+			pods: "{{ .Pods }}",
+			{{- end }}
+			{{- if .Services }}
+			services: "{{ .Services }}",
+			{{- end }}
+			{{- if .Nodes }}
+			nodes: "{{ .Nodes }}",
+			{{- end }}
+		},
+		{{- end }}
+		{{- with .MaintenanceWindow }}
+		maintenanceWindow: {
+			begin: "{{ .Begin }}",
+			end: "{{ .End }}",
+		},
+		{{- end }}
 	}`)
 }
 
+func (g *Graphqlizer) WorkerPoolInputToGraphQL(in gqlschema.WorkerPoolInput) (string, error) {
+	return g.genericToGraphQL(in, `{
+				name: "{{ .Name }}",
+				machineType: "{{ .MachineType }}",
+				autoScalerMin: {{ .AutoScalerMin }},
+				autoScalerMax: {{ .AutoScalerMax }},
+				{{- if .Zones }}
+				zones: {{ .Zones | marshal }},
+				{{- end }}
+				{{- if .DiskType }}
+				diskType: "{{ .DiskType }}",
+				{{- end }}
+				{{- if .VolumeSizeGb }}
+				volumeSizeGB: {{ .VolumeSizeGb }},
+				{{- end }}
+				{{- if .Labels }}
+				labels: {{ LabelsToGQL .Labels }},
+				{{- end }}
+				{{- with .Taints }}
+				taints: [
+					{{- range . }}
+					{
+						key: "{{ .Key }}",
+						{{- if .Value }}
+						value: "{{ .Value }}",
+						{{- end }}
+						effect: {{ .Effect }},
+					}
+					{{- end }}
+				],
+				{{- end }}
+			}`)
+}
+
 func (g *Graphqlizer) DNSConfigInputToGraphQL(in gqlschema.DNSConfigInput) (string, error) {
 	return g.genericToGraphQL(in, `{
 			domain: "{{ .Domain }}",
@@ -310,6 +370,19 @@ func (g *Graphqlizer) GardenerUpgradeInputToGraphQL(in gqlschema.GardenerUpgrade
 			usernamePrefix: "{{ .OidcConfig.UsernamePrefix }}",
 		},
 		{{- end }}
+		{{- if isSet .WorkerPools }}
+		workerPools: [
+			{{- range .WorkerPools }}
+			{{ WorkerPoolInputToGraphQL . }},
+			{{- end }}
+		],
+		{{- end }}
+		{{- with .MaintenanceWindow }}
+		maintenanceWindow: {
+			begin: "{{ .Begin }}",
+			end: "{{ .End }}",
+		},
+		{{- end }}
 	}`)
 }
 
@@ -360,6 +433,18 @@ func (g Graphqlizer) UpgradeShootInputToGraphQL(in gqlschema.UpgradeShootInput)
 }`)
 }
 
+// isSet returns false for nil pointers, maps and slices, so that an empty list can be distinguished from a missing one
+func isSet(obj interface{}) bool {
+	val := reflect.ValueOf(obj)
+	switch val.Kind() {
+	case reflect.Invalid:
+		return false
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		return !val.IsNil()
+	}
+	return true
+}
+
 func (g *Graphqlizer) genericToGraphQL(obj interface{}, tmpl string) (string, error) {
 	fm := sprig.TxtFuncMap()
 	fm["marshal"] = g.marshal
@@ -374,6 +459,8 @@ func (g *Graphqlizer) genericToGraphQL(obj interface{}, tmpl string) (string, er
 	fm["OpenStackProviderConfigInputToGraphQL"] = g.OpenStackProviderConfigInputToGraphQL
 	fm["DNSConfigInputToGraphQL"] = g.DNSConfigInputToGraphQL
 	fm["LabelsToGQL"] = g.LabelsToGQL
+	fm["WorkerPoolInputToGraphQL"] = g.WorkerPoolInputToGraphQL
+	fm["isSet"] = isSet
 	fm["strQuote"] = strconv.Quote
 
 	t, err := template.New("tmpl").Funcs(fm).Parse(tmpl)
@@ -184,6 +184,90 @@ func Test_GardenerConfigInputToGraphQL(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func Test_GardenerConfigInputToGraphQLWithNetworking(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+		kubernetesVersion: "1.25",
+		machineType: "m5.xlarge",
+		region: "eu-central-1",
+		provider: "AWS",
+		targetSecret: "scr",
+		workerCidr: "10.250.0.0/22",
+		autoScalerMin: 3,
+		autoScalerMax: 20,
+		maxSurge: 1,
+		maxUnavailable: 0,
+		networking: {
+			pods: "10.64.0.0/12",
+			nodes: "10.250.0.0/16",
+		},
+	}`
+
+	// when
+	got, err := sut.GardenerConfigInputToGraphQL(gqlschema.GardenerConfigInput{
+		KubernetesVersion: "1.25",
+		MachineType:       "m5.xlarge",
+		Region:            "eu-central-1",
+		Provider:          "AWS",
+		TargetSecret:      "scr",
+		WorkerCidr:        "10.250.0.0/22",
+		AutoScalerMin:     3,
+		AutoScalerMax:     20,
+		MaxSurge:          1,
+		Networking: &gqlschema.NetworkingInput{
+			Pods:  ptr.String("10.64.0.0/12"),
+			Nodes: ptr.String("10.250.0.0/16"),
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
+func Test_GardenerConfigInputToGraphQLWithMaintenanceWindow(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+		kubernetesVersion: "1.25",
+		machineType: "m5.xlarge",
+		region: "eu-central-1",
+		provider: "AWS",
+		targetSecret: "scr",
+		workerCidr: "10.250.0.0/22",
+		autoScalerMin: 3,
+		autoScalerMax: 20,
+		maxSurge: 1,
+		maxUnavailable: 0,
+		maintenanceWindow: {
+			begin: "220000+0100",
+			end: "230000+0100",
+		},
+	}`
+
+	// when
+	got, err := sut.GardenerConfigInputToGraphQL(gqlschema.GardenerConfigInput{
+		KubernetesVersion: "1.25",
+		MachineType:       "m5.xlarge",
+		Region:            "eu-central-1",
+		Provider:          "AWS",
+		TargetSecret:      "scr",
+		WorkerCidr:        "10.250.0.0/22",
+		AutoScalerMin:     3,
+		AutoScalerMax:     20,
+		MaxSurge:          1,
+		MaintenanceWindow: &gqlschema.MaintenanceWindowInput{
+			Begin: "220000+0100",
+			End:   "230000+0100",
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
 func Test_GardenerConfigInputToGraphQLWithOIDC(t *testing.T) {
 	// given
 	sut := Graphqlizer{}
@@ -612,6 +696,95 @@ func Test_UpgradeShootInputToGraphQL(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func Test_UpgradeShootInputToGraphQLWithWorkerPools(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+	gardenerConfig: {
+		workerPools: [
+			{
+				name: "workers",
+				machineType: "m5.2xlarge",
+				autoScalerMin: 1,
+				autoScalerMax: 5,
+				zones: ["eu-central-1a"],
+				labels: {pool:"workers",},
+				taints: [
+					{
+						key: "dedicated",
+						value: "workers",
+						effect: NoSchedule,
+					}
+				],
+			},
+		],
+	},
+}`
+
+	// when
+	got, err := sut.UpgradeShootInputToGraphQL(gqlschema.UpgradeShootInput{
+		GardenerConfig: &gqlschema.GardenerUpgradeInput{
+			WorkerPools: []*gqlschema.WorkerPoolInput{
+				{
+					Name:          "workers",
+					MachineType:   "m5.2xlarge",
+					AutoScalerMin: 1,
+					AutoScalerMax: 5,
+					Zones:         []string{"eu-central-1a"},
+					Labels:        gqlschema.Labels{"pool": "workers"},
+					Taints: []*gqlschema.TaintInput{
+						{Key: "dedicated", Value: ptr.String("workers"), Effect: gqlschema.TaintEffectNoSchedule},
+					},
+				},
+			},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+
+	t.Run("should send an empty list to remove all worker pools", func(t *testing.T) {
+		// when
+		got, err := sut.UpgradeShootInputToGraphQL(gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				WorkerPools: []*gqlschema.WorkerPoolInput{},
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Contains(t, got, "workerPools: [\n\t\t],")
+	})
+}
+
+func Test_UpgradeShootInputToGraphQLWithMaintenanceWindow(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+	gardenerConfig: {
+		maintenanceWindow: {
+			begin: "220000+0100",
+			end: "230000+0100",
+		},
+	},
+}`
+
+	// when
+	got, err := sut.UpgradeShootInputToGraphQL(gqlschema.UpgradeShootInput{
+		GardenerConfig: &gqlschema.GardenerUpgradeInput{
+			MaintenanceWindow: &gqlschema.MaintenanceWindowInput{
+				Begin: "220000+0100",
+				End:   "230000+0100",
+			},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
 func TestOpenstack(t *testing.T) {
 	// given
 	input := gqlschema.ProviderSpecificInput{
@@ -78,6 +78,7 @@ func (c *converter) NewDTO(instance internal.Instance) (pkg.RuntimeDTO, error) {
 		ServicePlanName:             instance.ServicePlanName,
 		Provider:                    string(instance.Provider),
 		ProviderRegion:              instance.ProviderRegion,
+		HighAvailability:            instance.Parameters.Parameters.IsHighAvailability(),
 		UserID:                      instance.Parameters.ErsContext.UserID,
 		ShootName:                   instance.InstanceDetails.ShootName,
 		Status: pkg.RuntimeStatus{
@@ -89,6 +90,14 @@ func (c *converter) NewDTO(instance internal.Instance) (pkg.RuntimeDTO, error) {
 	if !instance.DeletedAt.IsZero() {
 		toReturn.Status.DeletedAt = &instance.DeletedAt
 	}
+	if window := instance.Parameters.Parameters.MaintenanceWindow; window != nil {
+		toReturn.MaintenanceWindow = &pkg.MaintenanceWindow{
+			Days:     window.Days,
+			Begin:    window.Begin,
+			End:      window.End,
+			TimeZone: window.TimeZone,
+		}
+	}
 
 	c.setRegionOrDefault(instance, &toReturn)
 
@@ -3,6 +3,7 @@ package runtime
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
@@ -43,6 +44,7 @@ func NewHandler(instanceDb storage.Instances, operationDb storage.Operations, ru
 
 func (h *Handler) AttachRoutes(router *mux.Router) {
 	router.HandleFunc("/runtimes", h.getRuntimes)
+	router.HandleFunc("/runtimes/changes", h.getRuntimeChanges)
 }
 
 func findLastDeprovisioning(operations []internal.Operation) internal.Operation {
@@ -125,6 +127,35 @@ func (h *Handler) listInstances(filter dbmodel.InstanceFilter) ([]internal.Insta
 	return h.instancesDb.List(filter)
 }
 
+// listDeletedInstances rebuilds the given instances, which are already removed from the instances table, from their residual operations
+func (h *Handler) listDeletedInstances(instanceIDs []string) ([]internal.Instance, int, int, error) {
+	var operations []internal.Operation
+	for _, id := range instanceIDs {
+		_, err := h.instancesDb.GetByID(id)
+		switch {
+		case err == nil:
+			continue
+		case !dberr.IsNotFound(err):
+			return nil, 0, 0, fmt.Errorf("while getting instance %s: %w", id, err)
+		}
+
+		instanceOperations, err := h.operationsDb.ListOperationsByInstanceID(id)
+		switch {
+		case dberr.IsNotFound(err):
+			continue
+		case err != nil:
+			return nil, 0, 0, fmt.Errorf("while listing operations of instance %s: %w", id, err)
+		}
+		sort.Slice(instanceOperations, func(i, j int) bool {
+			return instanceOperations[i].CreatedAt.Before(instanceOperations[j].CreatedAt)
+		})
+		operations = append(operations, instanceOperations...)
+	}
+
+	instances := recreateInstances(operations)
+	return instances, len(instances), len(instances), nil
+}
+
 func (h *Handler) getRuntimes(w http.ResponseWriter, req *http.Request) {
 	toReturn := make([]pkg.RuntimeDTO, 0)
 
@@ -140,7 +171,13 @@ func (h *Handler) getRuntimes(w http.ResponseWriter, req *http.Request) {
 	kymaConfig := getBoolParam(pkg.KymaConfigParam, req)
 	clusterConfig := getBoolParam(pkg.ClusterConfigParam, req)
 
-	instances, count, totalCount, err := h.listInstances(filter)
+	var instances []internal.Instance
+	var count, totalCount int
+	if getBoolParam(pkg.OnlyDeletedParam, req) {
+		instances, count, totalCount, err = h.listDeletedInstances(filter.InstanceIDs)
+	} else {
+		instances, count, totalCount, err = h.listInstances(filter)
+	}
 	if err != nil {
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while fetching instances: %w", err))
 		return
@@ -186,6 +223,97 @@ func (h *Handler) getRuntimes(w http.ResponseWriter, req *http.Request) {
 	httputil.WriteResponse(w, http.StatusOK, runtimePage)
 }
 
+// getRuntimeChanges returns runtimes which instances had any operation created or updated after the time given by the since parameter.
+// If the parameter is not set, only the current cursor is returned, which can be used as a starting point of the feed.
+func (h *Handler) getRuntimeChanges(w http.ResponseWriter, req *http.Request) {
+	now := time.Now().UTC()
+	changesPage := pkg.RuntimeChangesPage{
+		Data:   make([]pkg.RuntimeChange, 0),
+		Cursor: now.Format(time.RFC3339Nano),
+	}
+
+	sinceParam := req.URL.Query().Get(pkg.SinceParam)
+	if sinceParam == "" {
+		httputil.WriteResponse(w, http.StatusOK, changesPage)
+		return
+	}
+	since, err := time.Parse(time.RFC3339Nano, sinceParam)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while parsing %s parameter: %w", pkg.SinceParam, err))
+		return
+	}
+
+	operations, err := h.operationsDb.ListOperationsInTimeRange(since, now)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while fetching operations: %w", err))
+		return
+	}
+
+	for _, op := range lastOperationPerInstance(operations) {
+		change, err := h.runtimeChange(op)
+		if err != nil {
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+			return
+		}
+		changesPage.Data = append(changesPage.Data, change)
+	}
+	changesPage.Count = len(changesPage.Data)
+
+	httputil.WriteResponse(w, http.StatusOK, changesPage)
+}
+
+func (h *Handler) runtimeChange(op internal.Operation) (pkg.RuntimeChange, error) {
+	change := pkg.RuntimeChange{
+		Type:         pkg.ChangeRemoved,
+		InstanceID:   op.InstanceID,
+		RuntimeID:    op.RuntimeID,
+		SubAccountID: op.SubAccountID,
+	}
+
+	instance, err := h.instancesDb.GetByID(op.InstanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		return change, nil
+	case err != nil:
+		return change, fmt.Errorf("while fetching instance %s: %w", op.InstanceID, err)
+	}
+
+	dto, err := h.converter.NewDTO(*instance)
+	if err != nil {
+		return change, fmt.Errorf("while converting instance to DTO: %w", err)
+	}
+	if err := h.setRuntimeAllOperations(*instance, &dto); err != nil {
+		return change, err
+	}
+	if err := h.determineStatusModifiedAt(&dto); err != nil {
+		return change, err
+	}
+
+	change.Type = pkg.ChangeUpserted
+	change.RuntimeID = dto.RuntimeID
+	change.SubAccountID = dto.SubAccountID
+	change.Runtime = &dto
+	return change, nil
+}
+
+// lastOperationPerInstance returns the most recently updated operation of every instance, ordered by the update time
+func lastOperationPerInstance(operations []internal.Operation) []internal.Operation {
+	byInstance := make(map[string]internal.Operation)
+	for _, op := range operations {
+		if last, exists := byInstance[op.InstanceID]; !exists || op.UpdatedAt.After(last.UpdatedAt) {
+			byInstance[op.InstanceID] = op
+		}
+	}
+	result := make([]internal.Operation, 0, len(byInstance))
+	for _, op := range byInstance {
+		result = append(result, op)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UpdatedAt.Before(result[j].UpdatedAt)
+	})
+	return result
+}
+
 func (h *Handler) takeLastNonDryRunOperations(oprs []internal.UpgradeKymaOperation) ([]internal.UpgradeKymaOperation, int) {
 	toReturn := make([]internal.UpgradeKymaOperation, 0)
 	totalCount := 0
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -504,6 +505,54 @@ func TestRuntimeHandler(t *testing.T) {
 		assert.Equal(t, deprovisioningOpId, out.Data[0].Status.Deprovisioning.OperationID)
 	})
 
+	t.Run("should rebuild deleted instances from their operations", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+		existingInstance := fixture.FixInstance("existing-instance")
+		err := instances.Insert(existingInstance)
+		require.NoError(t, err)
+
+		err = operations.InsertOperation(fixture.FixProvisioningOperation("existing-provisioning", existingInstance.InstanceID))
+		require.NoError(t, err)
+		provisioning := fixture.FixProvisioningOperation("deleted-provisioning", "deleted-instance")
+		err = operations.InsertOperation(provisioning)
+		require.NoError(t, err)
+		deprovisioning := fixture.FixDeprovisioningOperation("deleted-deprovisioning", "deleted-instance")
+		deprovisioning.State = domain.Succeeded
+		deprovisioning.CreatedAt = provisioning.CreatedAt.Add(time.Hour)
+		deprovisioning.UpdatedAt = provisioning.CreatedAt.Add(2 * time.Hour)
+		err = operations.InsertDeprovisioningOperation(deprovisioning)
+		require.NoError(t, err)
+
+		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		// when
+		req, err := http.NewRequest("GET", fmt.Sprintf("/runtimes?%s=true&%s=deleted-instance&%s=%s&%s=unknown-instance",
+			pkg.OnlyDeletedParam, pkg.InstanceIDParam, pkg.InstanceIDParam, existingInstance.InstanceID, pkg.InstanceIDParam), nil)
+		require.NoError(t, err)
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out pkg.RuntimesPage
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		require.Len(t, out.Data, 1)
+		assert.Equal(t, 1, out.TotalCount)
+		assert.Equal(t, "deleted-instance", out.Data[0].InstanceID)
+		assert.Equal(t, provisioning.GlobalAccountID, out.Data[0].GlobalAccountID)
+		require.NotNil(t, out.Data[0].Status.DeletedAt)
+		assert.True(t, deprovisioning.UpdatedAt.Equal(*out.Data[0].Status.DeletedAt))
+	})
+
 	t.Run("test operation detail parameter and runtime state", func(t *testing.T) {
 		// given
 		operations := memory.NewOperation()
@@ -685,6 +734,129 @@ func TestRuntimeHandler(t *testing.T) {
 	})
 }
 
+func TestRuntimeChangesHandler(t *testing.T) {
+	t.Run("should return only cursor when since parameter is not set", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+
+		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		req, err := http.NewRequest("GET", "/runtimes/changes", nil)
+		require.NoError(t, err)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out pkg.RuntimeChangesPage
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, out.Count)
+		assert.Empty(t, out.Data)
+		_, err = time.Parse(time.RFC3339Nano, out.Cursor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return upserted and removed runtimes changed since the cursor", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+		since := time.Now().Add(-time.Hour)
+
+		oldID := "old"
+		err := instances.Insert(fixInstance(oldID, since.Add(-time.Hour)))
+		require.NoError(t, err)
+		oldOp := fixture.FixProvisioningOperation(fixRandomID(), oldID)
+		oldOp.CreatedAt = since.Add(-time.Hour)
+		oldOp.UpdatedAt = since.Add(-time.Minute)
+		err = operations.InsertOperation(oldOp)
+		require.NoError(t, err)
+
+		provisionedID := "provisioned"
+		err = instances.Insert(fixInstance(provisionedID, since.Add(time.Minute)))
+		require.NoError(t, err)
+		provOp := fixture.FixProvisioningOperation(fixRandomID(), provisionedID)
+		provOp.CreatedAt = since.Add(time.Minute)
+		provOp.UpdatedAt = since.Add(2 * time.Minute)
+		err = operations.InsertOperation(provOp)
+		require.NoError(t, err)
+
+		removedID := "removed"
+		deprovOp := fixture.FixDeprovisioningOperationAsOperation(fixRandomID(), removedID)
+		deprovOp.RuntimeID = "removed-runtime"
+		deprovOp.SubAccountID = "removed-subaccount"
+		deprovOp.CreatedAt = since.Add(3 * time.Minute)
+		deprovOp.UpdatedAt = since.Add(4 * time.Minute)
+		err = operations.InsertOperation(deprovOp)
+		require.NoError(t, err)
+
+		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		req, err := http.NewRequest("GET", "/runtimes/changes", nil)
+		require.NoError(t, err)
+		req.URL.RawQuery = url.Values{pkg.SinceParam: []string{since.Format(time.RFC3339Nano)}}.Encode()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out pkg.RuntimeChangesPage
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, out.Count)
+		assert.Equal(t, pkg.ChangeUpserted, out.Data[0].Type)
+		assert.Equal(t, provisionedID, out.Data[0].InstanceID)
+		require.NotNil(t, out.Data[0].Runtime)
+		assert.Equal(t, provisionedID, out.Data[0].Runtime.SubAccountID)
+		assert.NotNil(t, out.Data[0].Runtime.Status.Provisioning)
+
+		assert.Equal(t, pkg.ChangeRemoved, out.Data[1].Type)
+		assert.Equal(t, removedID, out.Data[1].InstanceID)
+		assert.Equal(t, "removed-runtime", out.Data[1].RuntimeID)
+		assert.Equal(t, "removed-subaccount", out.Data[1].SubAccountID)
+		assert.Nil(t, out.Data[1].Runtime)
+	})
+
+	t.Run("should reject invalid since parameter", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+
+		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		req, err := http.NewRequest("GET", "/runtimes/changes?since=yesterday", nil)
+		require.NoError(t, err)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
 func fixInstance(id string, t time.Time) internal.Instance {
 	return internal.Instance{
 		InstanceID:      id,
@@ -0,0 +1,52 @@
+package dbmodel
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+)
+
+type JobRunDTO struct {
+	ID          string
+	JobName     string
+	State       string
+	TriggeredBy string
+	Affected    string
+	Error       string
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+}
+
+func NewJobRunDTO(run jobs.RunDTO) JobRunDTO {
+	return JobRunDTO{
+		ID:          run.ID,
+		JobName:     run.JobName,
+		State:       string(run.State),
+		TriggeredBy: run.TriggeredBy,
+		Affected:    strings.Join(run.Affected, ","),
+		Error:       run.Error,
+		CreatedAt:   run.CreatedAt,
+		StartedAt:   run.StartedAt,
+		FinishedAt:  run.FinishedAt,
+	}
+}
+
+func (r JobRunDTO) ToJobRun() jobs.RunDTO {
+	var affected []string
+	if r.Affected != "" {
+		affected = strings.Split(r.Affected, ",")
+	}
+	return jobs.RunDTO{
+		ID:          r.ID,
+		JobName:     r.JobName,
+		State:       jobs.RunState(r.State),
+		TriggeredBy: r.TriggeredBy,
+		Affected:    affected,
+		Error:       r.Error,
+		CreatedAt:   r.CreatedAt,
+		StartedAt:   r.StartedAt,
+		FinishedAt:  r.FinishedAt,
+	}
+}
@@ -0,0 +1,49 @@
+package dbmodel
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+)
+
+type PlanVisibilityRuleDTO struct {
+	PlanName   string
+	ScopeType  string
+	ScopeValue string
+	Visible    bool
+	Features   string
+	UpdatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func NewPlanVisibilityRuleDTO(rule planvisibility.RuleDTO) PlanVisibilityRuleDTO {
+	return PlanVisibilityRuleDTO{
+		PlanName:   rule.PlanName,
+		ScopeType:  string(rule.ScopeType),
+		ScopeValue: rule.ScopeValue,
+		Visible:    rule.Visible,
+		Features:   strings.Join(rule.Features, ","),
+		UpdatedBy:  rule.UpdatedBy,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+func (r PlanVisibilityRuleDTO) ToRule() planvisibility.RuleDTO {
+	var features []string
+	if r.Features != "" {
+		features = strings.Split(r.Features, ",")
+	}
+	return planvisibility.RuleDTO{
+		PlanName:   r.PlanName,
+		ScopeType:  planvisibility.ScopeType(r.ScopeType),
+		ScopeValue: r.ScopeValue,
+		Visible:    r.Visible,
+		Features:   features,
+		UpdatedBy:  r.UpdatedBy,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+)
+
+type auditLog struct {
+	mu sync.Mutex
+
+	entries []audit.EntryDTO
+}
+
+func NewAuditLog() *auditLog {
+	return &auditLog{
+		entries: make([]audit.EntryDTO, 0),
+	}
+}
+
+func (s *auditLog) Insert(entry audit.EntryDTO) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+
+	return nil
+}
+
+func (s *auditLog) List(params audit.ListParameters) ([]audit.EntryDTO, int, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := s.filter(params)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+
+	result := make([]audit.EntryDTO, 0)
+	offset := pagination.ConvertPageAndPageSizeToOffset(params.PageSize, params.Page)
+	for i := offset; (params.PageSize < 1 || i < offset+params.PageSize) && i < len(entries); i++ {
+		result = append(result, entries[i])
+	}
+
+	return result,
+		len(result),
+		len(entries),
+		nil
+}
+
+func (s *auditLog) filter(params audit.ListParameters) []audit.EntryDTO {
+	entries := make([]audit.EntryDTO, 0, len(s.entries))
+	equal := func(a, b string) bool { return a == b }
+	for _, e := range s.entries {
+		if !matchFilter(e.InstanceID, params.InstanceIDs, equal) {
+			continue
+		}
+		if !matchFilter(e.OrchestrationID, params.OrchestrationIDs, equal) {
+			continue
+		}
+		if !matchFilter(e.Caller, params.Callers, equal) {
+			continue
+		}
+		if !matchAction(e.Action, params.Actions) || !matchOutcome(e.Outcome, params.Outcomes) {
+			continue
+		}
+		if !params.From.IsZero() && e.CreatedAt.Before(params.From) {
+			continue
+		}
+		if !params.To.IsZero() && e.CreatedAt.After(params.To) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+
+	return entries
+}
+
+func matchAction(action audit.Action, actions []audit.Action) bool {
+	if len(actions) == 0 {
+		return true
+	}
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+func matchOutcome(outcome audit.Outcome, outcomes []audit.Outcome) bool {
+	if len(outcomes) == 0 {
+		return true
+	}
+	for _, o := range outcomes {
+		if o == outcome {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type jobRuns struct {
+	mu sync.Mutex
+
+	runs map[string]jobs.RunDTO
+}
+
+func NewJobRuns() *jobRuns {
+	return &jobRuns{
+		runs: make(map[string]jobs.RunDTO),
+	}
+}
+
+func (s *jobRuns) Insert(run jobs.RunDTO) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.runs[run.ID]; exists {
+		return dberr.AlreadyExists("job run with id %s already exists", run.ID)
+	}
+	s.runs[run.ID] = run
+
+	return nil
+}
+
+func (s *jobRuns) Update(run jobs.RunDTO) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.runs[run.ID]; !exists {
+		return dberr.NotFound("job run with id %s not exist", run.ID)
+	}
+	s.runs[run.ID] = run
+
+	return nil
+}
+
+func (s *jobRuns) GetLastScheduled(jobName string) (jobs.RunDTO, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var last *jobs.RunDTO
+	for _, run := range s.runs {
+		if run.JobName != jobName || run.TriggeredBy != jobs.TriggeredBySchedule {
+			continue
+		}
+		if last == nil || run.CreatedAt.After(last.CreatedAt) {
+			r := run
+			last = &r
+		}
+	}
+	if last == nil {
+		return jobs.RunDTO{}, dberr.NotFound("scheduled run of the job %s not exist", jobName)
+	}
+	return *last, nil
+}
+
+func (s *jobRuns) List(params jobs.ListParameters) ([]jobs.RunDTO, int, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	runs := s.filter(params)
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].CreatedAt.After(runs[j].CreatedAt)
+	})
+
+	result := make([]jobs.RunDTO, 0)
+	offset := pagination.ConvertPageAndPageSizeToOffset(params.PageSize, params.Page)
+	for i := offset; (params.PageSize < 1 || i < offset+params.PageSize) && i < len(runs); i++ {
+		result = append(result, runs[i])
+	}
+
+	return result,
+		len(result),
+		len(runs),
+		nil
+}
+
+func (s *jobRuns) filter(params jobs.ListParameters) []jobs.RunDTO {
+	runs := make([]jobs.RunDTO, 0, len(s.runs))
+	equal := func(a, b string) bool { return a == b }
+	for _, run := range s.runs {
+		if !matchFilter(run.JobName, params.JobNames, equal) {
+			continue
+		}
+		if !matchRunState(run.State, params.States) {
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	return runs
+}
+
+func matchRunState(state jobs.RunState, states []jobs.RunState) bool {
+	if len(states) == 0 {
+		return true
+	}
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
@@ -187,8 +187,30 @@ func (s *operations) ListOperationsByInstanceID(instanceID string) ([]internal.O
 }
 
 func (s *operations) ListOperationsInTimeRange(from, to time.Time) ([]internal.Operation, error) {
-	panic("not implemented") //also not used in any tests
-	return nil, nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inRange := func(t time.Time) bool {
+		return !t.Before(from) && !t.After(to)
+	}
+	operations := make([]internal.Operation, 0)
+	for _, op := range s.operations {
+		if inRange(op.CreatedAt) || inRange(op.UpdatedAt) {
+			operations = append(operations, op)
+		}
+	}
+	for _, op := range s.upgradeClusterOperations {
+		if inRange(op.CreatedAt) || inRange(op.UpdatedAt) {
+			operations = append(operations, op.Operation)
+		}
+	}
+	for _, op := range s.updateOperations {
+		if inRange(op.CreatedAt) || inRange(op.UpdatedAt) {
+			operations = append(operations, op.Operation)
+		}
+	}
+
+	return operations, nil
 }
 
 func (s *operations) InsertDeprovisioningOperation(operation internal.DeprovisioningOperation) error {
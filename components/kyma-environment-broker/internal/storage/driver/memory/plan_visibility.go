@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type planVisibilityKey struct {
+	planName   string
+	scopeType  planvisibility.ScopeType
+	scopeValue string
+}
+
+type planVisibility struct {
+	mu sync.Mutex
+
+	rules map[planVisibilityKey]planvisibility.RuleDTO
+}
+
+func NewPlanVisibility() *planVisibility {
+	return &planVisibility{
+		rules: make(map[planVisibilityKey]planvisibility.RuleDTO),
+	}
+}
+
+func (s *planVisibility) Upsert(rule planvisibility.RuleDTO) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := planVisibilityKey{planName: rule.PlanName, scopeType: rule.ScopeType, scopeValue: rule.ScopeValue}
+	if existing, exists := s.rules[key]; exists {
+		rule.CreatedAt = existing.CreatedAt
+	}
+	s.rules[key] = rule
+
+	return nil
+}
+
+func (s *planVisibility) Delete(planName string, scopeType planvisibility.ScopeType, scopeValue string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := planVisibilityKey{planName: planName, scopeType: scopeType, scopeValue: scopeValue}
+	if _, exists := s.rules[key]; !exists {
+		return dberr.NotFound("plan visibility rule for plan %s and %s %s not exist", planName, scopeType, scopeValue)
+	}
+	delete(s.rules, key)
+
+	return nil
+}
+
+func (s *planVisibility) List(params planvisibility.ListParameters) ([]planvisibility.RuleDTO, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]planvisibility.RuleDTO, 0)
+	equal := func(a, b string) bool { return a == b }
+	for _, rule := range s.rules {
+		if !matchFilter(rule.PlanName, params.PlanNames, equal) {
+			continue
+		}
+		if !matchScopeType(rule.ScopeType, params.ScopeTypes) {
+			continue
+		}
+		if !matchFilter(rule.ScopeValue, params.ScopeValues, equal) {
+			continue
+		}
+		result = append(result, rule)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].PlanName != result[j].PlanName {
+			return result[i].PlanName < result[j].PlanName
+		}
+		if result[i].ScopeType != result[j].ScopeType {
+			return result[i].ScopeType < result[j].ScopeType
+		}
+		return result[i].ScopeValue < result[j].ScopeValue
+	})
+
+	return result, nil
+}
+
+func matchScopeType(scopeType planvisibility.ScopeType, scopeTypes []planvisibility.ScopeType) bool {
+	if len(scopeTypes) == 0 {
+		return true
+	}
+	for _, s := range scopeTypes {
+		if s == scopeType {
+			return true
+		}
+	}
+	return false
+}
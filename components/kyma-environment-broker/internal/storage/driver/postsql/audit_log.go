@@ -0,0 +1,51 @@
+package postsql
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type auditLog struct {
+	postsql.Factory
+}
+
+func NewAuditLog(sess postsql.Factory) *auditLog {
+	return &auditLog{
+		Factory: sess,
+	}
+}
+
+func (s *auditLog) Insert(entry audit.EntryDTO) error {
+	sess := s.NewWriteSession()
+	return wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		err := sess.InsertAuditEntry(entry)
+		if err != nil {
+			log.Errorf("while saving audit log entry of %s action: %v", entry.Action, err)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+func (s *auditLog) List(params audit.ListParameters) ([]audit.EntryDTO, int, int, error) {
+	sess := s.NewReadSession()
+	var (
+		entries           = make([]audit.EntryDTO, 0)
+		lastErr           error
+		count, totalCount int
+	)
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		entries, count, totalCount, lastErr = sess.ListAuditEntries(params)
+		if lastErr != nil {
+			log.Errorf("while getting audit log entries: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, -1, -1, lastErr
+	}
+	return entries, count, totalCount, nil
+}
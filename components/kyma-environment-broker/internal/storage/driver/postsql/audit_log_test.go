@@ -0,0 +1,74 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("Audit log", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.AuditLog()
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		for _, entry := range []audit.EntryDTO{
+			{ID: "e1", Caller: "cf/john", CallerType: audit.CallerTypeOriginatingIdentity, Action: audit.ActionProvision, InstanceID: "inst-1", Parameters: `{"body":{}}`, StatusCode: 202, Outcome: audit.OutcomeSucceeded, CreatedAt: now.Add(-2 * time.Hour)},
+			{ID: "e2", Caller: "admin", CallerType: audit.CallerTypeToken, Action: audit.ActionCancelOrchestration, OrchestrationID: "orch-1", StatusCode: 404, Outcome: audit.OutcomeFailed, Error: "not found", CreatedAt: now.Add(-time.Hour)},
+			{ID: "e3", Caller: "cf/john", CallerType: audit.CallerTypeOriginatingIdentity, Action: audit.ActionDeprovision, InstanceID: "inst-1", StatusCode: 202, Outcome: audit.OutcomeSucceeded, CreatedAt: now},
+		} {
+			require.NoError(t, svc.Insert(entry))
+		}
+
+		// when
+		entries, count, totalCount, err := svc.List(audit.ListParameters{InstanceIDs: []string{"inst-1"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, 2, totalCount)
+		assert.Equal(t, "e3", entries[0].ID)
+		assert.Equal(t, "e1", entries[1].ID)
+		assert.Equal(t, `{"body":{}}`, entries[1].Parameters)
+
+		// when
+		entries, _, totalCount, err = svc.List(audit.ListParameters{Outcomes: []audit.Outcome{audit.OutcomeFailed}, From: now.Add(-90 * time.Minute)})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, totalCount)
+		assert.Equal(t, "orch-1", entries[0].OrchestrationID)
+		assert.Equal(t, "not found", entries[0].Error)
+
+		// when
+		entries, count, totalCount, err = svc.List(audit.ListParameters{Callers: []string{"cf/john"}, Page: 1, PageSize: 1})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, 2, totalCount)
+		assert.Equal(t, audit.ActionDeprovision, entries[0].Action)
+	})
+}
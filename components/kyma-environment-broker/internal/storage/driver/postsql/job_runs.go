@@ -0,0 +1,101 @@
+package postsql
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type jobRuns struct {
+	postsql.Factory
+}
+
+func NewJobRuns(sess postsql.Factory) *jobRuns {
+	return &jobRuns{
+		Factory: sess,
+	}
+}
+
+func (s *jobRuns) Insert(run jobs.RunDTO) error {
+	sess := s.NewWriteSession()
+	return wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		err := sess.InsertJobRun(dbmodel.NewJobRunDTO(run))
+		if err != nil {
+			log.Errorf("while saving run %s of the %s job: %v", run.ID, run.JobName, err)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+func (s *jobRuns) Update(run jobs.RunDTO) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.UpdateJobRun(dbmodel.NewJobRunDTO(run))
+		if lastErr != nil && dberr.IsNotFound(lastErr) {
+			return false, lastErr
+		}
+		if lastErr != nil {
+			log.Errorf("while updating run %s of the %s job: %v", run.ID, run.JobName, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *jobRuns) GetLastScheduled(jobName string) (jobs.RunDTO, error) {
+	sess := s.NewReadSession()
+	var (
+		run     dbmodel.JobRunDTO
+		lastErr dberr.Error
+	)
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		run, lastErr = sess.GetLastScheduledJobRun(jobName)
+		if lastErr != nil {
+			if dberr.IsNotFound(lastErr) {
+				return false, lastErr
+			}
+			log.Errorf("while getting last scheduled run of the %s job: %v", jobName, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return jobs.RunDTO{}, lastErr
+	}
+	return run.ToJobRun(), nil
+}
+
+func (s *jobRuns) List(params jobs.ListParameters) ([]jobs.RunDTO, int, int, error) {
+	sess := s.NewReadSession()
+	var (
+		dtos              []dbmodel.JobRunDTO
+		lastErr           error
+		count, totalCount int
+	)
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, count, totalCount, lastErr = sess.ListJobRuns(params)
+		if lastErr != nil {
+			log.Errorf("while getting job runs: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, -1, -1, lastErr
+	}
+
+	runs := make([]jobs.RunDTO, 0, len(dtos))
+	for _, dto := range dtos {
+		runs = append(runs, dto.ToJobRun())
+	}
+	return runs, count, totalCount, nil
+}
@@ -0,0 +1,91 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRuns(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("Job runs", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.JobRuns()
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		_, err = svc.GetLastScheduled("trial-cleanup")
+		assert.True(t, dberr.IsNotFound(err))
+
+		for _, run := range []jobs.RunDTO{
+			{ID: "r1", JobName: "trial-cleanup", State: jobs.Succeeded, TriggeredBy: jobs.TriggeredBySchedule, CreatedAt: now.Add(-2 * time.Hour)},
+			{ID: "r2", JobName: "trial-cleanup", State: jobs.Pending, TriggeredBy: "admin", CreatedAt: now.Add(-time.Hour)},
+			{ID: "r3", JobName: "environments-cleanup", State: jobs.Pending, TriggeredBy: jobs.TriggeredBySchedule, CreatedAt: now},
+		} {
+			require.NoError(t, svc.Insert(run))
+		}
+
+		// when
+		run, err := svc.GetLastScheduled("trial-cleanup")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "r1", run.ID)
+		assert.Nil(t, run.StartedAt)
+
+		// when
+		finishedAt := now.Add(time.Minute)
+		run.State = jobs.Failed
+		run.Affected = []string{"inst-1", "inst-2"}
+		run.Error = "broker unavailable"
+		run.StartedAt = &now
+		run.FinishedAt = &finishedAt
+		require.NoError(t, svc.Update(run))
+		runs, count, totalCount, err := svc.List(jobs.ListParameters{JobNames: []string{"trial-cleanup"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, 2, totalCount)
+		assert.Equal(t, "r2", runs[0].ID)
+		assert.Equal(t, jobs.Failed, runs[1].State)
+		assert.Equal(t, []string{"inst-1", "inst-2"}, runs[1].Affected)
+		assert.Equal(t, "broker unavailable", runs[1].Error)
+		assert.Equal(t, finishedAt, runs[1].FinishedAt.UTC())
+
+		// when
+		runs, _, totalCount, err = svc.List(jobs.ListParameters{States: []jobs.RunState{jobs.Pending}, Page: 1, PageSize: 1})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, totalCount)
+		assert.Equal(t, "r3", runs[0].ID)
+
+		// when
+		err = svc.Update(jobs.RunDTO{ID: "unknown"})
+
+		// then
+		assert.True(t, dberr.IsNotFound(err))
+	})
+}
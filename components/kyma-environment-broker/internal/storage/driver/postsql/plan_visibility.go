@@ -0,0 +1,82 @@
+package postsql
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type planVisibility struct {
+	postsql.Factory
+}
+
+func NewPlanVisibility(sess postsql.Factory) *planVisibility {
+	return &planVisibility{
+		Factory: sess,
+	}
+}
+
+// Upsert updates the rule of the plan for the scope or inserts it if the rule does not exist yet
+func (s *planVisibility) Upsert(rule planvisibility.RuleDTO) error {
+	sess := s.NewWriteSession()
+	dto := dbmodel.NewPlanVisibilityRuleDTO(rule)
+	return wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		err := sess.UpdatePlanVisibilityRule(dto)
+		if err != nil && dberr.IsNotFound(err) {
+			err = sess.InsertPlanVisibilityRule(dto)
+		}
+		if err != nil {
+			log.Errorf("while saving visibility rule of the plan %s for %s %s: %v", rule.PlanName, rule.ScopeType, rule.ScopeValue, err)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+func (s *planVisibility) Delete(planName string, scopeType planvisibility.ScopeType, scopeValue string) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.DeletePlanVisibilityRule(planName, string(scopeType), scopeValue)
+		if lastErr != nil && dberr.IsNotFound(lastErr) {
+			return false, lastErr
+		}
+		if lastErr != nil {
+			log.Errorf("while deleting visibility rule of the plan %s for %s %s: %v", planName, scopeType, scopeValue, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *planVisibility) List(params planvisibility.ListParameters) ([]planvisibility.RuleDTO, error) {
+	sess := s.NewReadSession()
+	var (
+		dtos    []dbmodel.PlanVisibilityRuleDTO
+		lastErr error
+	)
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = sess.ListPlanVisibilityRules(params)
+		if lastErr != nil {
+			log.Errorf("while getting plan visibility rules: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	rules := make([]planvisibility.RuleDTO, 0, len(dtos))
+	for _, dto := range dtos {
+		rules = append(rules, dto.ToRule())
+	}
+	return rules, nil
+}
@@ -0,0 +1,80 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanVisibility(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("Plan visibility rules", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.PlanVisibility()
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		for _, rule := range []planvisibility.RuleDTO{
+			{PlanName: "preview", ScopeType: planvisibility.GlobalAccountScope, ScopeValue: "ga-1", Visible: true, UpdatedBy: "admin", CreatedAt: now, UpdatedAt: now},
+			{PlanName: "preview", ScopeType: planvisibility.PlatformRegionScope, ScopeValue: "cf-eu10", Visible: true, UpdatedBy: "admin", CreatedAt: now, UpdatedAt: now},
+			{PlanName: "own_cluster", ScopeType: planvisibility.SubAccountScope, ScopeValue: "sa-1", Visible: true, UpdatedBy: "admin", CreatedAt: now, UpdatedAt: now},
+		} {
+			require.NoError(t, svc.Upsert(rule))
+		}
+
+		// when
+		updatedAt := now.Add(time.Minute)
+		err = svc.Upsert(planvisibility.RuleDTO{PlanName: "preview", ScopeType: planvisibility.GlobalAccountScope, ScopeValue: "ga-1",
+			Visible: false, Features: []string{planvisibility.AdditionalParamsFeature}, UpdatedBy: "operator", CreatedAt: updatedAt, UpdatedAt: updatedAt})
+		require.NoError(t, err)
+		rules, err := svc.List(planvisibility.ListParameters{PlanNames: []string{"preview"}})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		assert.Equal(t, planvisibility.GlobalAccountScope, rules[0].ScopeType)
+		assert.False(t, rules[0].Visible)
+		assert.Equal(t, []string{planvisibility.AdditionalParamsFeature}, rules[0].Features)
+		assert.Equal(t, "operator", rules[0].UpdatedBy)
+		assert.Equal(t, now, rules[0].CreatedAt.UTC())
+		assert.Equal(t, updatedAt, rules[0].UpdatedAt.UTC())
+		assert.Equal(t, "cf-eu10", rules[1].ScopeValue)
+
+		// when
+		err = svc.Delete("preview", planvisibility.PlatformRegionScope, "cf-eu10")
+		require.NoError(t, err)
+		rules, err = svc.List(planvisibility.ListParameters{ScopeTypes: []planvisibility.ScopeType{planvisibility.PlatformRegionScope, planvisibility.SubAccountScope}})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, "own_cluster", rules[0].PlanName)
+
+		// when
+		err = svc.Delete("preview", planvisibility.PlatformRegionScope, "cf-eu10")
+
+		// then
+		assert.True(t, dberr.IsNotFound(err))
+	})
+}
@@ -3,7 +3,10 @@ package storage
 import (
 	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/predicate"
@@ -115,3 +118,21 @@ type Events interface {
 	InsertEvent(level events.EventLevel, message, instanceID, operationID string)
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
 }
+
+type AuditLog interface {
+	Insert(entry audit.EntryDTO) error
+	List(params audit.ListParameters) ([]audit.EntryDTO, int, int, error)
+}
+
+type JobRuns interface {
+	Insert(run jobs.RunDTO) error
+	Update(run jobs.RunDTO) error
+	GetLastScheduled(jobName string) (jobs.RunDTO, error)
+	List(params jobs.ListParameters) ([]jobs.RunDTO, int, int, error)
+}
+
+type PlanVisibility interface {
+	Upsert(rule planvisibility.RuleDTO) error
+	Delete(planName string, scopeType planvisibility.ScopeType, scopeValue string) error
+	List(params planvisibility.ListParameters) ([]planvisibility.RuleDTO, error)
+}
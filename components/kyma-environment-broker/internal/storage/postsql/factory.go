@@ -4,7 +4,10 @@ import (
 	"time"
 
 	dbr "github.com/gocraft/dbr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
@@ -52,6 +55,10 @@ type ReadSession interface {
 	GetLatestRuntimeStateWithKymaVersionByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetLatestRuntimeStateWithOIDCConfigByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
+	ListAuditEntries(params audit.ListParameters) ([]audit.EntryDTO, int, int, error)
+	GetLastScheduledJobRun(jobName string) (dbmodel.JobRunDTO, dberr.Error)
+	ListJobRuns(params jobs.ListParameters) ([]dbmodel.JobRunDTO, int, int, error)
+	ListPlanVisibilityRules(params planvisibility.ListParameters) ([]dbmodel.PlanVisibilityRuleDTO, error)
 }
 
 //go:generate mockery --name=WriteSession
@@ -66,6 +73,12 @@ type WriteSession interface {
 	InsertRuntimeState(state dbmodel.RuntimeStateDTO) dberr.Error
 	InsertEvent(level events.EventLevel, message, instanceID, operationID string) dberr.Error
 	DeleteEvents(until time.Time) dberr.Error
+	InsertAuditEntry(entry audit.EntryDTO) dberr.Error
+	InsertJobRun(run dbmodel.JobRunDTO) dberr.Error
+	UpdateJobRun(run dbmodel.JobRunDTO) dberr.Error
+	InsertPlanVisibilityRule(rule dbmodel.PlanVisibilityRuleDTO) dberr.Error
+	UpdatePlanVisibilityRule(rule dbmodel.PlanVisibilityRuleDTO) dberr.Error
+	DeletePlanVisibilityRule(planName, scopeType, scopeValue string) dberr.Error
 }
 
 type Transaction interface {
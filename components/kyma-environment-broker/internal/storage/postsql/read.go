@@ -5,8 +5,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/planvisibility"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
@@ -700,6 +703,141 @@ func (r readSession) ListEvents(filter events.EventFilter) ([]events.EventDTO, e
 	return events, err
 }
 
+func (r readSession) ListAuditEntries(params audit.ListParameters) ([]audit.EntryDTO, int, int, error) {
+	var entries []audit.EntryDTO
+
+	stmt := r.session.Select("*").
+		From(AuditLogTableName).
+		OrderDesc(CreatedAtField)
+
+	if params.Page > 0 && params.PageSize > 0 {
+		stmt.Paginate(uint64(params.Page), uint64(params.PageSize))
+	}
+	addAuditFilters(stmt, params)
+
+	if _, err := stmt.Load(&entries); err != nil {
+		return nil, -1, -1, dberr.Internal("Failed to get audit log entries: %s", err)
+	}
+
+	var res struct {
+		Total int
+	}
+	countStmt := r.session.Select("count(*) as total").From(AuditLogTableName)
+	addAuditFilters(countStmt, params)
+	if err := countStmt.LoadOne(&res); err != nil {
+		return nil, -1, -1, dberr.Internal("Failed to count audit log entries: %s", err)
+	}
+
+	return entries, len(entries), res.Total, nil
+}
+
+func addAuditFilters(stmt *dbr.SelectStmt, params audit.ListParameters) {
+	if len(params.InstanceIDs) != 0 {
+		stmt.Where(dbr.Eq("instance_id", params.InstanceIDs))
+	}
+	if len(params.OrchestrationIDs) != 0 {
+		stmt.Where(dbr.Eq("orchestration_id", params.OrchestrationIDs))
+	}
+	if len(params.Callers) != 0 {
+		stmt.Where(dbr.Eq("caller", params.Callers))
+	}
+	if len(params.Actions) != 0 {
+		stmt.Where(dbr.Eq("action", params.Actions))
+	}
+	if len(params.Outcomes) != 0 {
+		stmt.Where(dbr.Eq("outcome", params.Outcomes))
+	}
+	if !params.From.IsZero() {
+		stmt.Where(dbr.Gte(CreatedAtField, params.From))
+	}
+	if !params.To.IsZero() {
+		stmt.Where(dbr.Lte(CreatedAtField, params.To))
+	}
+}
+
+func (r readSession) GetLastScheduledJobRun(jobName string) (dbmodel.JobRunDTO, dberr.Error) {
+	var run dbmodel.JobRunDTO
+
+	err := r.session.
+		Select("*").
+		From(JobRunsTableName).
+		Where(dbr.Eq("job_name", jobName)).
+		Where(dbr.Eq("triggered_by", jobs.TriggeredBySchedule)).
+		OrderDesc(CreatedAtField).
+		Limit(1).
+		LoadOne(&run)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.JobRunDTO{}, dberr.NotFound("Cannot find scheduled run of the job %s", jobName)
+		}
+		return dbmodel.JobRunDTO{}, dberr.Internal("Failed to get job run: %s", err)
+	}
+	return run, nil
+}
+
+func (r readSession) ListJobRuns(params jobs.ListParameters) ([]dbmodel.JobRunDTO, int, int, error) {
+	var runs []dbmodel.JobRunDTO
+
+	stmt := r.session.Select("*").
+		From(JobRunsTableName).
+		OrderDesc(CreatedAtField)
+
+	if params.Page > 0 && params.PageSize > 0 {
+		stmt.Paginate(uint64(params.Page), uint64(params.PageSize))
+	}
+	addJobRunFilters(stmt, params)
+
+	if _, err := stmt.Load(&runs); err != nil {
+		return nil, -1, -1, dberr.Internal("Failed to get job runs: %s", err)
+	}
+
+	var res struct {
+		Total int
+	}
+	countStmt := r.session.Select("count(*) as total").From(JobRunsTableName)
+	addJobRunFilters(countStmt, params)
+	if err := countStmt.LoadOne(&res); err != nil {
+		return nil, -1, -1, dberr.Internal("Failed to count job runs: %s", err)
+	}
+
+	return runs, len(runs), res.Total, nil
+}
+
+func addJobRunFilters(stmt *dbr.SelectStmt, params jobs.ListParameters) {
+	if len(params.JobNames) != 0 {
+		stmt.Where(dbr.Eq("job_name", params.JobNames))
+	}
+	if len(params.States) != 0 {
+		stmt.Where(dbr.Eq("state", params.States))
+	}
+}
+
+func (r readSession) ListPlanVisibilityRules(params planvisibility.ListParameters) ([]dbmodel.PlanVisibilityRuleDTO, error) {
+	var rules []dbmodel.PlanVisibilityRuleDTO
+
+	stmt := r.session.Select("*").
+		From(PlanVisibilityRulesTableName).
+		OrderAsc("plan_name").
+		OrderAsc("scope_type").
+		OrderAsc("scope_value")
+
+	if len(params.PlanNames) != 0 {
+		stmt.Where(dbr.Eq("plan_name", params.PlanNames))
+	}
+	if len(params.ScopeTypes) != 0 {
+		stmt.Where(dbr.Eq("scope_type", params.ScopeTypes))
+	}
+	if len(params.ScopeValues) != 0 {
+		stmt.Where(dbr.Eq("scope_value", params.ScopeValues))
+	}
+
+	if _, err := stmt.Load(&rules); err != nil {
+		return nil, dberr.Internal("Failed to get plan visibility rules: %s", err)
+	}
+	return rules, nil
+}
+
 func (r readSession) getInstanceCount(filter dbmodel.InstanceFilter) (int, error) {
 	var res struct {
 		Total int
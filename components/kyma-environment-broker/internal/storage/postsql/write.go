@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 
@@ -265,6 +266,130 @@ func (ws writeSession) DeleteEvents(until time.Time) dberr.Error {
 	return nil
 }
 
+func (ws writeSession) InsertAuditEntry(entry audit.EntryDTO) dberr.Error {
+	_, err := ws.insertInto(AuditLogTableName).
+		Pair("id", entry.ID).
+		Pair("caller", entry.Caller).
+		Pair("caller_type", entry.CallerType).
+		Pair("action", entry.Action).
+		Pair("instance_id", entry.InstanceID).
+		Pair("orchestration_id", entry.OrchestrationID).
+		Pair("parameters", entry.Parameters).
+		Pair("status_code", entry.StatusCode).
+		Pair("outcome", entry.Outcome).
+		Pair("error", entry.Error).
+		Pair("created_at", entry.CreatedAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to insert audit log entry: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) InsertJobRun(run dbmodel.JobRunDTO) dberr.Error {
+	_, err := ws.insertInto(JobRunsTableName).
+		Pair("id", run.ID).
+		Pair("job_name", run.JobName).
+		Pair("state", run.State).
+		Pair("triggered_by", run.TriggeredBy).
+		Pair("affected", run.Affected).
+		Pair("error", run.Error).
+		Pair("created_at", run.CreatedAt).
+		Pair("started_at", run.StartedAt).
+		Pair("finished_at", run.FinishedAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to insert job run: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) UpdateJobRun(run dbmodel.JobRunDTO) dberr.Error {
+	res, err := ws.update(JobRunsTableName).
+		Where(dbr.Eq("id", run.ID)).
+		Set("state", run.State).
+		Set("affected", run.Affected).
+		Set("error", run.Error).
+		Set("started_at", run.StartedAt).
+		Set("finished_at", run.FinishedAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update job run: %s", err)
+	}
+	rAffected, e := res.RowsAffected()
+	if e != nil {
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("Cannot find job run with ID:'%s'", run.ID)
+	}
+	return nil
+}
+
+func (ws writeSession) InsertPlanVisibilityRule(rule dbmodel.PlanVisibilityRuleDTO) dberr.Error {
+	_, err := ws.insertInto(PlanVisibilityRulesTableName).
+		Pair("plan_name", rule.PlanName).
+		Pair("scope_type", rule.ScopeType).
+		Pair("scope_value", rule.ScopeValue).
+		Pair("visible", rule.Visible).
+		Pair("features", rule.Features).
+		Pair("updated_by", rule.UpdatedBy).
+		Pair("created_at", rule.CreatedAt).
+		Pair("updated_at", rule.UpdatedAt).
+		Exec()
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("plan visibility rule for plan %s and %s %s already exists", rule.PlanName, rule.ScopeType, rule.ScopeValue)
+			}
+		}
+		return dberr.Internal("Failed to insert plan visibility rule: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) UpdatePlanVisibilityRule(rule dbmodel.PlanVisibilityRuleDTO) dberr.Error {
+	res, err := ws.update(PlanVisibilityRulesTableName).
+		Where(dbr.Eq("plan_name", rule.PlanName)).
+		Where(dbr.Eq("scope_type", rule.ScopeType)).
+		Where(dbr.Eq("scope_value", rule.ScopeValue)).
+		Set("visible", rule.Visible).
+		Set("features", rule.Features).
+		Set("updated_by", rule.UpdatedBy).
+		Set("updated_at", rule.UpdatedAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update plan visibility rule: %s", err)
+	}
+	rAffected, e := res.RowsAffected()
+	if e != nil {
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("Cannot find plan visibility rule for plan %s and %s %s", rule.PlanName, rule.ScopeType, rule.ScopeValue)
+	}
+	return nil
+}
+
+func (ws writeSession) DeletePlanVisibilityRule(planName, scopeType, scopeValue string) dberr.Error {
+	res, err := ws.deleteFrom(PlanVisibilityRulesTableName).
+		Where(dbr.Eq("plan_name", planName)).
+		Where(dbr.Eq("scope_type", scopeType)).
+		Where(dbr.Eq("scope_value", scopeValue)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to delete plan visibility rule: %s", err)
+	}
+	rAffected, e := res.RowsAffected()
+	if e != nil {
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("Cannot find plan visibility rule for plan %s and %s %s", planName, scopeType, scopeValue)
+	}
+	return nil
+}
+
 func (ws writeSession) Commit() dberr.Error {
 	err := ws.transaction.Commit()
 	if err != nil {
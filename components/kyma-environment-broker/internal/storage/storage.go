@@ -23,6 +23,9 @@ type BrokerStorage interface {
 	Orchestrations() Orchestrations
 	RuntimeStates() RuntimeStates
 	Events() Events
+	AuditLog() AuditLog
+	JobRuns() JobRuns
+	PlanVisibility() PlanVisibility
 }
 
 const (
@@ -51,6 +54,9 @@ func NewFromConfig(cfg Config, evcfg events.Config, cipher postgres.Cipher, log
 		orchestrations: postgres.NewOrchestrations(fact),
 		runtimeStates:  postgres.NewRuntimeStates(fact, cipher),
 		events:         events.New(evcfg, eventstorage.New(fact, log)),
+		auditLog:       postgres.NewAuditLog(fact),
+		jobRuns:        postgres.NewJobRuns(fact),
+		planVisibility: postgres.NewPlanVisibility(fact),
 	}, connection, nil
 }
 
@@ -62,6 +68,9 @@ func NewMemoryStorage() BrokerStorage {
 		orchestrations: memory.NewOrchestrations(),
 		runtimeStates:  memory.NewRuntimeStates(),
 		events:         events.New(events.Config{}, NewInMemoryEvents()),
+		auditLog:       memory.NewAuditLog(),
+		jobRuns:        memory.NewJobRuns(),
+		planVisibility: memory.NewPlanVisibility(),
 	}
 }
 
@@ -119,6 +128,9 @@ type storage struct {
 	orchestrations Orchestrations
 	runtimeStates  RuntimeStates
 	events         Events
+	auditLog       AuditLog
+	jobRuns        JobRuns
+	planVisibility PlanVisibility
 }
 
 func (s storage) Instances() Instances {
@@ -148,3 +160,15 @@ func (s storage) RuntimeStates() RuntimeStates {
 func (s storage) Events() Events {
 	return s.events
 }
+
+func (s storage) AuditLog() AuditLog {
+	return s.auditLog
+}
+
+func (s storage) JobRuns() JobRuns {
+	return s.jobRuns
+}
+
+func (s storage) PlanVisibility() PlanVisibility {
+	return s.planVisibility
+}
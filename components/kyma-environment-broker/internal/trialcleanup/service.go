@@ -0,0 +1,149 @@
+package trialcleanup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/cleanup"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	trialPlanID = broker.TrialPlanID
+	jobName     = "trial-cleanup"
+)
+
+type BrokerClient interface {
+	SendExpirationRequest(instance internal.Instance) (bool, error)
+}
+
+type Config struct {
+	DryRun           bool          `envconfig:"default=true"`
+	ExpirationPeriod time.Duration `envconfig:"default=336h"`
+	// MaxAffected aborts the cleanup if more instances would expire, zero means no limit
+	MaxAffected int `envconfig:"default=0"`
+}
+
+// Service expires the trial instances older than the expiration period
+type Service struct {
+	cfg             Config
+	instanceStorage storage.Instances
+	brokerClient    BrokerClient
+	log             logrus.FieldLogger
+}
+
+type instancePredicate func(internal.Instance) bool
+
+func NewService(cfg Config, brokerClient BrokerClient, instances storage.Instances, log logrus.FieldLogger) *Service {
+	return &Service{
+		cfg:             cfg,
+		instanceStorage: instances,
+		brokerClient:    brokerClient,
+		log:             log,
+	}
+}
+
+// PerformCleanup sends the expiration requests, the report lists the instances to expire
+func (s *Service) PerformCleanup() (*cleanup.Report, error) {
+	report := cleanup.NewReport(jobName, s.cfg.DryRun)
+
+	nonExpiredTrialInstancesFilter := dbmodel.InstanceFilter{PlanIDs: []string{trialPlanID}, Expired: &[]bool{false}[0]}
+	nonExpiredTrialInstances, nonExpiredTrialInstancesCount, err := s.getInstances(nonExpiredTrialInstancesFilter)
+
+	if err != nil {
+		s.log.Error(fmt.Sprintf("while getting non-expired trial instances: %s", err))
+		return report, err
+	}
+
+	instancesToExpire, instancesToExpireCount := s.filterInstances(
+		nonExpiredTrialInstances,
+		func(instance internal.Instance) bool { return time.Since(instance.CreatedAt) >= s.cfg.ExpirationPeriod },
+	)
+
+	instancesToBeLeftCount := nonExpiredTrialInstancesCount - instancesToExpireCount
+
+	for _, instance := range instancesToExpire {
+		report.Add(cleanup.Item{
+			Kind:   cleanup.KindInstance,
+			Name:   instance.InstanceID,
+			Reason: fmt.Sprintf("trial created %.0f days ago, expiration period is %s", time.Since(instance.CreatedAt).Hours()/24, s.cfg.ExpirationPeriod),
+		})
+	}
+	if err := report.CheckLimit(s.cfg.MaxAffected); err != nil {
+		s.log.Error(err)
+		return report, err
+	}
+
+	if s.cfg.DryRun {
+		s.logInstances(instancesToExpire)
+		s.log.Infof("Trials non-expired: %+v, to expire now: %+v, to be left non-expired: %+v", nonExpiredTrialInstancesCount, instancesToExpireCount, instancesToBeLeftCount)
+		return report, nil
+	}
+
+	suspensionsAcceptedCount, onlyMarkedAsExpiredCount, failuresCount := s.cleanupInstances(report, instancesToExpire)
+	s.log.Infof("Trials non-expired: %+v, to expire: %+v, left non-expired: %+v, suspension under way: %+v just marked expired: %+v, failures: %+v", nonExpiredTrialInstancesCount, instancesToExpireCount, instancesToBeLeftCount, suspensionsAcceptedCount, onlyMarkedAsExpiredCount, failuresCount)
+	return report, nil
+}
+
+func (s *Service) getInstances(filter dbmodel.InstanceFilter) ([]internal.Instance, int, error) {
+
+	instances, _, totalCount, err := s.instanceStorage.List(filter)
+	if err != nil {
+		return []internal.Instance{}, 0, err
+	}
+
+	return instances, totalCount, nil
+}
+
+func (s *Service) filterInstances(instances []internal.Instance, filter instancePredicate) ([]internal.Instance, int) {
+	var filteredInstances []internal.Instance
+	for _, instance := range instances {
+		if filter(instance) {
+			filteredInstances = append(filteredInstances, instance)
+		}
+	}
+	return filteredInstances, len(filteredInstances)
+}
+
+func (s *Service) cleanupInstances(report *cleanup.Report, instances []internal.Instance) (int, int, int) {
+	var suspensionAccepted int
+	var onlyExpirationMarked int
+	totalInstances := len(instances)
+	for _, instance := range instances {
+		suspensionUnderWay, err := s.expireInstance(instance)
+		if err != nil {
+			// ignoring errors - only logging
+			s.log.Error(fmt.Sprintf("while sending expiration request for instanceID: %s, error: %s", instance.InstanceID, err))
+			report.SetError(cleanup.KindInstance, instance.InstanceID, err)
+			continue
+		}
+		if suspensionUnderWay {
+			suspensionAccepted += 1
+		} else {
+			onlyExpirationMarked += 1
+		}
+	}
+	failures := totalInstances - suspensionAccepted - onlyExpirationMarked
+	return suspensionAccepted, onlyExpirationMarked, failures
+}
+
+func (s *Service) logInstances(instances []internal.Instance) {
+	for _, instance := range instances {
+		s.log.Infof("instanceId: %+v createdAt: %+v (%.0f days ago) servicePlanID: %+v servicePlanName: %+v",
+			instance.InstanceID, instance.CreatedAt, time.Since(instance.CreatedAt).Hours()/24, instance.ServicePlanID, instance.ServicePlanName)
+	}
+}
+
+func (s *Service) expireInstance(instance internal.Instance) (processed bool, err error) {
+	s.log.Infof("About to make instance suspended for instanceId: %+v", instance.InstanceID)
+	suspensionUnderWay, err := s.brokerClient.SendExpirationRequest(instance)
+	if err != nil {
+		s.log.Error(fmt.Sprintf("while sending expiration request for instance ID %q: %s", instance.InstanceID, err))
+		return suspensionUnderWay, err
+	}
+	return suspensionUnderWay, nil
+}
@@ -1,13 +1,17 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
 	"os"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/rest"
 
 	skrsvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/svc"
 
@@ -26,6 +30,7 @@ import (
 
 	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/service"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/shard"
 
 	gardenersecret "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/secret"
 	gardenershoot "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/shoot"
@@ -115,6 +120,31 @@ func main() {
 		SvcConfig:       skrsvc.Config{},
 	}
 
+	// Split subAccounts between replicas
+	sharderCtx, stopSharder := context.WithCancel(context.Background())
+	sharderStopped := make(chan struct{})
+	shardConfig := new(shard.Config)
+	if err := envconfig.Process("", shardConfig); err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Load sharding config")
+	}
+	if shardConfig.Enabled {
+		if err := shardConfig.Validate(); err != nil {
+			logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Validate sharding config")
+		}
+		sharder, err := newSharder(shardConfig, opts.ScrapeInterval, logger)
+		if err != nil {
+			logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Generate sharder")
+		}
+		kmcProcess.Sharder = sharder
+		go func() {
+			defer close(sharderStopped)
+			sharder.Run(sharderCtx)
+		}()
+		logger.Infof("sharding enabled with identity: %s", shardConfig.Identity)
+	} else {
+		close(sharderStopped)
+	}
+
 	// Start execution
 	go kmcProcess.Start()
 
@@ -136,6 +166,23 @@ func main() {
 
 	// Start a server to cater to the metrics and healthz endpoints
 	kmcSvr.Start()
+
+	// Release the shard lease on shutdown, so that the other replicas take over the subAccounts in the next interval
+	stopSharder()
+	<-sharderStopped
+}
+
+func newSharder(config *shard.Config, scrapeInterval time.Duration, logger *zap.SugaredLogger) (*shard.Sharder, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+	coordinationClient, err := coordinationclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	leases := coordinationClient.Leases(config.Namespace)
+	return shard.NewSharder(config, leases, scrapeInterval, logger), nil
 }
 
 func enableDebugging(debugPort int, log *zap.SugaredLogger) {
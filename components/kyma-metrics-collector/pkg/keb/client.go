@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -19,6 +20,29 @@ import (
 	"k8s.io/client-go/util/retry"
 )
 
+type ChangeType string
+
+const (
+	ChangeUpserted ChangeType = "upserted"
+	ChangeRemoved  ChangeType = "removed"
+)
+
+// RuntimeChange is a single entry of the KEB /runtimes/changes response
+type RuntimeChange struct {
+	Type         ChangeType             `json:"type"`
+	InstanceID   string                 `json:"instanceID"`
+	RuntimeID    string                 `json:"runtimeID"`
+	SubAccountID string                 `json:"subAccountID"`
+	Runtime      *kebruntime.RuntimeDTO `json:"runtime,omitempty"`
+}
+
+// RuntimeChangesPage is the response of the KEB /runtimes/changes endpoint
+type RuntimeChangesPage struct {
+	Data   []RuntimeChange `json:"data"`
+	Count  int             `json:"count"`
+	Cursor string          `json:"cursor"`
+}
+
 type Client struct {
 	HTTPClient *http.Client
 	Logger     *zap.SugaredLogger
@@ -30,6 +54,9 @@ const (
 	backOffFactor = 5.0
 
 	clientName = "keb-client"
+
+	changesPath = "changes"
+	sinceParam  = "since"
 )
 
 func NewClient(config *Config, logger *zap.SugaredLogger) *Client {
@@ -86,6 +113,48 @@ func (c Client) getRuntimesPerPage(req *http.Request, pageNum int) (*kebruntime.
 		"page": []string{fmt.Sprintf("%d", pageNum)},
 	}
 	req.URL.RawQuery = query.Encode()
+	body, err := c.getWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+	runtimesPage := new(kebruntime.RuntimesPage)
+	if err := json.Unmarshal(body, runtimesPage); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal runtimes response")
+	}
+
+	return runtimesPage, nil
+}
+
+// GetRuntimeChanges fetches the runtimes which changed after the given cursor from the KEB runtime change feed.
+// If the cursor is empty, KEB returns only the current cursor of the feed.
+func (c Client) GetRuntimeChanges(cursor string) (*RuntimeChangesPage, error) {
+	changesURL, err := url.ParseRequestURI(c.Config.URL)
+	if err != nil {
+		return nil, err
+	}
+	changesURL.Path = fmt.Sprintf("%s/%s", strings.TrimSuffix(changesURL.Path, "/"), changesPath)
+	if cursor != "" {
+		changesURL.RawQuery = url.Values{sinceParam: []string{cursor}}.Encode()
+	}
+	req := &http.Request{
+		Method: http.MethodGet,
+		URL:    changesURL,
+	}
+	c.Logger.Debugf("polling for runtime changes with URL: %s", req.URL.String())
+
+	body, err := c.getWithRetry(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get runtime changes from KEB")
+	}
+	changesPage := new(RuntimeChangesPage)
+	if err := json.Unmarshal(body, changesPage); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal runtime changes response")
+	}
+
+	return changesPage, nil
+}
+
+func (c Client) getWithRetry(req *http.Request) ([]byte, error) {
 	customBackoff := wait.Backoff{
 		Steps:    c.Config.RetryCount,
 		Duration: c.HTTPClient.Timeout,
@@ -137,12 +206,8 @@ func (c Client) getRuntimesPerPage(req *http.Request, pageNum int) (*kebruntime.
 			}
 		}
 	}()
-	runtimesPage := new(kebruntime.RuntimesPage)
-	if err := json.Unmarshal(body, runtimesPage); err != nil {
-		return nil, errors.Wrapf(err, "failed to unmarshal runtimes response")
-	}
 
-	return runtimesPage, nil
+	return body, nil
 }
 
 func (c *Client) namedLogger() *zap.SugaredLogger {
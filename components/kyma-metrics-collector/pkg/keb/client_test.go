@@ -223,6 +223,72 @@ func TestGetAllRuntimes(t *testing.T) {
 	})
 }
 
+func TestGetRuntimeChanges(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	totalRequest.Reset()
+	cursor := "2023-03-01T10:00:00Z"
+	nextCursor := "2023-03-01T10:01:00Z"
+
+	expectedChanges := RuntimeChangesPage{
+		Data: []RuntimeChange{
+			{
+				Type:         ChangeUpserted,
+				InstanceID:   "instance-1",
+				RuntimeID:    "runtime-1",
+				SubAccountID: "subaccount-1",
+				Runtime: &runtime.RuntimeDTO{
+					InstanceID:   "instance-1",
+					RuntimeID:    "runtime-1",
+					SubAccountID: "subaccount-1",
+					ShootName:    "shoot-1",
+				},
+			},
+			{
+				Type:         ChangeRemoved,
+				InstanceID:   "instance-2",
+				RuntimeID:    "runtime-2",
+				SubAccountID: "subaccount-2",
+			},
+		},
+		Count:  2,
+		Cursor: nextCursor,
+	}
+
+	getChangesHandler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		g.Expect(req.URL.Path).To(gomega.Equal(expectedPathPrefix + "/changes"))
+		response := RuntimeChangesPage{Data: []RuntimeChange{}, Cursor: cursor}
+		if req.URL.Query().Get("since") == cursor {
+			response = expectedChanges
+		}
+		body, err := json.Marshal(response)
+		g.Expect(err).Should(gomega.BeNil())
+		_, err = rw.Write(body)
+		g.Expect(err).Should(gomega.BeNil())
+	})
+
+	// Start a local test HTTP server
+	srv := kmctesting.StartTestServer(expectedPathPrefix+"/changes", getChangesHandler, g)
+	defer srv.Close()
+
+	kebClient := getKEBClient(fmt.Sprintf("%s%s", srv.URL, expectedPathPrefix))
+
+	// Getting the cursor only
+	gotChanges, err := kebClient.GetRuntimeChanges("")
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(gotChanges.Cursor).To(gomega.Equal(cursor))
+	g.Expect(gotChanges.Data).To(gomega.BeEmpty())
+
+	// Getting the changes since the cursor
+	gotChanges, err = kebClient.GetRuntimeChanges(cursor)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(*gotChanges).To(gomega.Equal(expectedChanges))
+
+	// Ensure metric has expected value
+	counter, err := totalRequest.GetMetricWithLabelValues(fmt.Sprint(http.StatusOK))
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(testutil.ToFloat64(counter)).Should(gomega.Equal(float64(2)))
+}
+
 func getKEBClient(url string) *Client {
 	config := &Config{
 		URL:              url,
@@ -7,4 +7,7 @@ type Config struct {
 	Timeout          time.Duration `envconfig:"KEB_TIMEOUT" default:"30s"`
 	RetryCount       int           `envconfig:"KEB_RETRY_COUNT" default:"5"`
 	PollWaitDuration time.Duration `envconfig:"KEB_POLL_WAIT_DURATION" default:"10m"`
+	// ChangesPollWaitDuration is the interval of polling the KEB runtime change feed between full resyncs.
+	// Zero disables the change feed, so that runtimes are fetched only by the full resync.
+	ChangesPollWaitDuration time.Duration `envconfig:"KEB_CHANGES_POLL_WAIT_DURATION" default:"1m"`
 }
@@ -18,6 +18,7 @@ import (
 	gardenersecret "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/secret"
 	gardenershoot "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/shoot"
 	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/shard"
 	skrnode "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/node"
 	skrpvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/pvc"
 	skrsvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/svc"
@@ -47,6 +48,8 @@ type Process struct {
 	PVCConfig       skrpvc.ConfigInf
 	SvcConfig       skrsvc.ConfigInf
 	Logger          *zap.SugaredLogger
+	// Sharder splits the subAccounts between KMC replicas. All subAccounts are processed if it is not set.
+	Sharder *shard.Sharder
 }
 
 const (
@@ -179,6 +182,8 @@ func (p *Process) pollKEBForRuntimes() {
 			Fatal("create a new request for KEB")
 	}
 	for {
+		// The cursor is taken before the full resync, so that no change made during the resync is lost
+		cursor := p.getRuntimeChangesCursor()
 		runtimesPage, err := p.KEBClient.GetAllRuntimes(kebReq)
 		if err != nil {
 			p.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
@@ -191,8 +196,54 @@ func (p *Process) pollKEBForRuntimes() {
 		p.namedLogger().Debugf("num of runtimes are: %d", runtimesPage.Count)
 		p.populateCacheAndQueue(runtimesPage)
 		p.namedLogger().Debugf("length of the cache after KEB is done populating: %d", p.Cache.ItemCount())
-		p.namedLogger().Infof("waiting to poll KEB again after %v....", p.KEBClient.Config.PollWaitDuration)
-		time.Sleep(p.KEBClient.Config.PollWaitDuration)
+		p.followRuntimeChanges(cursor)
+	}
+}
+
+// getRuntimeChangesCursor returns the current cursor of the KEB runtime change feed or an empty string
+// when the change feed is disabled or not available
+func (p *Process) getRuntimeChangesCursor() string {
+	if p.KEBClient.Config.ChangesPollWaitDuration <= 0 {
+		return ""
+	}
+	changesPage, err := p.KEBClient.GetRuntimeChanges("")
+	if err != nil {
+		p.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+			Warn("get runtime changes cursor from KEB, falling back to full resync only")
+		return ""
+	}
+	return changesPage.Cursor
+}
+
+// followRuntimeChanges polls the KEB runtime change feed starting from the given cursor until the next full resync is due.
+// Without the cursor it only waits for the next full resync.
+func (p *Process) followRuntimeChanges(cursor string) {
+	resyncInterval := p.KEBClient.Config.PollWaitDuration
+	changesInterval := p.KEBClient.Config.ChangesPollWaitDuration
+	if cursor == "" || changesInterval <= 0 {
+		p.namedLogger().Infof("waiting to poll KEB again after %v....", resyncInterval)
+		time.Sleep(resyncInterval)
+		return
+	}
+
+	p.namedLogger().Infof("following KEB runtime changes every %v until the next full resync after %v....", changesInterval, resyncInterval)
+	resyncAt := time.Now().Add(resyncInterval)
+	for time.Now().Before(resyncAt) {
+		wait := time.Until(resyncAt)
+		if wait > changesInterval {
+			wait = changesInterval
+		}
+		time.Sleep(wait)
+
+		changesPage, err := p.KEBClient.GetRuntimeChanges(cursor)
+		if err != nil {
+			p.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+				Error("get runtime changes from KEB")
+			continue
+		}
+		p.namedLogger().Debugf("num of changed runtimes are: %d", changesPage.Count)
+		p.applyRuntimeChanges(changesPage)
+		cursor = changesPage.Cursor
 	}
 }
 
@@ -246,6 +297,15 @@ func (p Process) processSubAccountID(subAccountID string, identifier int) {
 	p.namedLogger().With(log.KeySubAccountID, subAccountID).With(log.KeyWorkerID, identifier).
 		Debug("fetched subAccountID from queue")
 
+	if p.Sharder != nil && !p.Sharder.Claim(subAccountID) {
+		// Another replica is responsible for the subAccount or it has already been processed in this interval
+		next := p.Sharder.UntilNextInterval()
+		p.Queue.AddAfter(subAccountID, next)
+		p.namedLogger().With(log.KeyRequeue, log.ValueTrue).With(log.KeySubAccountID, subAccountID).
+			With(log.KeyWorkerID, identifier).Debugf("subAccountID not claimed by this replica, requeued after %v", next)
+		return
+	}
+
 	record, isOldMetricValid, err := p.getRecordWithOldOrNewMetric(identifier, subAccountID)
 	if err != nil {
 		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).With(log.KeyWorkerID, identifier).
@@ -379,50 +439,7 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 			continue
 		}
 		validSubAccounts[runtime.SubAccountID] = true
-		recordObj, isFoundInCache := p.Cache.Get(runtime.SubAccountID)
-		if isClusterTrackable(&runtime) {
-			newRecord := kmccache.Record{
-				SubAccountID: runtime.SubAccountID,
-				RuntimeID:    runtime.RuntimeID,
-				ShootName:    runtime.ShootName,
-				KubeConfig:   "",
-				Metric:       nil,
-			}
-			if !isFoundInCache {
-				err := p.Cache.Add(runtime.SubAccountID, newRecord, cache.NoExpiration)
-				if err != nil {
-					p.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
-						With(log.KeySubAccountID, runtime.SubAccountID).With(log.KeyRuntimeID, runtime.RuntimeID).
-						Error("Failed to add subAccountID to cache hence skipping queueing it")
-					continue
-				}
-				p.Queue.Add(runtime.SubAccountID)
-				p.namedLogger().With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, runtime.SubAccountID).
-					With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Queued and added to cache")
-				continue
-			}
-
-			// Cluster is trackable and exists in the cache
-			if record, ok := recordObj.(kmccache.Record); ok {
-				if record.ShootName != runtime.ShootName {
-					// The shootname has changed hence the record in the cache is not valid anymore
-					// No need to queue as the subAccountID already exists in queue
-					p.Cache.Set(runtime.SubAccountID, newRecord, cache.NoExpiration)
-					p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).With(log.KeyRuntimeID, runtime.RuntimeID).
-						Debug("Resetted the values in cache for subAccount")
-				}
-			}
-			continue
-		}
-		if isFoundInCache {
-			// Cluster is not trackable but is found in cache should be deleted
-			p.Cache.Delete(runtime.SubAccountID)
-			p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).
-				With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Deleted subAccount from cache")
-			continue
-		}
-		p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).
-			With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Ignoring SubAccount as it is not trackable")
+		p.updateCacheAndQueue(runtime)
 	}
 
 	// Cleaning up subAccounts from the cache which are not returned by KEB anymore
@@ -441,6 +458,85 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 	}
 }
 
+// applyRuntimeChanges updates Cache and Queue with the runtimes returned by the KEB runtime change feed
+func (p *Process) applyRuntimeChanges(changes *keb.RuntimeChangesPage) {
+	for _, change := range changes.Data {
+		if change.SubAccountID == "" {
+			continue
+		}
+		switch change.Type {
+		case keb.ChangeUpserted:
+			if change.Runtime == nil {
+				continue
+			}
+			p.updateCacheAndQueue(*change.Runtime)
+		case keb.ChangeRemoved:
+			recordObj, isFoundInCache := p.Cache.Get(change.SubAccountID)
+			if !isFoundInCache {
+				continue
+			}
+			// The subAccount could already have a new runtime, which must be kept
+			if record, ok := recordObj.(kmccache.Record); ok && record.RuntimeID != change.RuntimeID {
+				continue
+			}
+			p.Cache.Delete(change.SubAccountID)
+			p.namedLogger().With(log.KeySubAccountID, change.SubAccountID).
+				With(log.KeyRuntimeID, change.RuntimeID).Debug("Deleted removed subAccount from cache")
+		default:
+			p.namedLogger().With(log.KeySubAccountID, change.SubAccountID).
+				Warnf("Ignoring unknown runtime change type: %s", change.Type)
+		}
+	}
+}
+
+// updateCacheAndQueue adds a trackable runtime to Cache and Queue or deletes it from the Cache if it should not be tracked anymore
+func (p *Process) updateCacheAndQueue(runtime kebruntime.RuntimeDTO) {
+	recordObj, isFoundInCache := p.Cache.Get(runtime.SubAccountID)
+	if isClusterTrackable(&runtime) {
+		newRecord := kmccache.Record{
+			SubAccountID: runtime.SubAccountID,
+			RuntimeID:    runtime.RuntimeID,
+			ShootName:    runtime.ShootName,
+			KubeConfig:   "",
+			Metric:       nil,
+		}
+		if !isFoundInCache {
+			err := p.Cache.Add(runtime.SubAccountID, newRecord, cache.NoExpiration)
+			if err != nil {
+				p.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+					With(log.KeySubAccountID, runtime.SubAccountID).With(log.KeyRuntimeID, runtime.RuntimeID).
+					Error("Failed to add subAccountID to cache hence skipping queueing it")
+				return
+			}
+			p.Queue.Add(runtime.SubAccountID)
+			p.namedLogger().With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, runtime.SubAccountID).
+				With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Queued and added to cache")
+			return
+		}
+
+		// Cluster is trackable and exists in the cache
+		if record, ok := recordObj.(kmccache.Record); ok {
+			if record.ShootName != runtime.ShootName {
+				// The shootname has changed hence the record in the cache is not valid anymore
+				// No need to queue as the subAccountID already exists in queue
+				p.Cache.Set(runtime.SubAccountID, newRecord, cache.NoExpiration)
+				p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).With(log.KeyRuntimeID, runtime.RuntimeID).
+					Debug("Resetted the values in cache for subAccount")
+			}
+		}
+		return
+	}
+	if isFoundInCache {
+		// Cluster is not trackable but is found in cache should be deleted
+		p.Cache.Delete(runtime.SubAccountID)
+		p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).
+			With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Deleted subAccount from cache")
+		return
+	}
+	p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).
+		With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Ignoring SubAccount as it is not trackable")
+}
+
 func (p *Process) namedLogger() *zap.SugaredLogger {
 	return p.Logger.With("component", "kmc")
 }
@@ -365,6 +365,71 @@ func TestPopulateCacheAndQueue(t *testing.T) {
 	})
 }
 
+func TestApplyRuntimeChanges(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	t.Run("upserted runtimes are added to cache and queue and removed ones are deleted", func(t *testing.T) {
+		newSubAccID := uuid.New().String()
+		removedSubAccID := uuid.New().String()
+		newShootName := fmt.Sprintf("shoot-%s", kmctesting.GenerateRandomAlphaString(5))
+		removedShootName := fmt.Sprintf("shoot-%s", kmctesting.GenerateRandomAlphaString(5))
+		cache := gocache.New(gocache.NoExpiration, gocache.NoExpiration)
+		queue := workqueue.NewDelayingQueue()
+		p := Process{
+			Queue:  queue,
+			Cache:  cache,
+			Logger: logger.NewLogger(zapcore.InfoLevel),
+		}
+		removedRecord := NewRecord(removedSubAccID, removedShootName, "foo")
+		removedRecord.RuntimeID = "removed-runtime"
+		err := p.Cache.Add(removedSubAccID, removedRecord, gocache.NoExpiration)
+		g.Expect(err).Should(gomega.BeNil())
+
+		newRuntime := kmctesting.NewRuntimesDTO(newSubAccID, newShootName, kmctesting.WithSucceededState)
+		changes := &kmckeb.RuntimeChangesPage{
+			Data: []kmckeb.RuntimeChange{
+				{Type: kmckeb.ChangeUpserted, SubAccountID: newSubAccID, Runtime: &newRuntime},
+				{Type: kmckeb.ChangeRemoved, SubAccountID: removedSubAccID, RuntimeID: "removed-runtime"},
+			},
+		}
+
+		expectedQueue := workqueue.NewDelayingQueue()
+		expectedQueue.Add(newSubAccID)
+		expectedCache := gocache.New(gocache.NoExpiration, gocache.NoExpiration)
+		err = expectedCache.Add(newSubAccID, NewRecord(newSubAccID, newShootName, ""), gocache.NoExpiration)
+		g.Expect(err).Should(gomega.BeNil())
+
+		p.applyRuntimeChanges(changes)
+		g.Expect(*p.Cache).To(gomega.Equal(*expectedCache))
+		g.Expect(areQueuesEqual(p.Queue, expectedQueue)).To(gomega.BeTrue())
+	})
+
+	t.Run("removed runtime does not delete a newer runtime of the same subaccount", func(t *testing.T) {
+		subAccID := uuid.New().String()
+		shootName := fmt.Sprintf("shoot-%s", kmctesting.GenerateRandomAlphaString(5))
+		cache := gocache.New(gocache.NoExpiration, gocache.NoExpiration)
+		p := Process{
+			Queue:  workqueue.NewDelayingQueue(),
+			Cache:  cache,
+			Logger: logger.NewLogger(zapcore.InfoLevel),
+		}
+		record := NewRecord(subAccID, shootName, "foo")
+		record.RuntimeID = "new-runtime"
+		err := p.Cache.Add(subAccID, record, gocache.NoExpiration)
+		g.Expect(err).Should(gomega.BeNil())
+
+		p.applyRuntimeChanges(&kmckeb.RuntimeChangesPage{
+			Data: []kmckeb.RuntimeChange{
+				{Type: kmckeb.ChangeRemoved, SubAccountID: subAccID, RuntimeID: "old-runtime"},
+			},
+		})
+
+		gotRecord, found := p.Cache.Get(subAccID)
+		g.Expect(found).To(gomega.BeTrue())
+		g.Expect(gotRecord).To(gomega.Equal(record))
+	})
+}
+
 func TestExecute(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	subAccID := uuid.New().String()
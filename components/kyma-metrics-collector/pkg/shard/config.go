@@ -0,0 +1,39 @@
+package shard
+
+import (
+	"fmt"
+	"time"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"KMC_SHARDING_ENABLED" default:"false"`
+	// Identity is the unique name of the replica, which is used as the name of its shard Lease
+	Identity  string `envconfig:"KMC_SHARDING_IDENTITY"`
+	Namespace string `envconfig:"KMC_SHARDING_NAMESPACE" default:"kcp-system"`
+	// LeaseDuration is the time after which a replica is considered gone when it does not renew its Lease
+	LeaseDuration time.Duration `envconfig:"KMC_SHARDING_LEASE_DURATION" default:"30s"`
+	RenewInterval time.Duration `envconfig:"KMC_SHARDING_RENEW_INTERVAL" default:"10s"`
+	// SettleDuration is the time a new replica waits before it takes over subAccounts,
+	// so that all other replicas have seen its Lease. It must be longer than the renew interval.
+	SettleDuration time.Duration `envconfig:"KMC_SHARDING_SETTLE_DURATION" default:"30s"`
+	VirtualNodes   int           `envconfig:"KMC_SHARDING_VIRTUAL_NODES" default:"100"`
+}
+
+func (c Config) Validate() error {
+	if c.Identity == "" {
+		return fmt.Errorf("sharding identity must not be empty")
+	}
+	if c.RenewInterval <= 0 {
+		return fmt.Errorf("renew interval must be positive")
+	}
+	if c.LeaseDuration <= c.RenewInterval {
+		return fmt.Errorf("lease duration %v must be longer than renew interval %v", c.LeaseDuration, c.RenewInterval)
+	}
+	if c.SettleDuration <= c.RenewInterval {
+		return fmt.Errorf("settle duration %v must be longer than renew interval %v", c.SettleDuration, c.RenewInterval)
+	}
+	if c.VirtualNodes <= 0 {
+		return fmt.Errorf("number of virtual nodes must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package shard
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	activeMembers = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "kmc",
+			Subsystem: "shard",
+			Name:      "active_members",
+			Help:      "Number of KMC replicas sharing the subAccounts in the current scrape interval.",
+		},
+	)
+)
@@ -0,0 +1,52 @@
+package shard
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// Ring is a consistent hash ring which assigns keys to members.
+// Every member is placed on the ring several times (virtual nodes) to spread the keys evenly.
+type Ring struct {
+	hashes []uint64
+	owners map[uint64]string
+}
+
+func NewRing(members []string, virtualNodes int) *Ring {
+	ring := &Ring{
+		owners: make(map[uint64]string, len(members)*virtualNodes),
+	}
+	for _, member := range members {
+		for i := 0; i < virtualNodes; i++ {
+			h := hashKey(fmt.Sprintf("%s#%d", member, i))
+			ring.hashes = append(ring.hashes, h)
+			ring.owners[h] = member
+		}
+	}
+	sort.Slice(ring.hashes, func(i, j int) bool {
+		return ring.hashes[i] < ring.hashes[j]
+	})
+	return ring
+}
+
+// Owner returns the member responsible for the given key or an empty string if the ring has no members
+func (r *Ring) Owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+	h := hashKey(key)
+	idx := sort.Search(len(r.hashes), func(i int) bool {
+		return r.hashes[i] >= h
+	})
+	if idx == len(r.hashes) {
+		idx = 0
+	}
+	return r.owners[r.hashes[idx]]
+}
+
+func hashKey(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,44 @@
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestRing(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	t.Run("empty ring has no owner", func(t *testing.T) {
+		ring := NewRing(nil, 10)
+		g.Expect(ring.Owner("foo")).To(gomega.BeEmpty())
+	})
+
+	t.Run("keys are spread between all members", func(t *testing.T) {
+		members := []string{"kmc-0", "kmc-1", "kmc-2"}
+		ring := NewRing(members, 100)
+
+		owned := make(map[string]int)
+		for i := 0; i < 3000; i++ {
+			owned[ring.Owner(fmt.Sprintf("subaccount-%d", i))]++
+		}
+		g.Expect(owned).To(gomega.HaveLen(len(members)))
+		for _, member := range members {
+			g.Expect(owned[member]).To(gomega.BeNumerically(">", 500))
+		}
+	})
+
+	t.Run("only keys of the removed member are moved", func(t *testing.T) {
+		ring := NewRing([]string{"kmc-0", "kmc-1", "kmc-2"}, 100)
+		shrunkRing := NewRing([]string{"kmc-0", "kmc-1"}, 100)
+
+		for i := 0; i < 1000; i++ {
+			key := fmt.Sprintf("subaccount-%d", i)
+			owner := ring.Owner(key)
+			if owner != "kmc-2" {
+				g.Expect(shrunkRing.Owner(key)).To(gomega.Equal(owner))
+			}
+		}
+	})
+}
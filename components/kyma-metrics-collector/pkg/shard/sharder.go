@@ -0,0 +1,269 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1"
+
+	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const (
+	leaseNamePrefix = "kmc-shard-"
+	// LeaseLabel marks the Leases of all KMC replicas
+	LeaseLabel = "kyma-project.io/kmc-shard"
+)
+
+type member struct {
+	identity    string
+	acquireTime time.Time
+	renewTime   time.Time
+	duration    time.Duration
+	// releasedAt is the time when the Lease of the member was noticed to be gone
+	releasedAt time.Time
+}
+
+// activeAt returns true if the member is settled and its Lease is not expired at the given time
+func (m member) activeAt(t time.Time, settle time.Duration) bool {
+	return !m.acquireTime.Add(settle).After(t) && m.renewTime.Add(m.duration).After(t)
+}
+
+// Sharder splits subAccounts between KMC replicas. Every replica renews its own Lease and builds a consistent hash
+// ring from the Leases of all replicas. The membership is evaluated at the start of every scrape interval and the ring
+// is kept for the whole interval, so that all replicas agree on the owner of a subAccount for the whole interval and
+// the metrics are sent only once per interval. A replica which releases its Lease keeps its subAccounts until the
+// end of the interval.
+type Sharder struct {
+	config   *Config
+	leases   coordinationclient.LeaseInterface
+	interval time.Duration
+	logger   *zap.SugaredLogger
+	now      func() time.Time
+
+	mu       sync.Mutex
+	members  []member
+	ring     *Ring
+	ringKey  string
+	ringSlot int64
+	claimed  map[string]int64
+	lastSlot int64
+}
+
+func NewSharder(config *Config, leases coordinationclient.LeaseInterface, interval time.Duration, logger *zap.SugaredLogger) *Sharder {
+	return &Sharder{
+		config:   config,
+		leases:   leases,
+		interval: interval,
+		logger:   logger,
+		now:      time.Now,
+		claimed:  make(map[string]int64),
+	}
+}
+
+// Run keeps the Lease of the replica renewed and refreshes the known replicas until the context is done.
+// The Lease is deleted at the end, so that the other replicas take over its subAccounts in the next interval.
+func (s *Sharder) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.RenewInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sync(ctx); err != nil {
+			s.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Error("sync shard members")
+		}
+		select {
+		case <-ctx.Done():
+			s.release()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync renews the Lease of the replica and reads the Leases of all replicas
+func (s *Sharder) Sync(ctx context.Context) error {
+	if err := s.renew(ctx); err != nil {
+		return fmt.Errorf("while renewing lease: %w", err)
+	}
+
+	leaseList, err := s.leases.List(ctx, metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=true", LeaseLabel)})
+	if err != nil {
+		return fmt.Errorf("while listing leases: %w", err)
+	}
+	members := make([]member, 0, len(leaseList.Items))
+	current := make(map[string]bool, len(leaseList.Items))
+	for _, lease := range leaseList.Items {
+		m, ok := toMember(lease)
+		if !ok {
+			s.namedLogger().Warnf("skipping incomplete shard lease %s", lease.Name)
+			continue
+		}
+		members = append(members, m)
+		current[m.identity] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// keep the members whose Leases are gone until the end of the interval, they may have already sent their subAccounts
+	now := s.now()
+	for _, m := range s.members {
+		if current[m.identity] {
+			continue
+		}
+		if m.releasedAt.IsZero() {
+			m.releasedAt = now
+		}
+		if s.slot(m.releasedAt) == s.slot(now) {
+			members = append(members, m)
+		}
+	}
+	s.members = members
+	return nil
+}
+
+// Claim returns true if the replica is responsible for the subAccount in the current interval and the subAccount
+// has not been claimed in this interval yet
+func (s *Sharder) Claim(subAccountID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slot := s.slot(s.now())
+	if slot != s.lastSlot {
+		// forget claims from the previous intervals
+		for key, claimedSlot := range s.claimed {
+			if claimedSlot < slot {
+				delete(s.claimed, key)
+			}
+		}
+		s.lastSlot = slot
+	}
+
+	if s.ownerAt(subAccountID, slot) != s.config.Identity {
+		return false
+	}
+	if s.claimed[subAccountID] == slot {
+		return false
+	}
+	s.claimed[subAccountID] = slot
+	return true
+}
+
+// UntilNextInterval returns the time left until the next scrape interval begins
+func (s *Sharder) UntilNextInterval() time.Duration {
+	now := s.now()
+	return s.slotStart(s.slot(now) + 1).Sub(now)
+}
+
+// ownerAt returns the owner of the key in the given interval. The ring is built from the members active at the start
+// of the interval and is not changed until the next interval, even if the members change in the meantime.
+func (s *Sharder) ownerAt(key string, slot int64) string {
+	if s.ring != nil && s.ringSlot == slot {
+		return s.ring.Owner(key)
+	}
+
+	slotStart := s.slotStart(slot)
+	active := make([]string, 0, len(s.members))
+	for _, m := range s.members {
+		if m.activeAt(slotStart, s.config.SettleDuration) {
+			active = append(active, m.identity)
+		}
+	}
+	sort.Strings(active)
+	activeMembers.Set(float64(len(active)))
+
+	ringKey := strings.Join(active, ",")
+	if s.ring == nil || ringKey != s.ringKey {
+		s.namedLogger().Infof("rebalancing subAccounts between %d replicas: %s", len(active), ringKey)
+		s.ring = NewRing(active, s.config.VirtualNodes)
+		s.ringKey = ringKey
+	}
+	s.ringSlot = slot
+	return s.ring.Owner(key)
+}
+
+func (s *Sharder) slot(t time.Time) int64 {
+	return t.UnixNano() / int64(s.interval)
+}
+
+func (s *Sharder) slotStart(slot int64) time.Time {
+	return time.Unix(0, slot*int64(s.interval))
+}
+
+func (s *Sharder) renew(ctx context.Context) error {
+	now := metav1.NewMicroTime(s.now())
+	lease, err := s.leases.Get(ctx, s.leaseName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = s.leases.Create(ctx, s.newLease(now), metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if m, ok := toMember(*lease); !ok || !m.renewTime.Add(m.duration).After(now.Time) {
+		// the lease expired, hence the replica must settle again before taking over any subAccounts
+		s.namedLogger().Info("shard lease expired, acquiring it again")
+		lease.Spec = s.newLease(now).Spec
+	}
+	lease.Spec.RenewTime = &now
+	_, err = s.leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+func (s *Sharder) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.RenewInterval)
+	defer cancel()
+	err := s.leases.Delete(ctx, s.leaseName(), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		s.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Error("delete shard lease")
+	}
+}
+
+func (s *Sharder) newLease(now metav1.MicroTime) *coordinationv1.Lease {
+	identity := s.config.Identity
+	duration := int32(s.config.LeaseDuration.Seconds())
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.leaseName(),
+			Namespace: s.config.Namespace,
+			Labels: map[string]string{
+				LeaseLabel: "true",
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &identity,
+			LeaseDurationSeconds: &duration,
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	}
+}
+
+func (s *Sharder) leaseName() string {
+	return leaseNamePrefix + s.config.Identity
+}
+
+func (s *Sharder) namedLogger() *zap.SugaredLogger {
+	return s.logger.With("component", "sharder")
+}
+
+func toMember(lease coordinationv1.Lease) (member, bool) {
+	spec := lease.Spec
+	if spec.HolderIdentity == nil || spec.LeaseDurationSeconds == nil || spec.AcquireTime == nil || spec.RenewTime == nil {
+		return member{}, false
+	}
+	return member{
+		identity:    *spec.HolderIdentity,
+		acquireTime: spec.AcquireTime.Time,
+		renewTime:   spec.RenewTime.Time,
+		duration:    time.Duration(*spec.LeaseDurationSeconds) * time.Second,
+	}, true
+}
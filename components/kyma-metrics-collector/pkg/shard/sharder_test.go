@@ -0,0 +1,170 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"go.uber.org/zap/zapcore"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	coordinationfake "k8s.io/client-go/kubernetes/typed/coordination/v1/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const (
+	testNamespace = "kcp-system"
+	testInterval  = 3 * time.Minute
+)
+
+func TestSharder(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.Background()
+	firstSlotStart := time.Unix(0, 0).Add(100 * testInterval)
+
+	t.Run("every subAccount is claimed by exactly one replica once per interval", func(t *testing.T) {
+		clientset := newFakeCoordinationClient()
+		sharders := newTestSharders(clientset, firstSlotStart, "kmc-0", "kmc-1", "kmc-2")
+		renewUntil(g, sharders, firstSlotStart, firstSlotStart.Add(time.Second))
+
+		// the replicas are not settled yet
+		for _, s := range sharders {
+			g.Expect(s.Claim("subaccount")).To(gomega.BeFalse())
+		}
+
+		renewUntil(g, sharders, firstSlotStart, firstSlotStart.Add(testInterval))
+		claimedBy := make(map[string]int)
+		for i := 0; i < 300; i++ {
+			key := fmt.Sprintf("subaccount-%d", i)
+			claims := 0
+			for _, s := range sharders {
+				if s.Claim(key) {
+					claims++
+					claimedBy[s.config.Identity]++
+				}
+				// claiming twice in the same interval is not possible
+				g.Expect(s.Claim(key)).To(gomega.BeFalse())
+			}
+			g.Expect(claims).To(gomega.Equal(1))
+		}
+		g.Expect(claimedBy).To(gomega.HaveLen(3))
+
+		// in the next interval the subAccount can be claimed again
+		renewUntil(g, sharders, firstSlotStart.Add(testInterval), firstSlotStart.Add(2*testInterval))
+		claims := 0
+		for _, s := range sharders {
+			if s.Claim("subaccount-0") {
+				claims++
+			}
+		}
+		g.Expect(claims).To(gomega.Equal(1))
+	})
+
+	t.Run("subAccounts are rebalanced in the next interval when a replica leaves", func(t *testing.T) {
+		clientset := newFakeCoordinationClient()
+		sharders := newTestSharders(clientset, firstSlotStart, "kmc-0", "kmc-1")
+		renewUntil(g, sharders, firstSlotStart, firstSlotStart.Add(testInterval))
+
+		key := ""
+		for i := 0; key == ""; i++ {
+			candidate := fmt.Sprintf("subaccount-%d", i)
+			if sharders[1].Claim(candidate) {
+				key = candidate
+			}
+		}
+
+		// kmc-1 stops renewing its lease
+		renewUntil(g, sharders[:1], firstSlotStart.Add(testInterval), firstSlotStart.Add(testInterval+time.Minute))
+		// the lease of kmc-1 was still valid at the beginning of the interval
+		g.Expect(sharders[0].Claim(key)).To(gomega.BeFalse())
+
+		renewUntil(g, sharders[:1], firstSlotStart.Add(testInterval+time.Minute), firstSlotStart.Add(2*testInterval))
+		g.Expect(sharders[0].Claim(key)).To(gomega.BeTrue())
+	})
+
+	t.Run("subAccounts are not rebalanced in the same interval when a replica releases its lease", func(t *testing.T) {
+		clientset := newFakeCoordinationClient()
+		sharders := newTestSharders(clientset, firstSlotStart, "kmc-0", "kmc-1")
+		renewUntil(g, sharders, firstSlotStart, firstSlotStart.Add(testInterval))
+
+		// kmc-1 claims a subAccount and releases its lease
+		key := ""
+		for i := 0; key == ""; i++ {
+			candidate := fmt.Sprintf("subaccount-%d", i)
+			if sharders[1].Claim(candidate) {
+				key = candidate
+			}
+		}
+		sharders[1].release()
+
+		// the subAccount was already sent in this interval
+		renewUntil(g, sharders[:1], firstSlotStart.Add(testInterval), firstSlotStart.Add(testInterval+time.Minute))
+		g.Expect(sharders[0].Claim(key)).To(gomega.BeFalse())
+
+		renewUntil(g, sharders[:1], firstSlotStart.Add(testInterval+time.Minute), firstSlotStart.Add(2*testInterval))
+		g.Expect(sharders[0].Claim(key)).To(gomega.BeTrue())
+	})
+
+	t.Run("lease is released", func(t *testing.T) {
+		clientset := newFakeCoordinationClient()
+		sharders := newTestSharders(clientset, time.Now(), "kmc-0")
+		g.Expect(sharders[0].Sync(ctx)).Should(gomega.Succeed())
+
+		sharders[0].release()
+
+		leases, err := clientset.Leases(testNamespace).List(ctx, metav1.ListOptions{})
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(leases.Items).To(gomega.BeEmpty())
+	})
+}
+
+func TestUntilNextInterval(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	sharders := newTestSharders(newFakeCoordinationClient(), time.Unix(0, 0).Add(10*testInterval+time.Minute), "kmc-0")
+
+	g.Expect(sharders[0].UntilNextInterval()).To(gomega.Equal(testInterval - time.Minute))
+}
+
+func newTestSharders(clientset coordinationclient.CoordinationV1Interface, now time.Time, identities ...string) []*Sharder {
+	var sharders []*Sharder
+	for _, identity := range identities {
+		config := &Config{
+			Identity:       identity,
+			Namespace:      testNamespace,
+			LeaseDuration:  30 * time.Second,
+			RenewInterval:  10 * time.Second,
+			SettleDuration: 30 * time.Second,
+			VirtualNodes:   100,
+		}
+		s := NewSharder(config, clientset.Leases(testNamespace), testInterval, logger.NewLogger(zapcore.InfoLevel))
+		s.now = func() time.Time { return now }
+		sharders = append(sharders, s)
+	}
+	return sharders
+}
+
+func newFakeCoordinationClient() coordinationclient.CoordinationV1Interface {
+	tracker := k8stesting.NewObjectTracker(scheme.Scheme, scheme.Codecs.UniversalDecoder())
+	fakeClient := &k8stesting.Fake{}
+	fakeClient.AddReactor("*", "*", k8stesting.ObjectReaction(tracker))
+	return &coordinationfake.FakeCoordinationV1{Fake: fakeClient}
+}
+
+// renewUntil simulates the replicas renewing their leases every renew interval from the given time until the end time
+func renewUntil(g *gomega.WithT, sharders []*Sharder, from, to time.Time) {
+	for now := from; !now.After(to); now = now.Add(sharders[0].config.RenewInterval) {
+		for _, s := range sharders {
+			current := now
+			s.now = func() time.Time { return current }
+			g.Expect(s.Sync(context.Background())).Should(gomega.Succeed())
+		}
+	}
+	for _, s := range sharders {
+		s.now = func() time.Time { return to }
+	}
+}
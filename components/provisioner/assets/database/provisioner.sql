@@ -46,6 +46,12 @@ CREATE TABLE gardener_config
     shoot_networking_filter_disabled boolean,
     control_plane_failure_tolerance varchar(256),
     eu_access boolean NOT NULL,
+    worker_pools jsonb,
+    pods_cidr varchar(256),
+    services_cidr varchar(256),
+    nodes_cidr varchar(256),
+    maintenance_window_begin varchar(256),
+    maintenance_window_end varchar(256),
     UNIQUE(cluster_id),
     foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
 );
@@ -66,7 +72,8 @@ CREATE TYPE operation_type AS ENUM (
     'UPGRADE_SHOOT',
     'HIBERNATE',
     'PROVISION_NO_INSTALL',
-    'DEPROVISION_NO_INSTALL'
+    'DEPROVISION_NO_INSTALL',
+    'WAKE_UP'
     );
 
 CREATE TABLE operation
@@ -83,7 +90,8 @@ CREATE TABLE operation
     last_transition timestamp without time zone,
     err_message text NOT NULL,
     reason text NOT NULL,
-    component text NOT NULL
+    component text NOT NULL,
+    rollback_operation_id uuid
 );
 
 -- Kyma Release
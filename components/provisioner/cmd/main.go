@@ -31,6 +31,9 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/installation/release"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/notification"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 	provisioningStages "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
@@ -75,15 +78,20 @@ type config struct {
 
 	OperatorRoleBinding provisioningStages.OperatorRoleBinding
 
+	StagePoliciesConfigPath string `envconfig:"optional"`
+
+	Authentication middlewares.AuthenticationConfig
+
 	Gardener struct {
-		Project                                    string `envconfig:"default=gardenerProject"`
-		KubeconfigPath                             string `envconfig:"default=./dev/kubeconfig.yaml"`
-		AuditLogsPolicyConfigMap                   string `envconfig:"optional"`
-		AuditLogsTenantConfigPath                  string `envconfig:"optional"`
-		MaintenanceWindowConfigPath                string `envconfig:"optional"`
-		ClusterCleanupResourceSelector             string `envconfig:"default=https://service-manager."`
-		DefaultEnableKubernetesVersionAutoUpdate   bool   `envconfig:"default=false"`
-		DefaultEnableMachineImageVersionAutoUpdate bool   `envconfig:"default=false"`
+		Project                                    string   `envconfig:"default=gardenerProject"`
+		KubeconfigPath                             string   `envconfig:"default=./dev/kubeconfig.yaml"`
+		AuditLogsPolicyConfigMap                   string   `envconfig:"optional"`
+		AuditLogsTenantConfigPath                  string   `envconfig:"optional"`
+		MaintenanceWindowConfigPath                string   `envconfig:"optional"`
+		ClusterCleanupResourceSelector             string   `envconfig:"default=https://service-manager."`
+		DefaultEnableKubernetesVersionAutoUpdate   bool     `envconfig:"default=false"`
+		DefaultEnableMachineImageVersionAutoUpdate bool     `envconfig:"default=false"`
+		DriftAutoRevertFields                      []string `envconfig:"optional"`
 	}
 
 	LatestDownloadedReleases int  `envconfig:"default=5"`
@@ -113,8 +121,9 @@ func (c *config) String() string {
 		"ShootUpgradeTimeout: %s, "+
 		"OperatorRoleBindingL2SubjectName: %s, OperatorRoleBindingL3SubjectName: %s, OperatorRoleBindingCreatingForAdmin: %t"+
 		"GardenerProject: %s, GardenerKubeconfigPath: %s, GardenerAuditLogsPolicyConfigMap: %s, AuditLogsTenantConfigPath: %s, "+
+		"GardenerDriftAutoRevertFields: %v, "+
 		"LatestDownloadedReleases: %d, DownloadPreReleases: %v, "+
-		"EnqueueInProgressOperations: %v"+
+		"EnqueueInProgressOperations: %v, StagePoliciesConfigPath: %s, AuthenticationEnabled: %v, "+
 		"LogLevel: %s"+
 		"RunAwsConfigMigration: %v",
 		c.Address, c.APIEndpoint, c.DirectorURL,
@@ -130,8 +139,9 @@ func (c *config) String() string {
 		c.ProvisioningTimeout.ShootUpgrade.String(),
 		c.OperatorRoleBinding.L2SubjectName, c.OperatorRoleBinding.L3SubjectName, c.OperatorRoleBinding.CreatingForAdmin,
 		c.Gardener.Project, c.Gardener.KubeconfigPath, c.Gardener.AuditLogsPolicyConfigMap, c.Gardener.AuditLogsTenantConfigPath,
+		c.Gardener.DriftAutoRevertFields,
 		c.LatestDownloadedReleases, c.DownloadPreReleases,
-		c.EnqueueInProgressOperations,
+		c.EnqueueInProgressOperations, c.StagePoliciesConfigPath, c.Authentication.Enabled,
 		c.LogLevel, c.RunAwsConfigMigration)
 }
 
@@ -205,6 +215,17 @@ func main() {
 
 	runtimeConfigurator := runtime.NewRuntimeConfigurator(k8sClientProvider, directorClient)
 
+	statusNotifier := notification.NewBroadcaster()
+
+	stagePolicies, err := operations.LoadStagePolicies(cfg.StagePoliciesConfigPath)
+	exitOnError(err, "Failed to load operation stage policies")
+
+	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath)
+
+	deprovisioningNoInstallQueue := queue.CreateDeprovisioningNoInstallQueue(cfg.DeprovisioningNoInstallTimeout, dbsFactory, directorClient, shootClient, statusNotifier, stagePolicies)
+
+	provisioningFailureHandler := failure.NewProvisioningFailureHandler(dbsFactory, provisioner, deprovisioningNoInstallQueue, uuid.NewUUIDGenerator())
+
 	provisioningQueue := queue.CreateProvisioningQueue(
 		cfg.ProvisioningTimeout,
 		dbsFactory,
@@ -215,7 +236,10 @@ func main() {
 		shootClient,
 		secretsInterface,
 		cfg.OperatorRoleBinding,
-		k8sClientProvider)
+		k8sClientProvider,
+		statusNotifier,
+		provisioningFailureHandler,
+		stagePolicies)
 
 	provisioningNoInstallQueue := queue.CreateProvisioningNoInstallQueue(
 		cfg.ProvisioningNoInstallTimeout,
@@ -225,20 +249,26 @@ func main() {
 		secretsInterface,
 		cfg.OperatorRoleBinding,
 		k8sClientProvider,
-		runtimeConfigurator)
+		runtimeConfigurator,
+		statusNotifier,
+		provisioningFailureHandler,
+		stagePolicies)
 
-	upgradeQueue := queue.CreateUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, installationService)
+	upgradeQueue := queue.CreateUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, installationService, statusNotifier, stagePolicies)
 
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, installationService, directorClient, shootClient, 5*time.Minute)
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, installationService, directorClient, shootClient, 5*time.Minute, statusNotifier, stagePolicies)
 
-	deprovisioningNoInstallQueue := queue.CreateDeprovisioningNoInstallQueue(cfg.DeprovisioningNoInstallTimeout, dbsFactory, directorClient, shootClient)
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, secretsInterface, statusNotifier, stagePolicies)
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, secretsInterface)
+	hibernationQueue := queue.CreateHibernationQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient, statusNotifier, stagePolicies)
 
-	hibernationQueue := queue.CreateHibernationQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient)
+	wakeUpQueue := queue.CreateWakeUpQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient, statusNotifier, stagePolicies)
 
-	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath)
-	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath)
+	driftAutoRevertFields, err := model.ParseRevertibleDriftFields(cfg.Gardener.DriftAutoRevertFields)
+	exitOnError(err, "Failed to parse shoot drift auto revert fields")
+
+	shootDriftCollector := metrics.NewShootDriftCollector()
+	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath, shootDriftCollector, driftAutoRevertFields)
 	exitOnError(err, "Failed to create Shoot controller.")
 	go func() {
 		err := shootController.StartShootController()
@@ -268,12 +298,13 @@ func main() {
 		upgradeQueue,
 		shootUpgradeQueue,
 		hibernationQueue,
+		wakeUpQueue,
 		cfg.Gardener.DefaultEnableKubernetesVersionAutoUpdate,
 		cfg.Gardener.DefaultEnableMachineImageVersionAutoUpdate)
 
 	tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
 	validator := api.NewValidator()
-	resolver := api.NewResolver(provisioningSVC, validator, tenantUpdater)
+	resolver := api.NewResolver(provisioningSVC, validator, tenantUpdater, statusNotifier, api.NewAuthorizer(cfg.Authentication.Enabled))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -292,6 +323,8 @@ func main() {
 
 	hibernationQueue.Run(ctx.Done())
 
+	wakeUpQueue.Run(ctx.Done())
+
 	gqlCfg := gqlschema.Config{
 		Resolvers: resolver,
 	}
@@ -308,13 +341,22 @@ func main() {
 	gqlHandler := handler.New(executableSchema)
 	gqlHandler.AddTransport(transport.POST{})
 	gqlHandler.AddTransport(transport.GET{})
+	gqlHandler.AddTransport(transport.Websocket{KeepAlivePingInterval: 10 * time.Second})
 	gqlHandler.Use(extension.Introspection{})
 	gqlHandler.SetErrorPresenter(presenter.Do)
-	router.Handle(cfg.APIEndpoint, gqlHandler)
+	gqlHandler.AroundFields(api.NewAuditLog(log.WithField("Component", "AuditLog")).AroundMutations)
+
+	if cfg.Authentication.Enabled {
+		authenticator, err := middlewares.NewAuthenticator(cfg.Authentication)
+		exitOnError(err, "Failed to initialize authenticator")
+		router.Handle(cfg.APIEndpoint, authenticator.Authenticate(gqlHandler))
+	} else {
+		router.Handle(cfg.APIEndpoint, gqlHandler)
+	}
 	router.HandleFunc("/healthz", healthz.NewHTTPHandler(log.StandardLogger()))
 
 	// Metrics
-	err = metrics.Register(dbsFactory.NewReadSession())
+	err = metrics.Register(dbsFactory.NewReadSession(), shootDriftCollector)
 	exitOnError(err, "Failed to register metrics collectors")
 
 	// Expose metrics on different port as it cannot be secured with mTLS
@@ -347,14 +389,14 @@ func main() {
 	}()
 
 	if cfg.EnqueueInProgressOperations {
-		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue)
+		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 		exitOnError(err, "Failed to enqueue in progress operations")
 	}
 
 	wg.Wait()
 }
 
-func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue queue.OperationQueue) error {
+func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue queue.OperationQueue) error {
 	readSession := dbFactory.NewReadSession()
 
 	var inProgressOps []model.Operation
@@ -388,6 +430,8 @@ func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue,
 			upgradeQueue.Add(op.ID)
 		case model.Hibernate:
 			hibernationQueue.Add(op.ID)
+		case model.WakeUp:
+			wakeUpQueue.Add(op.ID)
 		case model.UpgradeShoot:
 			shootUpgradeQueue.Add(op.ID)
 		}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/sirupsen/logrus"
+)
+
+const mutationObject = "Mutation"
+
+// AuditLog writes an audit log entry with the caller identity for every executed mutation
+type AuditLog struct {
+	log logrus.FieldLogger
+}
+
+func NewAuditLog(log logrus.FieldLogger) *AuditLog {
+	return &AuditLog{log: log}
+}
+
+// AroundMutations is the field middleware logging the result of the mutations
+func (a *AuditLog) AroundMutations(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fieldContext := graphql.GetFieldContext(ctx)
+	if fieldContext == nil || fieldContext.Object != mutationObject {
+		return next(ctx)
+	}
+
+	result, err := next(ctx)
+
+	entry := a.log.WithFields(logrus.Fields{
+		"mutation": fieldContext.Field.Name,
+		"tenant":   ctx.Value(middlewares.Tenant),
+	})
+	if caller, ok := middlewares.CallerFromContext(ctx); ok {
+		entry = entry.WithFields(logrus.Fields{"caller": caller.Identity, "role": caller.Role})
+	}
+	// only identifiers are logged, inputs can contain credentials and secret configuration
+	for name, value := range fieldContext.Args {
+		if id, ok := value.(string); ok {
+			entry = entry.WithField(name, id)
+		}
+	}
+
+	if err != nil {
+		entry.WithField("error", err.Error()).Info("Mutation failed")
+	} else {
+		entry.Info("Mutation succeeded")
+	}
+
+	return result, err
+}
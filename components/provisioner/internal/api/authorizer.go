@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+)
+
+type Action string
+
+const (
+	ActionProvisionRuntime         Action = "provisionRuntime"
+	ActionUpgradeRuntime           Action = "upgradeRuntime"
+	ActionDeprovisionRuntime       Action = "deprovisionRuntime"
+	ActionUpgradeShoot             Action = "upgradeShoot"
+	ActionHibernateRuntime         Action = "hibernateRuntime"
+	ActionWakeUpRuntime            Action = "wakeUpRuntime"
+	ActionRollBackUpgradeOperation Action = "rollBackUpgradeOperation"
+	ActionReconnectRuntimeAgent    Action = "reconnectRuntimeAgent"
+	ActionRead                     Action = "read"
+)
+
+// requiredRoles defines the lowest role allowed to execute the action
+var requiredRoles = map[Action]middlewares.Role{
+	ActionRead:                     middlewares.RoleReadOnly,
+	ActionProvisionRuntime:         middlewares.RoleOperator,
+	ActionUpgradeRuntime:           middlewares.RoleOperator,
+	ActionUpgradeShoot:             middlewares.RoleOperator,
+	ActionHibernateRuntime:         middlewares.RoleOperator,
+	ActionWakeUpRuntime:            middlewares.RoleOperator,
+	ActionRollBackUpgradeOperation: middlewares.RoleOperator,
+	ActionReconnectRuntimeAgent:    middlewares.RoleOperator,
+	ActionDeprovisionRuntime:       middlewares.RoleAdmin,
+}
+
+//go:generate mockery -name=Authorizer
+type Authorizer interface {
+	Authorize(ctx context.Context, action Action) apperrors.AppError
+}
+
+type authorizer struct {
+	enabled bool
+}
+
+// NewAuthorizer creates the authorizer checking the role of the caller, when disabled all requests are allowed
+func NewAuthorizer(enabled bool) Authorizer {
+	return &authorizer{enabled: enabled}
+}
+
+func (a *authorizer) Authorize(ctx context.Context, action Action) apperrors.AppError {
+	if !a.enabled {
+		return nil
+	}
+
+	caller, ok := middlewares.CallerFromContext(ctx)
+	if !ok {
+		return apperrors.Forbidden("caller is not authenticated")
+	}
+
+	required, found := requiredRoles[action]
+	if !found {
+		return apperrors.Forbidden("unknown action %s", action)
+	}
+	if !caller.Role.Includes(required) {
+		return apperrors.Forbidden("caller %s with role %s is not allowed to %s, %s role is required", caller.Identity, caller.Role, action, required)
+	}
+
+	if caller.Tenant != "" {
+		tenant, _ := ctx.Value(middlewares.Tenant).(string)
+		if tenant != caller.Tenant {
+			return apperrors.Forbidden("caller %s is not allowed to access tenant %s", caller.Identity, tenant)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	withCaller := func(caller middlewares.Caller, tenant string) context.Context {
+		ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+		return context.WithValue(ctx, middlewares.CallerKey, caller)
+	}
+
+	for _, testCase := range []struct {
+		description string
+		ctx         context.Context
+		action      Action
+		allowed     bool
+	}{
+		{
+			description: "should allow read-only caller to read",
+			ctx:         withCaller(middlewares.Caller{Identity: "viewer", Role: middlewares.RoleReadOnly}, "tenant"),
+			action:      ActionRead,
+			allowed:     true,
+		},
+		{
+			description: "should not allow read-only caller to provision",
+			ctx:         withCaller(middlewares.Caller{Identity: "viewer", Role: middlewares.RoleReadOnly}, "tenant"),
+			action:      ActionProvisionRuntime,
+		},
+		{
+			description: "should allow operator to hibernate",
+			ctx:         withCaller(middlewares.Caller{Identity: "operator", Role: middlewares.RoleOperator}, "tenant"),
+			action:      ActionHibernateRuntime,
+			allowed:     true,
+		},
+		{
+			description: "should not allow operator to deprovision",
+			ctx:         withCaller(middlewares.Caller{Identity: "operator", Role: middlewares.RoleOperator}, "tenant"),
+			action:      ActionDeprovisionRuntime,
+		},
+		{
+			description: "should allow admin to deprovision",
+			ctx:         withCaller(middlewares.Caller{Identity: "admin", Role: middlewares.RoleAdmin}, "tenant"),
+			action:      ActionDeprovisionRuntime,
+			allowed:     true,
+		},
+		{
+			description: "should allow tenant bound caller to access own tenant",
+			ctx:         withCaller(middlewares.Caller{Identity: "operator", Role: middlewares.RoleOperator, Tenant: "tenant"}, "tenant"),
+			action:      ActionUpgradeShoot,
+			allowed:     true,
+		},
+		{
+			description: "should not allow tenant bound caller to access other tenant",
+			ctx:         withCaller(middlewares.Caller{Identity: "operator", Role: middlewares.RoleOperator, Tenant: "tenant"}, "other"),
+			action:      ActionRead,
+		},
+		{
+			description: "should not allow unauthenticated caller",
+			ctx:         context.WithValue(context.Background(), middlewares.Tenant, "tenant"),
+			action:      ActionRead,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			err := NewAuthorizer(true).Authorize(testCase.ctx, testCase.action)
+
+			// then
+			if testCase.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+
+	t.Run("should allow everything when disabled", func(t *testing.T) {
+		assert.Nil(t, NewAuthorizer(false).Authorize(context.Background(), ActionDeprovisionRuntime))
+	})
+}
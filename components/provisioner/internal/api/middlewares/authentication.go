@@ -0,0 +1,228 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+type Role string
+
+const (
+	RoleReadOnly Role = "read-only"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+const (
+	CallerKey Header = "caller"
+
+	authorizationHeader       = "Authorization"
+	forwardedClientCertHeader = "X-Forwarded-Client-Cert"
+)
+
+var roleLevels = map[Role]int{
+	RoleReadOnly: 1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// Includes checks if the role grants the permissions of the other role
+func (r Role) Includes(other Role) bool {
+	return roleLevels[r] >= roleLevels[other]
+}
+
+// Caller is the authenticated client of the API
+type Caller struct {
+	Identity string
+	Role     Role
+	// Tenant restricts the caller to the Runtimes of the tenant, empty for callers allowed to access all tenants
+	Tenant string
+}
+
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(CallerKey).(Caller)
+	return caller, ok
+}
+
+type AuthenticationConfig struct {
+	Enabled bool `envconfig:"default=false"`
+	// JWTPublicKeyPath points to the PEM encoded RSA public key verifying the tokens
+	JWTPublicKeyPath string `envconfig:"optional"`
+	JWTIssuer        string `envconfig:"optional"`
+	JWTAudience      string `envconfig:"optional"`
+	JWTRolesClaim    string `envconfig:"default=roles"`
+	JWTTenantClaim   string `envconfig:"default=tenant"`
+	// SubjectRolesConfigPath points to the JSON file mapping the client certificate common names to roles
+	SubjectRolesConfigPath string `envconfig:"optional"`
+}
+
+type Authenticator struct {
+	config       AuthenticationConfig
+	parser       *jwt.Parser
+	keyFunc      jwt.Keyfunc
+	subjectRoles map[string]Role
+}
+
+func NewAuthenticator(config AuthenticationConfig) (*Authenticator, error) {
+	authenticator := &Authenticator{
+		config:       config,
+		parser:       jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512"})),
+		subjectRoles: map[string]Role{},
+	}
+
+	if config.JWTPublicKeyPath != "" {
+		pemKey, err := os.ReadFile(config.JWTPublicKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWT public key: %s", err.Error())
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(pemKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWT public key: %s", err.Error())
+		}
+		authenticator.keyFunc = func(*jwt.Token) (interface{}, error) {
+			return key, nil
+		}
+	}
+
+	if config.SubjectRolesConfigPath != "" {
+		file, err := os.ReadFile(config.SubjectRolesConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read subject roles config: %s", err.Error())
+		}
+		if err := json.Unmarshal(file, &authenticator.subjectRoles); err != nil {
+			return nil, fmt.Errorf("failed to decode subject roles config: %s", err.Error())
+		}
+		for subject, role := range authenticator.subjectRoles {
+			if _, known := roleLevels[role]; !known {
+				return nil, fmt.Errorf("unknown role %q of subject %s", role, subject)
+			}
+		}
+	}
+
+	return authenticator, nil
+}
+
+// Authenticate resolves the caller from the bearer token or the client certificate forwarded by the sidecar proxy
+// and rejects the requests of unknown callers
+func (a *Authenticator) Authenticate(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		caller, err := a.authenticate(r)
+		if err != nil {
+			log.Warnf("Unauthorized request to %s: %s", r.URL.Path, err.Error())
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), CallerKey, caller)
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (a *Authenticator) authenticate(r *http.Request) (Caller, error) {
+	if token := strings.TrimPrefix(r.Header.Get(authorizationHeader), "Bearer "); token != "" {
+		return a.authenticateToken(token)
+	}
+
+	if clientCert := r.Header.Get(forwardedClientCertHeader); clientCert != "" {
+		return a.authenticateSubject(clientCert)
+	}
+
+	return Caller{}, fmt.Errorf("neither bearer token nor client certificate provided")
+}
+
+func (a *Authenticator) authenticateToken(token string) (Caller, error) {
+	if a.keyFunc == nil {
+		return Caller{}, fmt.Errorf("token authentication is not configured")
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.keyFunc); err != nil {
+		return Caller{}, fmt.Errorf("invalid token: %s", err.Error())
+	}
+	// the parser verifies the expiration only when the claim is present
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return Caller{}, fmt.Errorf("token without expiration or expired")
+	}
+	if a.config.JWTIssuer != "" && !claims.VerifyIssuer(a.config.JWTIssuer, true) {
+		return Caller{}, fmt.Errorf("invalid token issuer")
+	}
+	if a.config.JWTAudience != "" && !claims.VerifyAudience(a.config.JWTAudience, true) {
+		return Caller{}, fmt.Errorf("invalid token audience")
+	}
+
+	role := highestRole(claims[a.config.JWTRolesClaim])
+	if role == "" {
+		return Caller{}, fmt.Errorf("token does not contain any known role in %s claim", a.config.JWTRolesClaim)
+	}
+
+	subject, _ := claims["sub"].(string)
+	tenant, _ := claims[a.config.JWTTenantClaim].(string)
+
+	return Caller{Identity: subject, Role: role, Tenant: tenant}, nil
+}
+
+func (a *Authenticator) authenticateSubject(clientCert string) (Caller, error) {
+	commonName := forwardedSubjectCommonName(clientCert)
+	if commonName == "" {
+		return Caller{}, fmt.Errorf("client certificate subject without common name")
+	}
+
+	role, found := a.subjectRoles[commonName]
+	if !found {
+		return Caller{}, fmt.Errorf("no role assigned to subject %s", commonName)
+	}
+
+	return Caller{Identity: commonName, Role: role}, nil
+}
+
+func highestRole(claim interface{}) Role {
+	var names []string
+	switch value := claim.(type) {
+	case string:
+		names = strings.Fields(value)
+	case []interface{}:
+		for _, name := range value {
+			if s, ok := name.(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+
+	var highest Role
+	for _, name := range names {
+		role := Role(name)
+		if _, known := roleLevels[role]; known && !highest.Includes(role) {
+			highest = role
+		}
+	}
+	return highest
+}
+
+// forwardedSubjectCommonName extracts the common name from the Subject element of the client certificate header, e.g.
+// By=spiffe://cluster.local/ns/kcp-system/sa/provisioner;Hash=abc;Subject="CN=kcp-broker,O=SAP";URI=spiffe://...
+func forwardedSubjectCommonName(header string) string {
+	// the sidecar appends the certificate of every proxy on the way, the first one belongs to the client
+	client := strings.Split(header, ",By=")[0]
+
+	for _, element := range strings.Split(client, ";") {
+		key, value, found := strings.Cut(element, "=")
+		if !found || !strings.EqualFold(key, "Subject") {
+			continue
+		}
+		for _, attribute := range strings.Split(strings.Trim(value, `"`), ",") {
+			name, attributeValue, found := strings.Cut(strings.TrimSpace(attribute), "=")
+			if found && name == "CN" {
+				return attributeValue
+			}
+		}
+	}
+	return ""
+}
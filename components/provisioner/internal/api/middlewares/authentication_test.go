@@ -0,0 +1,172 @@
+package middlewares
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	publicKeyPath := writePublicKey(t, dir, &privateKey.PublicKey)
+	subjectRolesPath := filepath.Join(dir, "roles.json")
+	require.NoError(t, os.WriteFile(subjectRolesPath, []byte(`{"kcp-broker": "operator"}`), 0600))
+
+	authenticator, err := NewAuthenticator(AuthenticationConfig{
+		Enabled:                true,
+		JWTPublicKeyPath:       publicKeyPath,
+		JWTIssuer:              "https://issuer",
+		JWTAudience:            "provisioner",
+		JWTRolesClaim:          "roles",
+		JWTTenantClaim:         "tenant",
+		SubjectRolesConfigPath: subjectRolesPath,
+	})
+	require.NoError(t, err)
+
+	signToken := func(key *rsa.PrivateKey, claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour).Unix()
+
+	for _, testCase := range []struct {
+		description    string
+		headers        map[string]string
+		expectedStatus int
+		expectedCaller Caller
+	}{
+		{
+			description: "should authenticate caller with the highest role from token",
+			headers: map[string]string{
+				"Authorization": "Bearer " + signToken(privateKey, jwt.MapClaims{"sub": "john", "iss": "https://issuer", "roles": []interface{}{"read-only", "admin"}, "tenant": "tenant", "aud": "provisioner", "exp": expiresAt}),
+			},
+			expectedStatus: http.StatusOK,
+			expectedCaller: Caller{Identity: "john", Role: RoleAdmin, Tenant: "tenant"},
+		},
+		{
+			description: "should authenticate caller with client certificate",
+			headers: map[string]string{
+				"X-Forwarded-Client-Cert": `By=spiffe://cluster.local/ns/kcp-system/sa/provisioner;Hash=abc;Subject="CN=kcp-broker,O=SAP";URI=spiffe://cluster.local/ns/kcp-system/sa/kcp-kyma-environment-broker`,
+			},
+			expectedStatus: http.StatusOK,
+			expectedCaller: Caller{Identity: "kcp-broker", Role: RoleOperator},
+		},
+		{
+			description: "should reject token signed with unknown key",
+			headers: map[string]string{
+				"Authorization": "Bearer " + signToken(otherKey, jwt.MapClaims{"sub": "john", "iss": "https://issuer", "roles": "admin", "aud": "provisioner", "exp": expiresAt}),
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description: "should reject token from other issuer",
+			headers: map[string]string{
+				"Authorization": "Bearer " + signToken(privateKey, jwt.MapClaims{"sub": "john", "iss": "https://other", "roles": "admin", "aud": "provisioner", "exp": expiresAt}),
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description: "should reject token for other audience",
+			headers: map[string]string{
+				"Authorization": "Bearer " + signToken(privateKey, jwt.MapClaims{"sub": "john", "iss": "https://issuer", "roles": "admin", "aud": "other", "exp": expiresAt}),
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description: "should reject token without expiration",
+			headers: map[string]string{
+				"Authorization": "Bearer " + signToken(privateKey, jwt.MapClaims{"sub": "john", "iss": "https://issuer", "roles": "admin", "aud": "provisioner"}),
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description: "should reject expired token",
+			headers: map[string]string{
+				"Authorization": "Bearer " + signToken(privateKey, jwt.MapClaims{"sub": "john", "iss": "https://issuer", "roles": "admin", "aud": "provisioner", "exp": time.Now().Add(-time.Minute).Unix()}),
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description: "should reject token without known role",
+			headers: map[string]string{
+				"Authorization": "Bearer " + signToken(privateKey, jwt.MapClaims{"sub": "john", "iss": "https://issuer", "roles": "viewer", "aud": "provisioner", "exp": expiresAt}),
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description: "should reject client certificate of unknown subject",
+			headers: map[string]string{
+				"X-Forwarded-Client-Cert": `Hash=abc;Subject="CN=unknown"`,
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			description:    "should reject request without credentials",
+			headers:        map[string]string{},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			var caller Caller
+			handler := authenticator.Authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				caller, _ = CallerFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+			for name, value := range testCase.headers {
+				req.Header.Set(name, value)
+			}
+			rr := httptest.NewRecorder()
+
+			// when
+			handler.ServeHTTP(rr, req)
+
+			// then
+			assert.Equal(t, testCase.expectedStatus, rr.Code)
+			assert.Equal(t, testCase.expectedCaller, caller)
+		})
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Run("should fail for unknown role of subject", func(t *testing.T) {
+		// given
+		subjectRolesPath := filepath.Join(t.TempDir(), "roles.json")
+		require.NoError(t, os.WriteFile(subjectRolesPath, []byte(`{"kcp-broker": "owner"}`), 0600))
+
+		// when
+		_, err := NewAuthenticator(AuthenticationConfig{SubjectRolesConfigPath: subjectRolesPath})
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func writePublicKey(t *testing.T, dir string, key *rsa.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+	return path
+}
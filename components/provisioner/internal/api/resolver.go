@@ -15,6 +15,8 @@ type Resolver struct {
 	provisioning  provisioning.Service
 	validator     Validator
 	tenantUpdater TenantUpdater
+	subscriber    StatusSubscriber
+	authorizer    Authorizer
 }
 
 func (r *Resolver) Mutation() gqlschema.MutationResolver {
@@ -22,6 +24,8 @@ func (r *Resolver) Mutation() gqlschema.MutationResolver {
 		provisioning:  r.provisioning,
 		validator:     r.validator,
 		tenantUpdater: r.tenantUpdater,
+		subscriber:    r.subscriber,
+		authorizer:    r.authorizer,
 	}
 }
 func (r *Resolver) Query() gqlschema.QueryResolver {
@@ -29,18 +33,36 @@ func (r *Resolver) Query() gqlschema.QueryResolver {
 		provisioning:  r.provisioning,
 		validator:     r.validator,
 		tenantUpdater: r.tenantUpdater,
+		subscriber:    r.subscriber,
+		authorizer:    r.authorizer,
+	}
+}
+func (r *Resolver) Subscription() gqlschema.SubscriptionResolver {
+	return &Resolver{
+		provisioning:  r.provisioning,
+		validator:     r.validator,
+		tenantUpdater: r.tenantUpdater,
+		subscriber:    r.subscriber,
+		authorizer:    r.authorizer,
 	}
 }
 
-func NewResolver(provisioningService provisioning.Service, validator Validator, tenantUpdater TenantUpdater) *Resolver {
+func NewResolver(provisioningService provisioning.Service, validator Validator, tenantUpdater TenantUpdater, subscriber StatusSubscriber, authorizer Authorizer) *Resolver {
 	return &Resolver{
 		provisioning:  provisioningService,
 		validator:     validator,
 		tenantUpdater: tenantUpdater,
+		subscriber:    subscriber,
+		authorizer:    authorizer,
 	}
 }
 
 func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput) (*gqlschema.OperationStatus, error) {
+	if err := r.authorizer.Authorize(ctx, ActionProvisionRuntime); err != nil {
+		log.Errorf("Failed to provision Runtime: %s", err)
+		return nil, err
+	}
+
 	err := r.validator.ValidateProvisioningInput(config)
 	if err != nil {
 		log.Errorf("Failed to provision Runtime %s", err)
@@ -70,6 +92,11 @@ func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.Provis
 func (r *Resolver) DeprovisionRuntime(ctx context.Context, id string) (string, error) {
 	log.Infof("Requested deprovisioning of Runtime %s.", id)
 
+	if err := r.authorizer.Authorize(ctx, ActionDeprovisionRuntime); err != nil {
+		log.Errorf("Failed to deprovision Runtime %s: %s", id, err)
+		return "", err
+	}
+
 	err := r.tenantUpdater.GetAndUpdateTenant(id, ctx)
 	if err != nil {
 		log.Errorf("Failed to deprovision Runtime %s: %s", id, err)
@@ -89,6 +116,11 @@ func (r *Resolver) DeprovisionRuntime(ctx context.Context, id string) (string, e
 func (r *Resolver) UpgradeRuntime(ctx context.Context, runtimeId string, input gqlschema.UpgradeRuntimeInput) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested upgrade of Runtime %s.", runtimeId)
 
+	if err := r.authorizer.Authorize(ctx, ActionUpgradeRuntime); err != nil {
+		log.Errorf("Failed to upgrade Runtime %s: %s", runtimeId, err)
+		return nil, err
+	}
+
 	if err := r.tenantUpdater.GetAndUpdateTenant(runtimeId, ctx); err != nil {
 		log.Errorf("Failed to upgrade Runtime %s: %s", runtimeId, err)
 		return &gqlschema.OperationStatus{}, err
@@ -109,6 +141,11 @@ func (r *Resolver) UpgradeRuntime(ctx context.Context, runtimeId string, input g
 }
 
 func (r *Resolver) RollBackUpgradeOperation(ctx context.Context, runtimeID string) (*gqlschema.RuntimeStatus, error) {
+	if err := r.authorizer.Authorize(ctx, ActionRollBackUpgradeOperation); err != nil {
+		log.Errorf("Failed to roll back last Runtime upgrade: %s, Runtime ID: %s", err, runtimeID)
+		return nil, err
+	}
+
 	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
 	if err != nil {
 		log.Errorf("Failed to roll back last Runtime upgrade: %s, Runtime ID: %s", err, runtimeID)
@@ -125,12 +162,21 @@ func (r *Resolver) RollBackUpgradeOperation(ctx context.Context, runtimeID strin
 }
 
 func (r *Resolver) ReconnectRuntimeAgent(ctx context.Context, id string) (string, error) {
+	if err := r.authorizer.Authorize(ctx, ActionReconnectRuntimeAgent); err != nil {
+		return "", err
+	}
+
 	return "", nil
 }
 
 func (r *Resolver) RuntimeStatus(ctx context.Context, runtimeID string) (*gqlschema.RuntimeStatus, error) {
 	log.Infof("Requested to get status for Runtime %s.", runtimeID)
 
+	if err := r.authorizer.Authorize(ctx, ActionRead); err != nil {
+		log.Errorf("Failed to get status for Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
 	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
 	if err != nil {
 		log.Errorf("Failed to get status for Runtime %s: %s", runtimeID, err)
@@ -150,6 +196,11 @@ func (r *Resolver) RuntimeStatus(ctx context.Context, runtimeID string) (*gqlsch
 func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID string) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to get Runtime operation status for Operation %s.", operationID)
 
+	if err := r.authorizer.Authorize(ctx, ActionRead); err != nil {
+		log.Errorf("Failed to get Runtime operation status: %s Operation ID: %s", err, operationID)
+		return nil, err
+	}
+
 	status, err := r.provisioning.RuntimeOperationStatus(operationID)
 	if err != nil {
 		log.Errorf("Failed to get Runtime operation status: %s Operation ID: %s", err, operationID)
@@ -167,9 +218,85 @@ func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID strin
 	return status, nil
 }
 
+func (r *Resolver) RuntimeDrift(ctx context.Context, runtimeID string) (*gqlschema.RuntimeDrift, error) {
+	log.Infof("Requested to get drift for Runtime %s.", runtimeID)
+
+	if err := r.authorizer.Authorize(ctx, ActionRead); err != nil {
+		log.Errorf("Failed to get drift for Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to get drift for Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	drift, err := r.provisioning.RuntimeDrift(runtimeID)
+	if err != nil {
+		log.Errorf("Failed to get drift for Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	return drift, nil
+}
+
+func (r *Resolver) Runtimes(ctx context.Context, filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimesPage, error) {
+	log.Infof("Requested to list Runtimes.")
+
+	if err := r.authorizer.Authorize(ctx, ActionRead); err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+
+	if caller, ok := middlewares.CallerFromContext(ctx); ok && caller.Tenant != "" {
+		if filter == nil {
+			filter = &gqlschema.RuntimesFilter{}
+		}
+		filter.Tenant = &caller.Tenant
+	}
+
+	page, err := r.provisioning.ListRuntimes(filter, first, after)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (r *Resolver) Operations(ctx context.Context, filter *gqlschema.OperationsFilter, first *int, after *string) (*gqlschema.OperationsPage, error) {
+	log.Infof("Requested to list operations.")
+
+	if err := r.authorizer.Authorize(ctx, ActionRead); err != nil {
+		log.Errorf("Failed to list operations: %s", err)
+		return nil, err
+	}
+
+	if caller, ok := middlewares.CallerFromContext(ctx); ok && caller.Tenant != "" {
+		if filter == nil {
+			filter = &gqlschema.OperationsFilter{}
+		}
+		filter.Tenant = &caller.Tenant
+	}
+
+	page, err := r.provisioning.ListOperations(filter, first, after)
+	if err != nil {
+		log.Errorf("Failed to list operations: %s", err)
+		return nil, err
+	}
+
+	return page, nil
+}
+
 func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to upgrade Gardener Shoot cluster specification for Runtime : %s.", runtimeID)
 
+	if err := r.authorizer.Authorize(ctx, ActionUpgradeShoot); err != nil {
+		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime  %s: %s", runtimeID, err)
+		return nil, err
+	}
+
 	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
 	if err != nil {
 		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime  %s: %s", runtimeID, err)
@@ -196,6 +323,11 @@ func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gql
 func (r *Resolver) HibernateRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to hibernate runtime : %s.", runtimeID)
 
+	if err := r.authorizer.Authorize(ctx, ActionHibernateRuntime); err != nil {
+		log.Errorf("Failed to hibernate Runtime  %s: %s", runtimeID, err)
+		return nil, err
+	}
+
 	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
 	if err != nil {
 		log.Errorf("Failed to hibernate Runtime  %s: %s", runtimeID, err)
@@ -211,6 +343,29 @@ func (r *Resolver) HibernateRuntime(ctx context.Context, runtimeID string) (*gql
 	return status, nil
 }
 
+func (r *Resolver) WakeUpRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to wake up runtime : %s.", runtimeID)
+
+	if err := r.authorizer.Authorize(ctx, ActionWakeUpRuntime); err != nil {
+		log.Errorf("Failed to wake up Runtime  %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime  %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.WakeUpCluster(runtimeID)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	return status, nil
+}
+
 func getSubAccount(ctx context.Context) string {
 	subAccount, ok := ctx.Value(middlewares.SubAccountID).(string)
 	if !ok {
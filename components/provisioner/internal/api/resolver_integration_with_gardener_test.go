@@ -21,8 +21,12 @@ import (
 	v1alpha12 "github.com/kyma-project/kyma/components/compass-runtime-agent/pkg/apis/compass/v1alpha1"
 	"github.com/kyma-project/kyma/components/compass-runtime-agent/pkg/client/clientset/versioned/typed/compass/v1alpha1"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/notification"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
@@ -160,6 +164,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 
 	queueCtx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	statusNotifier := notification.NewBroadcaster()
 	provisioningQueue := queue.CreateProvisioningQueue(
 		testProvisioningTimeouts(),
 		dbsFactory,
@@ -170,7 +175,10 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 		shootInterface,
 		secretsInterface,
 		testOperatorRoleBinding(),
-		mockK8sClientProvider)
+		mockK8sClientProvider,
+		statusNotifier,
+		failure.NewNoopFailureHandler(),
+		operations.StagePolicies{})
 	provisioningQueue.Run(queueCtx.Done())
 
 	provisioningNoInstallQueue := queue.CreateProvisioningNoInstallQueue(
@@ -181,25 +189,31 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 		secretsInterface,
 		testOperatorRoleBinding(),
 		mockK8sClientProvider,
-		runtimeConfigurator)
+		runtimeConfigurator,
+		statusNotifier,
+		failure.NewNoopFailureHandler(),
+		operations.StagePolicies{})
 	provisioningNoInstallQueue.Run(queueCtx.Done())
 
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(testDeprovisioningTimeouts(), dbsFactory, installationServiceMock, directorServiceMock, shootInterface, 1*time.Second)
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(testDeprovisioningTimeouts(), dbsFactory, installationServiceMock, directorServiceMock, shootInterface, 1*time.Second, statusNotifier, operations.StagePolicies{})
 	deprovisioningQueue.Run(queueCtx.Done())
 
-	deprovisioningNoInstallQueue := queue.CreateDeprovisioningNoInstallQueue(testDeprovisioningNoInstallTimeouts(), dbsFactory, directorServiceMock, shootInterface)
+	deprovisioningNoInstallQueue := queue.CreateDeprovisioningNoInstallQueue(testDeprovisioningNoInstallTimeouts(), dbsFactory, directorServiceMock, shootInterface, statusNotifier, operations.StagePolicies{})
 	deprovisioningNoInstallQueue.Run(queueCtx.Done())
 
-	upgradeQueue := queue.CreateUpgradeQueue(testProvisioningTimeouts(), dbsFactory, directorServiceMock, installationServiceMock)
+	upgradeQueue := queue.CreateUpgradeQueue(testProvisioningTimeouts(), dbsFactory, directorServiceMock, installationServiceMock, statusNotifier, operations.StagePolicies{})
 	upgradeQueue.Run(queueCtx.Done())
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), dbsFactory, directorServiceMock, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, secretsInterface)
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), dbsFactory, directorServiceMock, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, secretsInterface, statusNotifier, operations.StagePolicies{})
 	shootUpgradeQueue.Run(queueCtx.Done())
 
-	shootHibernationQueue := queue.CreateHibernationQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface)
+	shootHibernationQueue := queue.CreateHibernationQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface, statusNotifier, operations.StagePolicies{})
 	shootHibernationQueue.Run(queueCtx.Done())
 
-	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath)
+	shootWakeUpQueue := queue.CreateWakeUpQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface, statusNotifier, operations.StagePolicies{})
+	shootWakeUpQueue.Run(queueCtx.Done())
+
+	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath, metrics.NewShootDriftCollector(), nil)
 	require.NoError(t, err)
 
 	go func() {
@@ -251,13 +265,13 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 			inputConverter := provisioning.NewInputConverter(uuidGenerator, provider, "Project", defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
 			graphQLConverter := provisioning.NewGraphQLConverter()
 
-			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), installationServiceMockForDeprovisiong, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, shootHibernationQueue)
+			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), installationServiceMockForDeprovisiong, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, shootHibernationQueue, shootWakeUpQueue)
 
 			validator := api.NewValidator()
 
 			tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
 
-			resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+			resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 			err = insertDummyReleaseIfNotExist(releaseRepository, uuidGenerator.New(), kymaVersion)
 			require.NoError(t, err)
@@ -590,6 +604,7 @@ func testOperatorRoleBinding() provisioning2.OperatorRoleBinding {
 func testHibernationTimeouts() queue.HibernationTimeouts {
 	return queue.HibernationTimeouts{
 		WaitingForClusterHibernation: 5 * time.Minute,
+		WaitingForClusterWakeUp:      5 * time.Minute,
 	}
 }
 
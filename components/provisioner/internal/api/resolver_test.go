@@ -58,7 +58,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
 
@@ -109,7 +109,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -133,7 +133,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -165,7 +165,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -205,7 +205,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		expectedID := "ec781980-0533-4098-aab7-96b535569732"
 
@@ -225,7 +225,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 		provisioningService.On("DeprovisionRuntime", runtimeID).Return("", apperrors.Internal("Deprovisioning fails because reasons"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
@@ -243,7 +243,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 		expectedID := "ec781980-0533-4098-aab7-96b535569732"
 
 		ctx := context.Background()
@@ -285,7 +285,7 @@ func TestResolver_UpgradeRuntime(t *testing.T) {
 		validator.On("ValidateUpgradeInput", upgradeInput).Return(nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		//when
 		status, err := resolver.UpgradeRuntime(ctx, runtimeID, upgradeInput)
@@ -308,7 +308,7 @@ func TestResolver_UpgradeRuntime(t *testing.T) {
 		validator.On("ValidateUpgradeInput", upgradeInput).Return(nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		//when
 		_, err := resolver.UpgradeRuntime(ctx, runtimeID, upgradeInput)
@@ -327,7 +327,7 @@ func TestResolver_UpgradeRuntime(t *testing.T) {
 		validator.On("ValidateUpgradeInput", upgradeInput).Return(apperrors.BadRequest("error"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		//when
 		_, err := resolver.UpgradeRuntime(ctx, runtimeID, upgradeInput)
@@ -352,7 +352,7 @@ func TestResolver_RollBackUpgradeOperation(t *testing.T) {
 		provisioningService.On("RollBackLastUpgrade", runtimeID).Return(&runtimeStatus, nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		//when
 		status, err := resolver.RollBackUpgradeOperation(ctx, runtimeID)
@@ -371,7 +371,7 @@ func TestResolver_RollBackUpgradeOperation(t *testing.T) {
 		provisioningService.On("RollBackLastUpgrade", runtimeID).Return(nil, apperrors.Internal("error"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		//when
 		_, err := resolver.RollBackUpgradeOperation(ctx, runtimeID)
@@ -392,7 +392,7 @@ func TestResolver_RuntimeStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
 		message := "some message"
@@ -426,7 +426,7 @@ func TestResolver_RuntimeStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		provisioningService.On("RuntimeStatus", runtimeID).Return(nil, apperrors.Internal("Runtime status fails"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
@@ -451,7 +451,7 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
 		message := "some message"
@@ -482,7 +482,7 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
 		validator.On("ValidateTenantForOperation", operationID, tenant).Return(nil)
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		provisioningService.On("RuntimeOperationStatus", operationID).Return(nil, apperrors.Internal("Some error"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
@@ -520,7 +520,7 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(nil)
 		provisioningService.On("UpgradeGardenerShoot", runtimeID, upgradeShootInput).Return(operation, nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		//when
 		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
@@ -542,7 +542,7 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(apperrors.BadRequest("error"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		//when
 		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
@@ -563,7 +563,7 @@ func TestResolver_HibernateRuntime(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
 		message := "some message"
@@ -593,7 +593,7 @@ func TestResolver_HibernateRuntime(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
 
 		provisioningService.On("HibernateCluster", runtimeID).Return(nil, apperrors.Internal("Some error"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
@@ -608,6 +608,61 @@ func TestResolver_HibernateRuntime(t *testing.T) {
 	})
 }
 
+func TestResolver_WakeUpRuntime(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	runtimeID := "1100bb59-9c40-4ebb-b846-7477c4dc5bbd"
+
+	t.Run("Should wake up cluster", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
+
+		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
+		message := "some message"
+
+		operationStatus := &gqlschema.OperationStatus{
+			ID:        &operationID,
+			Operation: gqlschema.OperationTypeWakeUp,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: &runtimeID,
+			Message:   &message,
+		}
+
+		provisioningService.On("WakeUpCluster", runtimeID).Return(operationStatus, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.WakeUpRuntime(ctx, runtimeID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operationStatus, status)
+	})
+
+	t.Run("Should return error when wake up fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil, api.NewAuthorizer(false))
+
+		provisioningService.On("WakeUpCluster", runtimeID).Return(nil, apperrors.Internal("Some error"))
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.WakeUpRuntime(ctx, runtimeID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeInternal)
+		require.Empty(t, status)
+	})
+}
+
 func oidcInput() *gqlschema.OIDCConfigInput {
 	return &gqlschema.OIDCConfigInput{
 		ClientID:       "9bd05ed7-a930-44e6-8c79-e6defeb2222",
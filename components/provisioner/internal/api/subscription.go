@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+// statusResyncInterval makes subscriptions pick up changes done outside of the operations executor
+const statusResyncInterval = 30 * time.Second
+
+type StatusSubscriber interface {
+	Subscribe(id string) (<-chan struct{}, func())
+}
+
+func (r *Resolver) OperationStatusChanged(ctx context.Context, operationID string) (<-chan *gqlschema.OperationStatus, error) {
+	log.Infof("Requested to subscribe to status of Operation %s.", operationID)
+
+	if err := r.authorizer.Authorize(ctx, ActionRead); err != nil {
+		log.Errorf("Failed to subscribe to status of Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	signals, cancel := r.subscriber.Subscribe(operationID)
+
+	status, err := r.provisioning.RuntimeOperationStatus(operationID)
+	if err != nil {
+		cancel()
+		log.Errorf("Failed to subscribe to status of Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	err = r.tenantUpdater.GetAndUpdateTenant(*status.RuntimeID, ctx)
+	if err != nil {
+		cancel()
+		log.Errorf("Failed to subscribe to status of Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	statuses := make(chan *gqlschema.OperationStatus, 1)
+	statuses <- status
+
+	go func() {
+		defer close(statuses)
+		defer cancel()
+
+		if status.State != gqlschema.OperationStateInProgress {
+			return
+		}
+
+		watchStatus(ctx, signals, func() bool {
+			current, err := r.provisioning.RuntimeOperationStatus(operationID)
+			if err != nil {
+				log.Warnf("Failed to get status of Operation %s: %s", operationID, err)
+				return true
+			}
+			if reflect.DeepEqual(status, current) {
+				return true
+			}
+			status = current
+
+			select {
+			case statuses <- current:
+			case <-ctx.Done():
+				return false
+			}
+			return current.State == gqlschema.OperationStateInProgress
+		})
+	}()
+
+	return statuses, nil
+}
+
+func (r *Resolver) RuntimeStatusChanged(ctx context.Context, runtimeID string) (<-chan *gqlschema.RuntimeStatus, error) {
+	log.Infof("Requested to subscribe to status of Runtime %s.", runtimeID)
+
+	if err := r.authorizer.Authorize(ctx, ActionRead); err != nil {
+		log.Errorf("Failed to subscribe to status of Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to subscribe to status of Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	signals, cancel := r.subscriber.Subscribe(runtimeID)
+
+	status, err := r.provisioning.RuntimeStatus(runtimeID)
+	if err != nil {
+		cancel()
+		log.Errorf("Failed to subscribe to status of Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	statuses := make(chan *gqlschema.RuntimeStatus, 1)
+	statuses <- status
+
+	go func() {
+		defer close(statuses)
+		defer cancel()
+
+		watchStatus(ctx, signals, func() bool {
+			current, err := r.provisioning.RuntimeStatus(runtimeID)
+			if err != nil {
+				log.Warnf("Failed to get status of Runtime %s: %s", runtimeID, err)
+				return true
+			}
+			if reflect.DeepEqual(status, current) {
+				return true
+			}
+			status = current
+
+			select {
+			case statuses <- current:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return statuses, nil
+}
+
+// watchStatus calls update on every signal and resync until the context is done or update returns false
+func watchStatus(ctx context.Context, signals <-chan struct{}, update func() bool) {
+	resync := time.NewTicker(statusResyncInterval)
+	defer resync.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+		case <-resync.C:
+		}
+
+		if !update() {
+			return
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	validatorMocks "github.com/kyma-project/control-plane/components/provisioner/internal/api/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/notification"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const subscriptionTimeout = 5 * time.Second
+
+func TestResolver_OperationStatusChanged(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	runtimeID := "1100bb59-9c40-4ebb-b846-7477c4dc5bbd"
+	operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
+
+	inProgress := &gqlschema.OperationStatus{
+		ID:        &operationID,
+		Operation: gqlschema.OperationTypeProvision,
+		State:     gqlschema.OperationStateInProgress,
+		RuntimeID: &runtimeID,
+		Message:   util.StringPtr("Starting provisioning"),
+	}
+	succeeded := &gqlschema.OperationStatus{
+		ID:        &operationID,
+		Operation: gqlschema.OperationTypeProvision,
+		State:     gqlschema.OperationStateSucceeded,
+		RuntimeID: &runtimeID,
+		Message:   util.StringPtr("Operation succeeded"),
+	}
+
+	t.Run("Should send status changes until operation finishes", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		broadcaster := notification.NewBroadcaster()
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(inProgress, nil).Once()
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(succeeded, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, broadcaster, api.NewAuthorizer(false))
+
+		//when
+		statuses, err := provisioner.OperationStatusChanged(ctx, operationID)
+		require.NoError(t, err)
+
+		//then
+		assert.Equal(t, inProgress, receiveOperationStatus(t, statuses))
+
+		broadcaster.OperationChanged(operationID, runtimeID)
+
+		assert.Equal(t, succeeded, receiveOperationStatus(t, statuses))
+		assertOperationStatusesClosed(t, statuses)
+	})
+
+	t.Run("Should send status and close when operation already finished", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(succeeded, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, notification.NewBroadcaster(), api.NewAuthorizer(false))
+
+		//when
+		statuses, err := provisioner.OperationStatusChanged(ctx, operationID)
+		require.NoError(t, err)
+
+		//then
+		assert.Equal(t, succeeded, receiveOperationStatus(t, statuses))
+		assertOperationStatusesClosed(t, statuses)
+	})
+
+	t.Run("Should return error when getting operation status fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(nil, apperrors.Internal("Some error"))
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, notification.NewBroadcaster(), api.NewAuthorizer(false))
+
+		//when
+		statuses, err := provisioner.OperationStatusChanged(ctx, operationID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeInternal)
+		assert.Nil(t, statuses)
+	})
+}
+
+func TestResolver_RuntimeStatusChanged(t *testing.T) {
+	runtimeID := "1100bb59-9c40-4ebb-b846-7477c4dc5bbd"
+	operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
+
+	provisioning := &gqlschema.RuntimeStatus{
+		LastOperationStatus: &gqlschema.OperationStatus{
+			ID:        &operationID,
+			Operation: gqlschema.OperationTypeProvision,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: &runtimeID,
+		},
+	}
+	provisioned := &gqlschema.RuntimeStatus{
+		LastOperationStatus: &gqlschema.OperationStatus{
+			ID:        &operationID,
+			Operation: gqlschema.OperationTypeProvision,
+			State:     gqlschema.OperationStateSucceeded,
+			RuntimeID: &runtimeID,
+		},
+	}
+
+	t.Run("Should send status changes until context is done", func(t *testing.T) {
+		//given
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), middlewares.Tenant, tenant))
+		defer cancel()
+
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		broadcaster := notification.NewBroadcaster()
+
+		provisioningService.On("RuntimeStatus", runtimeID).Return(provisioning, nil).Once()
+		provisioningService.On("RuntimeStatus", runtimeID).Return(provisioned, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, broadcaster, api.NewAuthorizer(false))
+
+		//when
+		statuses, err := provisioner.RuntimeStatusChanged(ctx, runtimeID)
+		require.NoError(t, err)
+
+		//then
+		assert.Equal(t, provisioning, receiveRuntimeStatus(t, statuses))
+
+		broadcaster.OperationChanged(operationID, runtimeID)
+		assert.Equal(t, provisioned, receiveRuntimeStatus(t, statuses))
+
+		cancel()
+		select {
+		case _, ok := <-statuses:
+			assert.False(t, ok)
+		case <-time.After(subscriptionTimeout):
+			t.Fatal("subscription was not closed")
+		}
+	})
+
+	t.Run("Should return error when tenant does not match", func(t *testing.T) {
+		//given
+		ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("tenant does not match"))
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, notification.NewBroadcaster(), api.NewAuthorizer(false))
+
+		//when
+		statuses, err := provisioner.RuntimeStatusChanged(ctx, runtimeID)
+
+		//then
+		require.Error(t, err)
+		assert.Nil(t, statuses)
+		provisioningService.AssertNotCalled(t, "RuntimeStatus", runtimeID)
+	})
+}
+
+func receiveOperationStatus(t *testing.T, statuses <-chan *gqlschema.OperationStatus) *gqlschema.OperationStatus {
+	select {
+	case status := <-statuses:
+		return status
+	case <-time.After(subscriptionTimeout):
+		t.Fatal("operation status was not received")
+		return nil
+	}
+}
+
+func assertOperationStatusesClosed(t *testing.T, statuses <-chan *gqlschema.OperationStatus) {
+	select {
+	case _, ok := <-statuses:
+		assert.False(t, ok)
+	case <-time.After(subscriptionTimeout):
+		t.Fatal("subscription was not closed")
+	}
+}
+
+func receiveRuntimeStatus(t *testing.T, statuses <-chan *gqlschema.RuntimeStatus) *gqlschema.RuntimeStatus {
+	select {
+	case status := <-statuses:
+		return status
+	case <-time.After(subscriptionTimeout):
+		t.Fatal("runtime status was not received")
+		return nil
+	}
+}
@@ -44,6 +44,10 @@ func (u *updater) GetAndUpdateTenant(runtimeID string, ctx context.Context) appe
 	}
 
 	if tenant != dbTenant {
+		// Only admins can move Runtimes between tenants, other callers are isolated to the tenant of the Runtime
+		if caller, ok := middlewares.CallerFromContext(ctx); ok && !caller.Role.Includes(middlewares.RoleAdmin) {
+			return apperrors.Forbidden("Runtime %s does not belong to tenant %s", runtimeID, tenant)
+		}
 		dberr := u.readWriteSession.UpdateTenant(runtimeID, tenant)
 		if dberr != nil {
 			return dberr
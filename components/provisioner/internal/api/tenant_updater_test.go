@@ -64,4 +64,21 @@ func TestTenantUpdater_GetAndUpdateTenant(t *testing.T) {
 		require.NoError(t, err)
 		rwsMock.AssertExpectations(t)
 	})
+
+	t.Run("should not update tenant of Runtime when caller is not admin", func(t *testing.T) {
+		newTenant := "tenant"
+		dbTenant := "tenet"
+		runtimeId := "runtimeID"
+		ctx := context.WithValue(context.Background(), middlewares.Tenant, newTenant)
+		ctx = context.WithValue(ctx, middlewares.CallerKey, middlewares.Caller{Identity: "operator", Role: middlewares.RoleOperator})
+
+		rwsMock := &mocks.ReadWriteSession{}
+		tenantUpdater := NewTenantUpdater(rwsMock)
+
+		rwsMock.On("GetTenant", runtimeId).Return(dbTenant, nil)
+
+		err := tenantUpdater.GetAndUpdateTenant(runtimeId, ctx)
+		require.Error(t, err)
+		rwsMock.AssertNotCalled(t, "UpdateTenant", runtimeId, newTenant)
+	})
 }
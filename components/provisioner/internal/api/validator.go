@@ -1,15 +1,35 @@
 package api
 
 import (
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/networking"
 )
 
-const RuntimeAgent = "compass-runtime-agent"
+const (
+	RuntimeAgent = "compass-runtime-agent"
+
+	// Gardener limits the length of worker group names
+	maxWorkerPoolNameLength = 15
+)
+
+var workerPoolNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// maintenanceWindowLayout is the Gardener time format of the maintenance window boundaries, for example, 220000+0000
+const maintenanceWindowLayout = "150405-0700"
+
+const (
+	// Gardener limits the duration of the maintenance window
+	minMaintenanceWindowDuration = 30 * time.Minute
+	maxMaintenanceWindowDuration = 6 * time.Hour
+)
 
 //go:generate mockery -name=Validator
 type Validator interface {
@@ -76,6 +96,14 @@ func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput)
 		return apperrors.BadRequest("empty purpose provided")
 	}
 
+	if err := v.validateWorkerPools(config.WorkerPools); err != nil {
+		return err
+	}
+
+	if err := v.validateMaintenanceWindow(config.MaintenanceWindow); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -122,6 +150,117 @@ func (v *validator) validateClusterConfig(clusterConfig *gqlschema.ClusterConfig
 		return err
 	}
 
+	if err := v.validateWorkerPools(gardenerConfig.WorkerPools); err != nil {
+		return err
+	}
+
+	for _, pool := range gardenerConfig.WorkerPools {
+		if err := v.validateOpenStackVolume(pool.DiskType, pool.VolumeSizeGb, gardenerConfig.Provider); err != nil {
+			return err
+		}
+	}
+
+	if err := v.validateNetworking(gardenerConfig); err != nil {
+		return err
+	}
+
+	if err := v.validateMaintenanceWindow(gardenerConfig.MaintenanceWindow); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *validator) validateMaintenanceWindow(window *gqlschema.MaintenanceWindowInput) apperrors.AppError {
+	if window == nil {
+		return nil
+	}
+
+	begin, err := time.Parse(maintenanceWindowLayout, window.Begin)
+	if err != nil {
+		return apperrors.BadRequest("error: invalid maintenance window begin %q, expected format HHMMSS+ZZZZ", window.Begin)
+	}
+	end, err := time.Parse(maintenanceWindowLayout, window.End)
+	if err != nil {
+		return apperrors.BadRequest("error: invalid maintenance window end %q, expected format HHMMSS+ZZZZ", window.End)
+	}
+	if duration := maintenanceWindowDuration(begin, end); duration < minMaintenanceWindowDuration || duration > maxMaintenanceWindowDuration {
+		return apperrors.BadRequest("error: maintenance window from %q to %q lasts %s, it must last between %s and %s",
+			window.Begin, window.End, duration, minMaintenanceWindowDuration, maxMaintenanceWindowDuration)
+	}
+
+	return nil
+}
+
+// maintenanceWindowDuration returns the duration of the window, which ends on the next day if the end is before the begin
+func maintenanceWindowDuration(begin, end time.Time) time.Duration {
+	duration := end.Sub(begin) % (24 * time.Hour)
+	if duration < 0 {
+		duration += 24 * time.Hour
+	}
+	return duration
+}
+
+// validateNetworking checks if the custom network ranges are valid and do not overlap with each other
+func (v *validator) validateNetworking(gardenerConfig gqlschema.GardenerConfigInput) apperrors.AppError {
+	input := gardenerConfig.Networking
+	if input == nil {
+		return nil
+	}
+
+	cidrs := networking.CIDRs{Pods: input.Pods, Services: input.Services, Nodes: input.Nodes}
+	if err := cidrs.Validate(gardenerConfig.WorkerCidr, providerNodesCIDR(gardenerConfig)); err != nil {
+		return apperrors.BadRequest("error: %s", err.Error())
+	}
+
+	return nil
+}
+
+// providerNodesCIDR returns the nodes range used by Provisioner when custom one is not provided
+func providerNodesCIDR(gardenerConfig gqlschema.GardenerConfigInput) string {
+	providerConfig := gardenerConfig.ProviderSpecificConfig
+	switch {
+	case providerConfig != nil && providerConfig.AzureConfig != nil:
+		return providerConfig.AzureConfig.VnetCidr
+	case providerConfig != nil && providerConfig.AwsConfig != nil:
+		return providerConfig.AwsConfig.VpcCidr
+	default:
+		return gardenerConfig.WorkerCidr
+	}
+}
+
+func (v *validator) validateWorkerPools(pools []*gqlschema.WorkerPoolInput) apperrors.AppError {
+	names := make(map[string]bool, len(pools))
+	for _, pool := range pools {
+		if pool == nil {
+			return apperrors.BadRequest("error: empty worker pool provided")
+		}
+		if len(pool.Name) > maxWorkerPoolNameLength || !workerPoolNameRegexp.MatchString(pool.Name) {
+			return apperrors.BadRequest("error: invalid worker pool name %q, it must consist of at most %d lower case alphanumeric characters or '-'", pool.Name, maxWorkerPoolNameLength)
+		}
+		if pool.Name == model.MainWorkerPoolName {
+			return apperrors.BadRequest("error: worker pool name %q is reserved for the main worker pool", pool.Name)
+		}
+		if names[pool.Name] {
+			return apperrors.BadRequest("error: worker pool name %q is not unique", pool.Name)
+		}
+		names[pool.Name] = true
+
+		if pool.MachineType == "" {
+			return apperrors.BadRequest("error: empty machine type provided for worker pool %q", pool.Name)
+		}
+		if pool.AutoScalerMin < 0 || pool.AutoScalerMax < pool.AutoScalerMin {
+			return apperrors.BadRequest("error: invalid auto scaler range %d-%d for worker pool %q", pool.AutoScalerMin, pool.AutoScalerMax, pool.Name)
+		}
+		if pool.DiskType != nil && *pool.DiskType == "" {
+			return apperrors.BadRequest("error: empty disk type provided for worker pool %q", pool.Name)
+		}
+		for key, value := range pool.Labels {
+			if _, ok := value.(string); !ok {
+				return apperrors.BadRequest("error: value of label %q of worker pool %q is not a string", key, pool.Name)
+			}
+		}
+	}
 	return nil
 }
 
@@ -151,6 +151,299 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 	})
 }
 
+func TestValidator_ValidateWorkerPools(t *testing.T) {
+	validPool := func() *gqlschema.WorkerPoolInput {
+		return &gqlschema.WorkerPoolInput{
+			Name:          "mem-worker",
+			MachineType:   "m5.4xlarge",
+			AutoScalerMin: 1,
+			AutoScalerMax: 3,
+			Labels:        gqlschema.Labels{"workload": "memory"},
+		}
+	}
+
+	for _, testCase := range []struct {
+		description string
+		pools       func() []*gqlschema.WorkerPoolInput
+		valid       bool
+	}{
+		{
+			description: "should accept valid worker pools",
+			pools: func() []*gqlschema.WorkerPoolInput {
+				other := validPool()
+				other.Name = "gpu-worker"
+				return []*gqlschema.WorkerPoolInput{validPool(), other}
+			},
+			valid: true,
+		},
+		{
+			description: "should reject duplicated name",
+			pools: func() []*gqlschema.WorkerPoolInput {
+				return []*gqlschema.WorkerPoolInput{validPool(), validPool()}
+			},
+		},
+		{
+			description: "should reject name of the main worker pool",
+			pools: func() []*gqlschema.WorkerPoolInput {
+				pool := validPool()
+				pool.Name = "cpu-worker-0"
+				return []*gqlschema.WorkerPoolInput{pool}
+			},
+		},
+		{
+			description: "should reject invalid name",
+			pools: func() []*gqlschema.WorkerPoolInput {
+				pool := validPool()
+				pool.Name = "Memory_Worker"
+				return []*gqlschema.WorkerPoolInput{pool}
+			},
+		},
+		{
+			description: "should reject too long name",
+			pools: func() []*gqlschema.WorkerPoolInput {
+				pool := validPool()
+				pool.Name = "memory-heavy-workers"
+				return []*gqlschema.WorkerPoolInput{pool}
+			},
+		},
+		{
+			description: "should reject empty machine type",
+			pools: func() []*gqlschema.WorkerPoolInput {
+				pool := validPool()
+				pool.MachineType = ""
+				return []*gqlschema.WorkerPoolInput{pool}
+			},
+		},
+		{
+			description: "should reject auto scaler max lower than min",
+			pools: func() []*gqlschema.WorkerPoolInput {
+				pool := validPool()
+				pool.AutoScalerMax = 0
+				return []*gqlschema.WorkerPoolInput{pool}
+			},
+		},
+		{
+			description: "should reject label with non string value",
+			pools: func() []*gqlschema.WorkerPoolInput {
+				pool := validPool()
+				pool.Labels["workload"] = []string{"memory"}
+				return []*gqlschema.WorkerPoolInput{pool}
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			//given
+			validator := NewValidator()
+			clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+			clusterConfig.GardenerConfig.WorkerPools = testCase.pools()
+
+			provisioningInput := gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+				KymaConfig:    kymaConfig,
+			}
+			upgradeInput := gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{
+					WorkerPools: testCase.pools(),
+				},
+			}
+
+			//when
+			provisioningErr := validator.ValidateProvisioningInput(provisioningInput)
+			upgradeErr := validator.ValidateUpgradeShootInput(upgradeInput)
+
+			//then
+			if testCase.valid {
+				require.NoError(t, provisioningErr)
+				require.NoError(t, upgradeErr)
+			} else {
+				require.Error(t, provisioningErr)
+				util.CheckErrorType(t, provisioningErr, apperrors.CodeBadRequest)
+				require.Error(t, upgradeErr)
+				util.CheckErrorType(t, upgradeErr, apperrors.CodeBadRequest)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateNetworking(t *testing.T) {
+	for _, testCase := range []struct {
+		description      string
+		workerCidr       string
+		providerSpecific *gqlschema.ProviderSpecificInput
+		networking       *gqlschema.NetworkingInput
+		valid            bool
+	}{
+		{
+			description: "should accept not overlapping ranges",
+			workerCidr:  "10.250.0.0/19",
+			networking: &gqlschema.NetworkingInput{
+				Pods:     util.StringPtr("100.64.0.0/12"),
+				Services: util.StringPtr("100.104.0.0/13"),
+				Nodes:    util.StringPtr("10.250.0.0/16"),
+			},
+			valid: true,
+		},
+		{
+			description: "should accept pods range only",
+			workerCidr:  "10.250.0.0/19",
+			networking: &gqlschema.NetworkingInput{
+				Pods: util.StringPtr("100.64.0.0/12"),
+			},
+			valid: true,
+		},
+		{
+			description: "should reject invalid pods range",
+			workerCidr:  "10.250.0.0/19",
+			networking: &gqlschema.NetworkingInput{
+				Pods: util.StringPtr("100.64.0.0"),
+			},
+		},
+		{
+			description: "should reject pods range overlapping with services range",
+			workerCidr:  "10.250.0.0/19",
+			networking: &gqlschema.NetworkingInput{
+				Pods:     util.StringPtr("100.64.0.0/12"),
+				Services: util.StringPtr("100.72.0.0/13"),
+			},
+		},
+		{
+			description: "should reject services range overlapping with worker range",
+			workerCidr:  "10.250.0.0/19",
+			networking: &gqlschema.NetworkingInput{
+				Services: util.StringPtr("10.0.0.0/8"),
+			},
+		},
+		{
+			description: "should reject pods range overlapping with Azure virtual network",
+			workerCidr:  "10.250.0.0/19",
+			providerSpecific: &gqlschema.ProviderSpecificInput{
+				AzureConfig: &gqlschema.AzureProviderConfigInput{VnetCidr: "10.250.0.0/16"},
+			},
+			networking: &gqlschema.NetworkingInput{
+				Pods: util.StringPtr("10.250.128.0/17"),
+			},
+		},
+		{
+			description: "should reject nodes range not containing worker range",
+			workerCidr:  "10.250.0.0/19",
+			networking: &gqlschema.NetworkingInput{
+				Nodes: util.StringPtr("10.251.0.0/16"),
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			//given
+			validator := NewValidator()
+			clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+			clusterConfig.GardenerConfig.WorkerCidr = testCase.workerCidr
+			clusterConfig.GardenerConfig.ProviderSpecificConfig = testCase.providerSpecific
+			clusterConfig.GardenerConfig.Networking = testCase.networking
+
+			input := gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+				KymaConfig:    kymaConfig,
+			}
+
+			//when
+			err := validator.ValidateProvisioningInput(input)
+
+			//then
+			if testCase.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateMaintenanceWindow(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		window      *gqlschema.MaintenanceWindowInput
+		valid       bool
+	}{
+		{
+			description: "should accept window in Gardener format",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "220000+0000", End: "230000+0000"},
+			valid:       true,
+		},
+		{
+			description: "should accept window with time zone offset",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "010000+0200", End: "030000+0200"},
+			valid:       true,
+		},
+		{
+			description: "should reject window without time zone offset",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "220000", End: "230000+0000"},
+		},
+		{
+			description: "should reject window in invalid format",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "220000+0000", End: "23:00"},
+		},
+		{
+			description: "should reject empty window",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "220000+0000", End: "220000+0000"},
+		},
+		{
+			description: "should accept window of 30 minutes",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "220000+0000", End: "223000+0000"},
+			valid:       true,
+		},
+		{
+			description: "should reject window shorter than 30 minutes",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "220000+0000", End: "222959+0000"},
+		},
+		{
+			description: "should accept window of 6 hours ending on the next day",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "220000+0000", End: "040000+0000"},
+			valid:       true,
+		},
+		{
+			description: "should reject window longer than 6 hours ending on the next day",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "200000+0000", End: "040000+0000"},
+		},
+		{
+			description: "should reject window longer than 6 hours",
+			window:      &gqlschema.MaintenanceWindowInput{Begin: "010000+0000", End: "070001+0000"},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			//given
+			validator := NewValidator()
+			clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+			clusterConfig.GardenerConfig.MaintenanceWindow = testCase.window
+
+			provisioningInput := gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+				KymaConfig:    kymaConfig,
+			}
+			upgradeShootInput := gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{MaintenanceWindow: testCase.window},
+			}
+
+			//when
+			provisioningErr := validator.ValidateProvisioningInput(provisioningInput)
+			upgradeShootErr := validator.ValidateUpgradeShootInput(upgradeShootInput)
+
+			//then
+			if testCase.valid {
+				require.NoError(t, provisioningErr)
+				require.NoError(t, upgradeShootErr)
+			} else {
+				require.Error(t, provisioningErr)
+				util.CheckErrorType(t, provisioningErr, apperrors.CodeBadRequest)
+				require.Error(t, upgradeShootErr)
+				util.CheckErrorType(t, upgradeShootErr, apperrors.CodeBadRequest)
+			}
+		})
+	}
+}
+
 func TestValidator_ValidateUpgradeInput(t *testing.T) {
 
 	t.Run("Should return nil when input is correct", func(t *testing.T) {
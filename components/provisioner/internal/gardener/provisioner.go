@@ -69,7 +69,7 @@ func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationI
 		purpose = *cluster.ClusterConfig.Purpose
 	}
 
-	if g.shouldSetMaintenanceWindow(purpose) {
+	if g.shouldSetMaintenanceWindow(purpose) && !cluster.ClusterConfig.HasMaintenanceWindow() {
 		err := g.setMaintenanceWindow(shootTemplate, region)
 
 		if err != nil {
@@ -167,6 +167,8 @@ func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, withoutU
 			}
 			return newDeprovisionOperation(operationId, cluster.ID, message, model.InProgress, model.WaitForClusterDeletion, time.Now()), nil
 		}
+		appError := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return model.Operation{}, appError.Append("error getting Shoot")
 	}
 
 	if shoot.DeletionTimestamp != nil {
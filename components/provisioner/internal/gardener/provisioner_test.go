@@ -77,6 +77,25 @@ func TestGardenerProvisioner_ProvisionCluster(t *testing.T) {
 		require.NotNil(t, shoot.Spec.Maintenance.TimeWindow)
 		assert.Equal(t, auditLogsPolicyCMName, shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef.Name)
 	})
+
+	t.Run("should prefer customer maintenance window over the region one", func(t *testing.T) {
+		// given
+		shootClient := fake.NewSimpleClientset().CoreV1beta1().Shoots(gardenerNamespace)
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, maintWindowConfigPath)
+
+		customerCluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, purpose)
+		customerCluster.ClusterConfig.MaintenanceWindowBegin = util.StringPtr("010000+0100")
+		customerCluster.ClusterConfig.MaintenanceWindowEnd = util.StringPtr("020000+0100")
+
+		// when
+		apperr := provisionerClient.ProvisionCluster(customerCluster, operationId)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, &gardener_types.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}, shoot.Spec.Maintenance.TimeWindow)
+	})
 }
 
 func TestGardenerProvisioner_DeprovisionCluster(t *testing.T) {
@@ -3,6 +3,7 @@ package gardener
 import (
 	"fmt"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -17,7 +18,9 @@ import (
 func NewShootController(
 	mgr manager.Manager,
 	dbsFactory dbsession.Factory,
-	auditLogTenantConfigPath string) (*ShootController, error) {
+	auditLogTenantConfigPath string,
+	driftRecorder DriftRecorder,
+	driftAutoRevertFields []model.DriftField) (*ShootController, error) {
 
 	err := gardener_types.AddToScheme(mgr.GetScheme())
 	if err != nil {
@@ -26,7 +29,7 @@ func NewShootController(
 
 	err = ctrl.NewControllerManagedBy(mgr).
 		For(&gardener_types.Shoot{}).
-		Complete(NewReconciler(mgr, dbsFactory, NewAuditLogConfigurator(auditLogTenantConfigPath)))
+		Complete(NewReconciler(mgr, dbsFactory, NewAuditLogConfigurator(auditLogTenantConfigPath), driftRecorder, driftAutoRevertFields))
 	if err != nil {
 		return nil, fmt.Errorf("unable to create controller: %w", err)
 	}
@@ -3,6 +3,7 @@ package gardener
 import (
 	"context"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -18,17 +19,26 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+type DriftRecorder interface {
+	RecordDrift(shootName, runtimeID string, drift []model.DriftedField)
+	ForgetShoot(shootName string)
+}
+
 func NewReconciler(
 	mgr ctrl.Manager,
 	dbsFactory dbsession.Factory,
-	auditLogConfigurator AuditLogConfigurator) *Reconciler {
+	auditLogConfigurator AuditLogConfigurator,
+	driftRecorder DriftRecorder,
+	driftAutoRevertFields []model.DriftField) *Reconciler {
 	return &Reconciler{
 		client: mgr.GetClient(),
 		scheme: mgr.GetScheme(),
 		log:    logrus.WithField("Component", "ShootReconciler"),
 
-		dbsFactory:           dbsFactory,
-		auditLogConfigurator: auditLogConfigurator,
+		dbsFactory:            dbsFactory,
+		auditLogConfigurator:  auditLogConfigurator,
+		driftRecorder:         driftRecorder,
+		driftAutoRevertFields: driftAutoRevertFields,
 	}
 }
 
@@ -39,7 +49,9 @@ type Reconciler struct {
 
 	log *logrus.Entry
 
-	auditLogConfigurator AuditLogConfigurator
+	auditLogConfigurator  AuditLogConfigurator
+	driftRecorder         DriftRecorder
+	driftAutoRevertFields []model.DriftField
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -49,6 +61,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	var shoot gardener_types.Shoot
 	if err := r.client.Get(ctx, req.NamespacedName, &shoot); err != nil {
 		if errors.IsNotFound(err) {
+			r.driftRecorder.ForgetShoot(req.Name)
 			return ctrl.Result{}, nil
 		}
 
@@ -56,7 +69,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	shouldReconcile, err := r.shouldReconcileShoot(shoot)
+	cluster, shouldReconcile, err := r.shouldReconcileShoot(shoot)
 	if err != nil {
 		log.Errorf("Failed to verify if shoot should be reconciled: %s", err.Error())
 		return ctrl.Result{}, err
@@ -76,21 +89,58 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
+	if err := r.reconcileDrift(log, &shoot, cluster); err != nil {
+		log.Warnf("Failed to reconcile drift of %s shoot: %s", shoot.Name, err.Error())
+		return ctrl.Result{}, err
+	}
+
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) shouldReconcileShoot(shoot gardener_types.Shoot) (bool, error) {
+func (r *Reconciler) shouldReconcileShoot(shoot gardener_types.Shoot) (model.Cluster, bool, error) {
 	session := r.dbsFactory.NewReadSession()
 
-	if _, err := session.GetGardenerClusterByName(shoot.Name); err != nil {
+	cluster, err := session.GetGardenerClusterByName(shoot.Name)
+	if err != nil {
 		if err.Code() == dberrors.CodeNotFound {
-			return false, nil
+			return model.Cluster{}, false, nil
 		}
 
-		return false, err
+		return model.Cluster{}, false, err
+	}
+
+	return cluster, !cluster.Deleted, nil
+}
+
+func (r *Reconciler) reconcileDrift(logger logrus.FieldLogger, shoot *gardener_types.Shoot, cluster model.Cluster) error {
+	drift := cluster.ClusterConfig.DetectDrift(*shoot)
+	r.driftRecorder.RecordDrift(shoot.Name, cluster.ID, drift)
+
+	if len(drift) == 0 {
+		return nil
+	}
+	logger.Warnf("Shoot drifted from the stored configuration: %+v", drift)
+
+	if len(r.driftAutoRevertFields) == 0 {
+		return nil
+	}
+
+	// Operations in progress modify the shoot and the stored configuration, reverting could interfere with them
+	lastOperation, err := r.dbsFactory.NewReadSession().GetLastOperation(cluster.ID)
+	if err != nil && err.Code() != dberrors.CodeNotFound {
+		return err
+	}
+	if err == nil && lastOperation.State == model.InProgress {
+		logger.Infof("Operation %s in progress, skipping drift revert", lastOperation.ID)
+		return nil
+	}
+
+	if !cluster.ClusterConfig.RevertDrift(shoot, drift, r.driftAutoRevertFields) {
+		return nil
 	}
 
-	return true, nil
+	logger.Info("Reverting drifted shoot fields")
+	return r.updateShoot(shoot)
 }
 
 func (r *Reconciler) updateShoot(modifiedShoot *gardener_types.Shoot) error {
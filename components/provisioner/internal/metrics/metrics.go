@@ -7,11 +7,16 @@ const (
 	prometheusSubsystem = "provisioner"
 )
 
-func Register(opsStatsGetter OperationsStatsGetter) error {
+func Register(opsStatsGetter OperationsStatsGetter, shootDriftCollector *ShootDriftCollector) error {
 	err := prometheus.Register(NewInProgressOperationsCollector(opsStatsGetter))
 	if err != nil {
 		return err
 	}
 
+	err = prometheus.Register(shootDriftCollector)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
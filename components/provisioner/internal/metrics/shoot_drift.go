@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ShootDriftCollector exposes the shoot fields which differ from the Gardener config stored by Provisioner
+type ShootDriftCollector struct {
+	driftedFields *prometheus.GaugeVec
+
+	mu     sync.Mutex
+	shoots map[string][]prometheus.Labels
+}
+
+func NewShootDriftCollector() *ShootDriftCollector {
+	return &ShootDriftCollector{
+		driftedFields: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "shoot_drifted_fields",
+			Help:      "Shoot fields modified outside of Provisioner, set to 1 for every drifted field",
+		}, []string{"shoot_name", "runtime_id", "field"}),
+		shoots: map[string][]prometheus.Labels{},
+	}
+}
+
+func (c *ShootDriftCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.driftedFields.Describe(ch)
+}
+
+func (c *ShootDriftCollector) Collect(ch chan<- prometheus.Metric) {
+	c.driftedFields.Collect(ch)
+}
+
+// RecordDrift replaces the drift previously recorded for the shoot
+func (c *ShootDriftCollector) RecordDrift(shootName, runtimeID string, drift []model.DriftedField) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.forget(shootName)
+
+	if len(drift) == 0 {
+		return
+	}
+
+	labels := make([]prometheus.Labels, 0, len(drift))
+	for _, field := range drift {
+		fieldLabels := prometheus.Labels{"shoot_name": shootName, "runtime_id": runtimeID, "field": string(field.Field)}
+		c.driftedFields.With(fieldLabels).Set(1)
+		labels = append(labels, fieldLabels)
+	}
+	c.shoots[shootName] = labels
+}
+
+// ForgetShoot removes the drift recorded for the shoot, for example when it was deleted
+func (c *ShootDriftCollector) ForgetShoot(shootName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.forget(shootName)
+}
+
+func (c *ShootDriftCollector) forget(shootName string) {
+	for _, labels := range c.shoots[shootName] {
+		c.driftedFields.Delete(labels)
+	}
+	delete(c.shoots, shootName)
+}
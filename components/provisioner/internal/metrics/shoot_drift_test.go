@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShootDriftCollector(t *testing.T) {
+	collector := NewShootDriftCollector()
+
+	// when
+	collector.RecordDrift("shoot-1", "runtime-1", []model.DriftedField{
+		{Field: model.DriftFieldMachineType, Expected: "small", Actual: "large"},
+		{Field: model.DriftFieldAutoScalerMax, Expected: "3", Actual: "10"},
+	})
+	collector.RecordDrift("shoot-2", "runtime-2", []model.DriftedField{
+		{Field: model.DriftFieldKubernetesVersion, Expected: "1.24", Actual: "1.25"},
+	})
+
+	// then
+	assert.Equal(t, 3, testutil.CollectAndCount(collector, "kcp_provisioner_shoot_drifted_fields"))
+	assert.Equal(t, float64(1), testutil.ToFloat64(collector.driftedFields.WithLabelValues("shoot-2", "runtime-2", "kubernetesVersion")))
+
+	// when
+	collector.RecordDrift("shoot-1", "runtime-1", []model.DriftedField{
+		{Field: model.DriftFieldMachineType, Expected: "small", Actual: "large"},
+	})
+
+	// then
+	assert.Equal(t, 2, testutil.CollectAndCount(collector, "kcp_provisioner_shoot_drifted_fields"))
+
+	// when
+	collector.ForgetShoot("shoot-2")
+	collector.RecordDrift("shoot-1", "runtime-1", nil)
+
+	// then
+	assert.Equal(t, 0, testutil.CollectAndCount(collector, "kcp_provisioner_shoot_drifted_fields"))
+}
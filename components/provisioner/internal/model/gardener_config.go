@@ -66,6 +66,11 @@ type GardenerConfig struct {
 	TargetSecret                        string
 	Region                              string
 	WorkerCidr                          string
+	PodsCidr                            *string
+	ServicesCidr                        *string
+	NodesCidr                           *string
+	MaintenanceWindowBegin              *string
+	MaintenanceWindowEnd                *string
 	AutoScalerMin                       int
 	AutoScalerMax                       int
 	MaxSurge                            int
@@ -79,6 +84,7 @@ type GardenerConfig struct {
 	ShootNetworkingFilterDisabled       *bool
 	ControlPlaneFailureTolerance        *string
 	EuAccess                            bool
+	WorkerPools                         []WorkerPool `db:"-"`
 }
 
 type ExtensionProviderConfig struct {
@@ -190,8 +196,10 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 				},
 			},
 			Networking: gardener_types.Networking{
-				Type:  "calico", // Default value - we may consider adding it to API (if Hydroform will support it)
-				Nodes: util.StringPtr(c.GardenerProviderConfig.NodeCIDR(c)),
+				Type:     "calico", // Default value - we may consider adding it to API (if Hydroform will support it)
+				Nodes:    c.nodesCIDR(),
+				Pods:     c.PodsCidr,
+				Services: c.ServicesCidr,
 			},
 			Purpose:           purpose,
 			ExposureClassName: exposureClassName,
@@ -200,6 +208,7 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 					KubernetesVersion:   c.EnableKubernetesVersionAutoUpdate,
 					MachineImageVersion: c.EnableMachineImageVersionAutoUpdate,
 				},
+				TimeWindow: c.maintenanceTimeWindow(),
 			},
 			DNS: gardenerDnsConfig(dnsInputConfig),
 			Extensions: []gardener_types.Extension{
@@ -219,6 +228,27 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 	return shoot, nil
 }
 
+// nodesCIDR returns the custom nodes range if provided, the one derived from the provider-specific config otherwise
+func (c GardenerConfig) nodesCIDR() *string {
+	if util.NotNilOrEmpty(c.NodesCidr) {
+		return c.NodesCidr
+	}
+	return util.StringPtr(c.GardenerProviderConfig.NodeCIDR(c))
+}
+
+// maintenanceTimeWindow returns the customer maintenance window if provided, nil otherwise
+func (c GardenerConfig) maintenanceTimeWindow() *gardener_types.MaintenanceTimeWindow {
+	if !c.HasMaintenanceWindow() {
+		return nil
+	}
+	return &gardener_types.MaintenanceTimeWindow{Begin: *c.MaintenanceWindowBegin, End: *c.MaintenanceWindowEnd}
+}
+
+// HasMaintenanceWindow indicates whether the customer provided the maintenance window of the cluster
+func (c GardenerConfig) HasMaintenanceWindow() bool {
+	return util.NotNilOrEmpty(c.MaintenanceWindowBegin) && util.NotNilOrEmpty(c.MaintenanceWindowEnd)
+}
+
 func gardenerOidcConfig(oidcConfig *OIDCConfig) *gardener_types.OIDCConfig {
 	if oidcConfig != nil {
 		return &gardener_types.OIDCConfig{
@@ -363,7 +393,7 @@ func (c GCPGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot
 func (c GCPGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = "gcp"
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkers(gardenerConfig, c.input.Zones)
 
 	gcpInfra := NewGCPInfrastructure(gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(gcpInfra)
@@ -511,7 +541,7 @@ func (c AzureGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, sh
 	if len(c.input.AzureZones) > 0 {
 		zoneNames = getAzureZonesNames(c.input.AzureZones)
 	}
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkers(gardenerConfig, zoneNames)
 
 	azInfra := NewAzureInfrastructure(gardenerConfig.WorkerCidr, c)
 	jsonData, err := json.Marshal(azInfra)
@@ -610,7 +640,7 @@ func (c AWSGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoo
 
 	zoneNames := getAWSZonesNames(c.input.AwsZones)
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkers(gardenerConfig, zoneNames)
 
 	awsInfra := NewAWSInfrastructure(c)
 	jsonData, err := json.Marshal(awsInfra)
@@ -675,7 +705,7 @@ func (c OpenStackGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig,
 func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = c.input.CloudProfileName
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkers(gardenerConfig, c.input.Zones)
 
 	openStackInfra := NewOpenStackInfrastructure(c.input.FloatingPoolName, gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(openStackInfra)
@@ -701,7 +731,7 @@ func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig
 
 func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_types.Worker {
 	worker := gardener_types.Worker{
-		Name:           "cpu-worker-0",
+		Name:           MainWorkerPoolName,
 		MaxSurge:       util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxSurge)),
 		MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxUnavailable)),
 		Machine:        getMachineConfig(gardenerConfig),
@@ -733,6 +763,9 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 
 	shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = upgradeConfig.EnableKubernetesVersionAutoUpdate
 	shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = upgradeConfig.EnableMachineImageVersionAutoUpdate
+	if upgradeConfig.HasMaintenanceWindow() {
+		shoot.Spec.Maintenance.TimeWindow = upgradeConfig.maintenanceTimeWindow()
+	}
 
 	if len(shoot.Spec.Provider.Workers) == 0 {
 		return apperrors.Internal("no worker groups assigned to Gardener shoot '%s'", shoot.Name)
@@ -746,7 +779,7 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 		shoot.Spec.Provider.Workers[0].Volume.VolumeSize = fmt.Sprintf("%dGi", *upgradeConfig.VolumeSizeGB)
 	}
 
-	// We support only single working group during provisioning
+	// The first worker group is the main one, additional worker pools are updated below
 	shoot.Spec.Provider.Workers[0].MaxSurge = util.IntOrStringPtr(intstr.FromInt(upgradeConfig.MaxSurge))
 	shoot.Spec.Provider.Workers[0].MaxUnavailable = util.IntOrStringPtr(intstr.FromInt(upgradeConfig.MaxUnavailable))
 	shoot.Spec.Provider.Workers[0].Machine.Type = upgradeConfig.MachineType
@@ -758,6 +791,8 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 	if util.NotNilOrEmpty(upgradeConfig.MachineImageVersion) {
 		shoot.Spec.Provider.Workers[0].Machine.Image.Version = upgradeConfig.MachineImageVersion
 	}
+	updateWorkerPools(upgradeConfig, shoot)
+
 	if upgradeConfig.OIDCConfig != nil {
 		if shoot.Spec.Kubernetes.KubeAPIServer == nil {
 			shoot.Spec.Kubernetes.KubeAPIServer = &gardener_types.KubeAPIServerConfig{}
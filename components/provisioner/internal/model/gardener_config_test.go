@@ -612,6 +612,74 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 
 }
 
+func TestGardenerConfig_ToShootTemplate_Networking(t *testing.T) {
+	awsProviderConfig, err := NewAWSGardenerConfig(fixAWSGardenerInput())
+	require.NoError(t, err)
+
+	t.Run("should use nodes range from provider config when custom one is not provided", func(t *testing.T) {
+		// given
+		gardenerConfig := fixGardenerConfig("aws", awsProviderConfig)
+
+		// when
+		shoot, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Equal(t, gardener_types.Networking{Type: "calico", Nodes: util.StringPtr("10.10.11.11/255")}, shoot.Spec.Networking)
+	})
+
+	t.Run("should use custom network ranges", func(t *testing.T) {
+		// given
+		gardenerConfig := fixGardenerConfig("aws", awsProviderConfig)
+		gardenerConfig.PodsCidr = util.StringPtr("100.64.0.0/12")
+		gardenerConfig.ServicesCidr = util.StringPtr("100.104.0.0/13")
+		gardenerConfig.NodesCidr = util.StringPtr("10.250.0.0/16")
+
+		// when
+		shoot, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Equal(t, gardener_types.Networking{
+			Type:     "calico",
+			Pods:     util.StringPtr("100.64.0.0/12"),
+			Services: util.StringPtr("100.104.0.0/13"),
+			Nodes:    util.StringPtr("10.250.0.0/16"),
+		}, shoot.Spec.Networking)
+	})
+}
+
+func TestGardenerConfig_ToShootTemplate_MaintenanceWindow(t *testing.T) {
+	awsProviderConfig, err := NewAWSGardenerConfig(fixAWSGardenerInput())
+	require.NoError(t, err)
+
+	t.Run("should not set maintenance window when custom one is not provided", func(t *testing.T) {
+		// given
+		gardenerConfig := fixGardenerConfig("aws", awsProviderConfig)
+
+		// when
+		shoot, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Nil(t, shoot.Spec.Maintenance.TimeWindow)
+	})
+
+	t.Run("should use custom maintenance window", func(t *testing.T) {
+		// given
+		gardenerConfig := fixGardenerConfig("aws", awsProviderConfig)
+		gardenerConfig.MaintenanceWindowBegin = util.StringPtr("220000+0000")
+		gardenerConfig.MaintenanceWindowEnd = util.StringPtr("230000+0000")
+
+		// when
+		shoot, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Equal(t, &gardener_types.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}, shoot.Spec.Maintenance.TimeWindow)
+	})
+}
+
 func TestEditShootConfig(t *testing.T) {
 	zones := []string{"fix-zone-1", "fix-zone-2"}
 
@@ -687,6 +755,20 @@ func TestEditShootConfig(t *testing.T) {
 				return shoot
 			}(expectedShoot),
 		},
+		{description: "should update maintenance window",
+			provider: "aws",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.MaintenanceWindowBegin = util.StringPtr("010000+0100")
+				config.MaintenanceWindowEnd = util.StringPtr("020000+0100")
+				return config
+			}(fixGardenerConfig("aws", awsProviderConfig)),
+			initialShoot: initialShoot.DeepCopy(),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Maintenance.TimeWindow = &gardener_types.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}
+				return shoot
+			}(expectedShoot),
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// PageCursor points at the last item of the previous page. Items are ordered by timestamp and ID.
+type PageCursor struct {
+	Timestamp time.Time `json:"timestamp"`
+	ID        string    `json:"id"`
+}
+
+type Page struct {
+	Limit int
+	After *PageCursor
+}
+
+type RuntimeFilter struct {
+	Tenant        *string
+	ShootName     *string
+	Deleted       *bool
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+type OperationFilter struct {
+	Tenant        *string
+	RuntimeID     *string
+	ShootName     *string
+	State         *OperationState
+	Type          *OperationType
+	StartedAfter  *time.Time
+	StartedBefore *time.Time
+}
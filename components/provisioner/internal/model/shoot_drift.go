@@ -0,0 +1,145 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+type DriftField string
+
+const (
+	DriftFieldKubernetesVersion DriftField = "kubernetesVersion"
+	DriftFieldMachineType       DriftField = "machineType"
+	DriftFieldAutoScalerMin     DriftField = "autoScalerMin"
+	DriftFieldAutoScalerMax     DriftField = "autoScalerMax"
+	DriftFieldMaxSurge          DriftField = "maxSurge"
+	DriftFieldMaxUnavailable    DriftField = "maxUnavailable"
+)
+
+// RevertibleDriftFields lists the fields which can be set back to the stored value.
+// Kubernetes version is not included as Gardener does not allow downgrades.
+var RevertibleDriftFields = []DriftField{
+	DriftFieldMachineType,
+	DriftFieldAutoScalerMin,
+	DriftFieldAutoScalerMax,
+	DriftFieldMaxSurge,
+	DriftFieldMaxUnavailable,
+}
+
+// DriftedField describes the difference between the stored Gardener config and the live shoot
+type DriftedField struct {
+	Field    DriftField
+	Expected string
+	Actual   string
+}
+
+// DetectDrift compares the shoot with the config and returns the fields changed outside of Provisioner
+func (c GardenerConfig) DetectDrift(shoot gardener_types.Shoot) []DriftedField {
+	var drift []DriftedField
+	compare := func(field DriftField, expected, actual string) {
+		if expected != actual {
+			drift = append(drift, DriftedField{Field: field, Expected: expected, Actual: actual})
+		}
+	}
+
+	// Gardener updates the patch version of the shoot when the auto update is enabled, so only the minor version can drift
+	if !c.EnableKubernetesVersionAutoUpdate || majorMinorVersion(c.KubernetesVersion) != majorMinorVersion(shoot.Spec.Kubernetes.Version) {
+		compare(DriftFieldKubernetesVersion, c.KubernetesVersion, shoot.Spec.Kubernetes.Version)
+	}
+
+	if len(shoot.Spec.Provider.Workers) == 0 {
+		return drift
+	}
+
+	// The first worker group is the main one
+	worker := shoot.Spec.Provider.Workers[0]
+	compare(DriftFieldMachineType, c.MachineType, worker.Machine.Type)
+	compare(DriftFieldAutoScalerMin, strconv.Itoa(c.AutoScalerMin), strconv.Itoa(int(worker.Minimum)))
+	compare(DriftFieldAutoScalerMax, strconv.Itoa(c.AutoScalerMax), strconv.Itoa(int(worker.Maximum)))
+	compare(DriftFieldMaxSurge, strconv.Itoa(c.MaxSurge), intOrStringToString(worker.MaxSurge))
+	compare(DriftFieldMaxUnavailable, strconv.Itoa(c.MaxUnavailable), intOrStringToString(worker.MaxUnavailable))
+
+	return drift
+}
+
+// RevertDrift sets the selected drifted fields of the shoot back to the values from the config.
+// It returns true if the shoot was modified.
+func (c GardenerConfig) RevertDrift(shoot *gardener_types.Shoot, drift []DriftedField, fields []DriftField) bool {
+	if len(shoot.Spec.Provider.Workers) == 0 {
+		return false
+	}
+
+	selected := make(map[DriftField]bool, len(fields))
+	for _, field := range fields {
+		selected[field] = true
+	}
+
+	worker := &shoot.Spec.Provider.Workers[0]
+	reverted := false
+	for _, drifted := range drift {
+		if !selected[drifted.Field] {
+			continue
+		}
+
+		switch drifted.Field {
+		case DriftFieldMachineType:
+			worker.Machine.Type = c.MachineType
+		case DriftFieldAutoScalerMin:
+			worker.Minimum = int32(c.AutoScalerMin)
+		case DriftFieldAutoScalerMax:
+			worker.Maximum = int32(c.AutoScalerMax)
+		case DriftFieldMaxSurge:
+			worker.MaxSurge = util.IntOrStringPtr(intstr.FromInt(c.MaxSurge))
+		case DriftFieldMaxUnavailable:
+			worker.MaxUnavailable = util.IntOrStringPtr(intstr.FromInt(c.MaxUnavailable))
+		default:
+			continue
+		}
+		reverted = true
+	}
+
+	return reverted
+}
+
+// ParseRevertibleDriftFields converts field names to drift fields, failing on fields which cannot be reverted
+func ParseRevertibleDriftFields(names []string) ([]DriftField, error) {
+	fields := make([]DriftField, 0, len(names))
+	for _, name := range names {
+		field := DriftField(name)
+		if !isRevertibleDriftField(field) {
+			return nil, fmt.Errorf("field %q cannot be reverted, supported fields: %v", name, RevertibleDriftFields)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func isRevertibleDriftField(field DriftField) bool {
+	for _, revertible := range RevertibleDriftFields {
+		if revertible == field {
+			return true
+		}
+	}
+	return false
+}
+
+// majorMinorVersion returns the version without the patch part, for example, 1.25 for 1.25.4
+func majorMinorVersion(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+func intOrStringToString(value *intstr.IntOrString) string {
+	if value == nil {
+		return ""
+	}
+	return value.String()
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGardenerConfig_DetectDrift(t *testing.T) {
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+
+	t.Run("should not detect drift for shoot created from config", func(t *testing.T) {
+		// given
+		shoot, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+		require.NoError(t, appErr)
+
+		// when
+		drift := gardenerConfig.DetectDrift(*shoot)
+
+		// then
+		assert.Empty(t, drift)
+	})
+
+	t.Run("should detect fields modified in shoot", func(t *testing.T) {
+		// given
+		shoot, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+		require.NoError(t, appErr)
+
+		shoot.Spec.Kubernetes.Version = "1.99"
+		shoot.Spec.Provider.Workers[0].Machine.Type = "large"
+		shoot.Spec.Provider.Workers[0].Maximum = 10
+
+		// when
+		drift := gardenerConfig.DetectDrift(*shoot)
+
+		// then
+		assert.Equal(t, []DriftedField{
+			{Field: DriftFieldKubernetesVersion, Expected: gardenerConfig.KubernetesVersion, Actual: "1.99"},
+			{Field: DriftFieldMachineType, Expected: gardenerConfig.MachineType, Actual: "large"},
+			{Field: DriftFieldAutoScalerMax, Expected: "3", Actual: "10"},
+		}, drift)
+	})
+
+	t.Run("should ignore patch version updated by Gardener when auto update is enabled", func(t *testing.T) {
+		// given
+		config := gardenerConfig
+		config.KubernetesVersion = "1.25.4"
+		config.EnableKubernetesVersionAutoUpdate = true
+
+		shoot, appErr := config.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+		require.NoError(t, appErr)
+		shoot.Spec.Kubernetes.Version = "1.25.6"
+
+		// when
+		drift := config.DetectDrift(*shoot)
+
+		// then
+		assert.Empty(t, drift)
+
+		// given
+		shoot.Spec.Kubernetes.Version = "1.26.1"
+
+		// when
+		drift = config.DetectDrift(*shoot)
+
+		// then
+		assert.Equal(t, []DriftedField{
+			{Field: DriftFieldKubernetesVersion, Expected: "1.25.4", Actual: "1.26.1"},
+		}, drift)
+	})
+}
+
+func TestGardenerConfig_RevertDrift(t *testing.T) {
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+
+	// given
+	shoot, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+	require.NoError(t, appErr)
+
+	shoot.Spec.Provider.Workers[0].Machine.Type = "large"
+	shoot.Spec.Provider.Workers[0].Maximum = 10
+	drift := gardenerConfig.DetectDrift(*shoot)
+
+	// when
+	reverted := gardenerConfig.RevertDrift(shoot, drift, []DriftField{DriftFieldMachineType})
+
+	// then
+	assert.True(t, reverted)
+	assert.Equal(t, []DriftedField{{Field: DriftFieldAutoScalerMax, Expected: "3", Actual: "10"}}, gardenerConfig.DetectDrift(*shoot))
+
+	// when
+	reverted = gardenerConfig.RevertDrift(shoot, gardenerConfig.DetectDrift(*shoot), []DriftField{DriftFieldMachineType})
+
+	// then
+	assert.False(t, reverted)
+}
+
+func TestParseRevertibleDriftFields(t *testing.T) {
+	// when
+	fields, err := ParseRevertibleDriftFields([]string{"machineType", "autoScalerMax"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []DriftField{DriftFieldMachineType, DriftFieldAutoScalerMax}, fields)
+
+	// when
+	_, err = ParseRevertibleDriftFields([]string{"kubernetesVersion"})
+
+	// then
+	assert.Error(t, err)
+}
@@ -28,6 +28,7 @@ const (
 	DeprovisionNoInstall OperationType = "DEPROVISION_NO_INSTALL"
 	ReconnectRuntime     OperationType = "RECONNECT_RUNTIME"
 	Hibernate            OperationType = "HIBERNATE"
+	WakeUp               OperationType = "WAKE_UP"
 )
 
 type OperationStage string
@@ -53,10 +54,41 @@ const (
 	WaitingForShootNewVersion OperationStage = "WaitingForShootNewVersion"
 
 	WaitForHibernation OperationStage = "WaitForHibernation"
+	WaitForWakeUp      OperationStage = "WaitForWakeUp"
 
 	FinishedStage OperationStage = "Finished"
 )
 
+var AllOperationStages = []OperationStage{
+	WaitingForClusterDomain,
+	WaitingForClusterCreation,
+	CreatingBindingsForOperators,
+	StartingInstallation,
+	WaitingForInstallation,
+	ConnectRuntimeAgent,
+	WaitForAgentToConnect,
+	TriggerKymaUninstall,
+	WaitForClusterDeletion,
+	DeleteCluster,
+	CleanupCluster,
+	StartingUpgrade,
+	UpdatingUpgradeState,
+	WaitingForShootUpgrade,
+	WaitingForShootNewVersion,
+	WaitForHibernation,
+	WaitForWakeUp,
+	FinishedStage,
+}
+
+func (s OperationStage) IsValid() bool {
+	for _, stage := range AllOperationStages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
 type Cluster struct {
 	ID                 string
 	Kubeconfig         *string
@@ -90,6 +122,8 @@ type Operation struct {
 	Stage          OperationStage
 	LastTransition *time.Time
 	LastError
+	// RollbackOperationID points to the deprovisioning operation reverting the failed operation
+	RollbackOperationID *string
 }
 
 type RuntimeAgentConnectionStatus int
@@ -114,4 +148,6 @@ type OperationsCount struct {
 type HibernationStatus struct {
 	Hibernated          bool
 	HibernationPossible bool
+	HibernatedAt        *time.Time
+	WokenUpAt           *time.Time
 }
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"fmt"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+// MainWorkerPoolName is the name of the worker group configured with the machine type and auto scaler settings of GardenerConfig
+const MainWorkerPoolName = "cpu-worker-0"
+
+// WorkerPool is an additional worker group of the shoot
+type WorkerPool struct {
+	Name          string            `json:"name"`
+	MachineType   string            `json:"machineType"`
+	AutoScalerMin int               `json:"autoScalerMin"`
+	AutoScalerMax int               `json:"autoScalerMax"`
+	Zones         []string          `json:"zones,omitempty"`
+	DiskType      *string           `json:"diskType,omitempty"`
+	VolumeSizeGB  *int              `json:"volumeSizeGB,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	Taints        []Taint           `json:"taints,omitempty"`
+}
+
+type Taint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+func getWorkers(gardenerConfig GardenerConfig, zones []string) []gardener_types.Worker {
+	mainWorker := getWorkerConfig(gardenerConfig, zones)
+
+	workers := []gardener_types.Worker{mainWorker}
+	for _, pool := range gardenerConfig.WorkerPools {
+		worker := gardener_types.Worker{}
+		applyWorkerPool(pool, gardenerConfig, mainWorker, &worker)
+		workers = append(workers, worker)
+	}
+
+	return workers
+}
+
+// updateWorkerPools replaces the additional worker groups of the shoot keeping the settings of the existing groups not managed by Provisioner
+func updateWorkerPools(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot) {
+	if upgradeConfig.WorkerPools == nil {
+		return
+	}
+
+	existing := make(map[string]gardener_types.Worker)
+	for _, worker := range shoot.Spec.Provider.Workers[1:] {
+		existing[worker.Name] = worker
+	}
+
+	mainWorker := shoot.Spec.Provider.Workers[0]
+	workers := []gardener_types.Worker{mainWorker}
+	for _, pool := range upgradeConfig.WorkerPools {
+		worker := existing[pool.Name]
+		applyWorkerPool(pool, upgradeConfig, mainWorker, &worker)
+		workers = append(workers, worker)
+	}
+
+	shoot.Spec.Provider.Workers = workers
+}
+
+func applyWorkerPool(pool WorkerPool, gardenerConfig GardenerConfig, mainWorker gardener_types.Worker, worker *gardener_types.Worker) {
+	worker.Name = pool.Name
+	worker.Machine.Type = pool.MachineType
+	worker.Machine.Image = mainWorker.Machine.Image.DeepCopy()
+	worker.Minimum = int32(pool.AutoScalerMin)
+	worker.Maximum = int32(pool.AutoScalerMax)
+	worker.MaxSurge = util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxSurge))
+	worker.MaxUnavailable = util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxUnavailable))
+	worker.Labels = pool.Labels
+	worker.Taints = toCoreTaints(pool.Taints)
+
+	worker.Zones = mainWorker.Zones
+	if len(pool.Zones) > 0 {
+		worker.Zones = pool.Zones
+	}
+
+	volume := gardener_types.Volume{}
+	if mainWorker.Volume != nil {
+		volume = *mainWorker.Volume.DeepCopy()
+	}
+	if util.NotNilOrEmpty(pool.DiskType) {
+		volume.Type = pool.DiskType
+	}
+	if pool.VolumeSizeGB != nil {
+		volume.VolumeSize = fmt.Sprintf("%dGi", *pool.VolumeSizeGB)
+	}
+	worker.Volume = nil
+	if volume.VolumeSize != "" {
+		worker.Volume = &volume
+	}
+}
+
+func toCoreTaints(taints []Taint) []corev1.Taint {
+	if len(taints) == 0 {
+		return nil
+	}
+
+	coreTaints := make([]corev1.Taint, 0, len(taints))
+	for _, taint := range taints {
+		coreTaints = append(coreTaints, corev1.Taint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: corev1.TaintEffect(taint.Effect),
+		})
+	}
+	return coreTaints
+}
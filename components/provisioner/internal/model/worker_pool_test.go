@@ -0,0 +1,157 @@
+package model
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestGardenerConfig_ToShootTemplate_WorkerPools(t *testing.T) {
+	// given
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+	gardenerConfig.WorkerPools = []WorkerPool{
+		fixMemoryWorkerPool(),
+		{
+			Name:          "zonal-worker",
+			MachineType:   "small",
+			AutoScalerMin: 0,
+			AutoScalerMax: 1,
+			Zones:         []string{"fix-zone-2"},
+			VolumeSizeGB:  util.IntPtr(100),
+		},
+	}
+
+	// when
+	shoot, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+
+	// then
+	require.NoError(t, appErr)
+	require.Len(t, shoot.Spec.Provider.Workers, 3)
+	assert.Equal(t, fixWorker(zones), shoot.Spec.Provider.Workers[0])
+	assert.Equal(t, fixMemoryWorker(zones), shoot.Spec.Provider.Workers[1])
+
+	zonalWorker := shoot.Spec.Provider.Workers[2]
+	assert.Equal(t, "zonal-worker", zonalWorker.Name)
+	assert.Equal(t, []string{"fix-zone-2"}, zonalWorker.Zones)
+	assert.Equal(t, &gardener_types.Volume{Type: util.StringPtr("SSD"), VolumeSize: "100Gi"}, zonalWorker.Volume)
+	assert.Nil(t, zonalWorker.Taints)
+}
+
+func TestEditShootConfig_WorkerPools(t *testing.T) {
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	initialShoot := func() *gardener_types.Shoot {
+		existingWorker := fixMemoryWorker(zones)
+		existingWorker.CRI = &gardener_types.CRI{Name: gardener_types.CRINameContainerD}
+
+		return &gardener_types.Shoot{
+			Spec: gardener_types.ShootSpec{
+				Kubernetes: gardener_types.Kubernetes{KubeAPIServer: &gardener_types.KubeAPIServerConfig{}},
+				Maintenance: &gardener_types.Maintenance{
+					AutoUpdate: &gardener_types.MaintenanceAutoUpdate{},
+				},
+				Provider: gardener_types.Provider{
+					Workers: []gardener_types.Worker{fixWorker(zones), existingWorker, fixWorker(zones)},
+				},
+			},
+		}
+	}
+
+	t.Run("should keep additional worker groups when worker pools are not provided", func(t *testing.T) {
+		// given
+		shoot := initialShoot()
+		upgradeConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+
+		// when
+		appErr := upgradeConfig.GardenerProviderConfig.EditShootConfig(upgradeConfig, shoot)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Len(t, shoot.Spec.Provider.Workers, 3)
+	})
+
+	t.Run("should update existing worker pool and remove the missing ones", func(t *testing.T) {
+		// given
+		shoot := initialShoot()
+		upgradeConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+
+		pool := fixMemoryWorkerPool()
+		pool.AutoScalerMax = 10
+		upgradeConfig.WorkerPools = []WorkerPool{pool}
+
+		// when
+		appErr := upgradeConfig.GardenerProviderConfig.EditShootConfig(upgradeConfig, shoot)
+
+		// then
+		require.NoError(t, appErr)
+		require.Len(t, shoot.Spec.Provider.Workers, 2)
+
+		expectedWorker := fixMemoryWorker(zones)
+		expectedWorker.Maximum = 10
+		expectedWorker.CRI = &gardener_types.CRI{Name: gardener_types.CRINameContainerD}
+		assert.Equal(t, expectedWorker, shoot.Spec.Provider.Workers[1])
+	})
+
+	t.Run("should remove all additional worker groups when empty worker pools are provided", func(t *testing.T) {
+		// given
+		shoot := initialShoot()
+		upgradeConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+		upgradeConfig.WorkerPools = []WorkerPool{}
+
+		// when
+		appErr := upgradeConfig.GardenerProviderConfig.EditShootConfig(upgradeConfig, shoot)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Len(t, shoot.Spec.Provider.Workers, 1)
+	})
+}
+
+func fixMemoryWorkerPool() WorkerPool {
+	return WorkerPool{
+		Name:          "mem-worker",
+		MachineType:   "memory-machine",
+		AutoScalerMin: 2,
+		AutoScalerMax: 5,
+		DiskType:      util.StringPtr("HDD"),
+		Labels:        map[string]string{"workload": "memory"},
+		Taints:        []Taint{{Key: "dedicated", Value: "memory", Effect: "NoSchedule"}},
+	}
+}
+
+func fixMemoryWorker(zones []string) gardener_types.Worker {
+	return gardener_types.Worker{
+		Name:           "mem-worker",
+		MaxSurge:       util.IntOrStringPtr(intstr.FromInt(30)),
+		MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(1)),
+		Machine: gardener_types.Machine{
+			Type: "memory-machine",
+			Image: &gardener_types.ShootMachineImage{
+				Name:    "gardenlinux",
+				Version: util.StringPtr("25.0.0"),
+			},
+		},
+		Volume: &gardener_types.Volume{
+			Type:       util.StringPtr("HDD"),
+			VolumeSize: "30Gi",
+		},
+		Maximum: 5,
+		Minimum: 2,
+		Zones:   zones,
+		Labels:  map[string]string{"workload": "memory"},
+		Taints:  []corev1.Taint{{Key: "dedicated", Value: "memory", Effect: corev1.TaintEffectNoSchedule}},
+	}
+}
@@ -27,7 +27,9 @@ func NewExecutor(
 	operation model.OperationType,
 	stages map[model.OperationStage]Step,
 	failureHandler FailureHandler,
-	directorClient director.DirectorClient) *Executor {
+	directorClient director.DirectorClient,
+	notifier StatusNotifier,
+	policies StagePolicies) *Executor {
 
 	return &Executor{
 		dbSession:      session,
@@ -36,6 +38,8 @@ func NewExecutor(
 		failureHandler: failureHandler,
 		log:            logrus.WithFields(logrus.Fields{"Component": "Executor", "OperationType": operation}),
 		directorClient: directorClient,
+		notifier:       notifier,
+		policies:       policies,
 	}
 }
 
@@ -45,6 +49,8 @@ type Executor struct {
 	operation      model.OperationType
 	failureHandler FailureHandler
 	directorClient director.DirectorClient
+	notifier       StatusNotifier
+	policies       StagePolicies
 
 	log logrus.FieldLogger
 }
@@ -76,20 +82,19 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 	log = log.WithField("ShootName", cluster.ClusterConfig.Name)
 
 	if operation.Type == e.operation {
-		requeue, delay, err := e.process(operation, cluster, log)
+		requeue, delay, err := e.process(&operation, cluster, log)
 		e.updateOperationLastError(log, operation.ID, err)
 		if err != nil {
 			nonRecoverable := NonRecoverableError{}
 			if errors.As(err, &nonRecoverable) {
 				log.Errorf("unrecoverable error occurred while processing operation: %s", err.Error())
-				e.handleOperationFailure(operation, cluster, log)
-				e.updateOperationStatus(log, operation.ID, nonRecoverable.Error(), model.Failed, time.Now())
-				e.setRuntimeStatusCondition(log, cluster.ID, cluster.Tenant)
+				e.handleNonRecoverableError(operation, cluster, nonRecoverable, log)
 
 				return ProcessingResult{Requeue: false}
 			}
 
-			return ProcessingResult{Requeue: true, Delay: defaultDelay}
+			e.notifier.OperationChanged(operation.ID, operation.ClusterID)
+			return ProcessingResult{Requeue: true, Delay: e.policies.retryBackoff(operation.Stage)}
 		}
 
 		return ProcessingResult{Requeue: requeue, Delay: delay}
@@ -101,7 +106,7 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 	}
 }
 
-func (e *Executor) process(operation model.Operation, cluster model.Cluster, logger logrus.FieldLogger) (bool, time.Duration, error) {
+func (e *Executor) process(operation *model.Operation, cluster model.Cluster, logger logrus.FieldLogger) (bool, time.Duration, error) {
 
 	step, found := e.stages[operation.Stage]
 	if !found {
@@ -113,12 +118,12 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 		log := logger.WithField("Stage", step.Name())
 		log.Infof("Starting processing")
 
-		if e.timeoutReached(operation, step.TimeLimit()) {
+		if e.timeoutReached(*operation, e.policies.timeLimit(step)) {
 			log.Errorf("Timeout reached for operation")
 			return false, 0, NewNonRecoverableError(apperrors.Internal("error: timeout while processing operation").SetReason(apperrors.ErrProvisionerTimeout))
 		}
 
-		result, err := step.Run(cluster, operation, log)
+		result, err := step.Run(cluster, *operation, log)
 		if err != nil {
 			if errors.Is(err, ErrKubeconfigNil) {
 				log.Warnf("Warning, the %s", err)
@@ -131,6 +136,7 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 		if result.Stage == model.FinishedStage {
 			log.Infof("Finished processing operation")
 			e.updateOperationStage(log, operation.ID, "Provisioning steps finished", model.FinishedStage, time.Now())
+			e.notifier.OperationChanged(operation.ID, operation.ClusterID)
 			break
 		}
 
@@ -140,6 +146,7 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 			step = e.stages[result.Stage]
 			operation.Stage = result.Stage
 			operation.LastTransition = &transitionTime
+			e.notifier.OperationChanged(operation.ID, operation.ClusterID)
 			log.Infof("Stage completed")
 		}
 
@@ -150,6 +157,7 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 
 	logger.Infof("Setting operation to succeeded")
 	e.updateOperationStatus(logger, operation.ID, "Operation succeeded", model.Succeeded, time.Now())
+	e.notifier.OperationChanged(operation.ID, operation.ClusterID)
 
 	return false, 0, nil
 }
@@ -166,6 +174,43 @@ func (e *Executor) timeoutReached(operation model.Operation, timeout time.Durati
 	return timePassed > timeout
 }
 
+func (e *Executor) handleNonRecoverableError(operation model.Operation, cluster model.Cluster, nonRecoverable NonRecoverableError, logger logrus.FieldLogger) {
+	action := e.policies.failureAction(operation.Stage)
+	log := logger.WithFields(logrus.Fields{"Stage": operation.Stage, "FailureAction": action})
+
+	message := nonRecoverable.Error()
+	if action == FailureActionMarkForManual {
+		log.Warnf("Operation marked for manual intervention, skipping failure handling")
+		message = fmt.Sprintf("Manual intervention required: %s", message)
+	} else {
+		e.handleOperationFailure(operation, cluster, log)
+	}
+
+	e.updateOperationStatus(log, operation.ID, message, model.Failed, time.Now())
+	e.setRuntimeStatusCondition(log, cluster.ID, cluster.Tenant)
+
+	if action == FailureActionRollback {
+		e.rollbackOperation(operation, cluster, log)
+	}
+
+	e.notifier.OperationChanged(operation.ID, operation.ClusterID)
+}
+
+func (e *Executor) rollbackOperation(operation model.Operation, cluster model.Cluster, log logrus.FieldLogger) {
+	rollbackHandler, ok := e.failureHandler.(RollbackHandler)
+	if !ok {
+		log.Warnf("Rollback is not supported for %s operation", e.operation)
+		return
+	}
+
+	err := retry.Do(func() error {
+		return rollbackHandler.Rollback(operation, cluster)
+	}, retry.Attempts(5))
+	if err != nil {
+		log.Errorf("error rolling back operation: %s", err.Error())
+	}
+}
+
 func (e *Executor) handleOperationFailure(operation model.Operation, cluster model.Cluster, log logrus.FieldLogger) {
 	err := retry.Do(func() error {
 		return e.failureHandler.HandleFailure(operation, cluster)
@@ -12,6 +12,7 @@ import (
 	directorMocks "github.com/kyma-project/control-plane/components/provisioner/internal/director/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/notification"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
@@ -62,7 +63,11 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		directorClient := &directorMocks.DirectorClient{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient)
+		broadcaster := notification.NewBroadcaster()
+		operationChanged, cancel := broadcaster.Subscribe(operationId)
+		defer cancel()
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, broadcaster, StagePolicies{})
 
 		// when
 		result := executor.Execute(operationId)
@@ -70,6 +75,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		// then
 		assert.Equal(t, false, result.Requeue)
 		assert.True(t, mockStage.called)
+		assert.Len(t, operationChanged, 1)
 	})
 
 	t.Run("should requeue operation if error occurred", func(t *testing.T) {
@@ -88,7 +94,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		directorClient := &directorMocks.DirectorClient{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, notification.NewBroadcaster(), StagePolicies{})
 
 		// when
 		result := executor.Execute(operationId)
@@ -119,7 +125,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, notification.NewBroadcaster(), StagePolicies{})
 
 		// when
 		result := executor.Execute(operationId)
@@ -151,7 +157,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, notification.NewBroadcaster(), StagePolicies{})
 
 		// when
 		result := executor.Execute(operationId)
@@ -184,7 +190,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, notification.NewBroadcaster(), StagePolicies{})
 
 		// when
 		result := executor.Execute(operationId)
@@ -196,6 +202,118 @@ func TestStagesExecutor_Execute(t *testing.T) {
 	})
 }
 
+func TestStagesExecutor_Execute_StagePolicies(t *testing.T) {
+
+	tNow := time.Now()
+
+	operation := model.Operation{
+		ID:             operationId,
+		Type:           model.Provision,
+		StartTimestamp: tNow,
+		State:          model.InProgress,
+		ClusterID:      clusterId,
+		Stage:          model.WaitingForInstallation,
+		LastTransition: &tNow,
+	}
+
+	cluster := model.Cluster{ID: clusterId}
+
+	t.Run("should use time limit and retry backoff from stage policy", func(t *testing.T) {
+		// given
+		runErr := fmt.Errorf("error")
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+
+		mockStage := NewErrorStep(model.WaitingForInstallation, runErr, 0)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		policies := StagePolicies{
+			model.WaitingForInstallation: {TimeLimit: time.Hour, RetryBackoff: 30 * time.Second},
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), &directorMocks.DirectorClient{}, notification.NewBroadcaster(), policies)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.True(t, result.Requeue)
+		assert.Equal(t, 30*time.Second, result.Delay)
+		assert.True(t, mockStage.called)
+	})
+
+	t.Run("should mark operation for manual intervention without running failure handler", func(t *testing.T) {
+		// given
+		runErr := NewNonRecoverableError(apperrors.External("gardener error").SetComponent(apperrors.ErrGardener).SetReason("ERR_INFRA_QUOTA_EXCEEDED"))
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationState", operationId, "Manual intervention required: gardener error", model.Failed, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "gardener error", "ERR_INFRA_QUOTA_EXCEEDED", string(apperrors.ErrGardener)).Return(nil)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewErrorStep(model.WaitingForInstallation, runErr, 10*time.Second),
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+		directorClient.On("SetRuntimeStatusCondition", clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(nil)
+
+		failureHandler := MockRollbackFailureHandler{}
+		policies := StagePolicies{
+			model.WaitingForInstallation: {OnFailure: FailureActionMarkForManual},
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, notification.NewBroadcaster(), policies)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.False(t, failureHandler.called)
+		assert.False(t, failureHandler.rolledBack)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should roll back operation when timeout reached", func(t *testing.T) {
+		// given
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationState", operationId, "error: timeout while processing operation", model.Failed, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "error: timeout while processing operation", string(apperrors.ErrProvisionerTimeout), string(apperrors.ErrProvisioner)).Return(nil)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewMockStep(model.WaitingForInstallation, model.ConnectRuntimeAgent, 0, time.Hour),
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+		directorClient.On("SetRuntimeStatusCondition", clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(nil)
+
+		failureHandler := MockRollbackFailureHandler{}
+		policies := StagePolicies{
+			model.WaitingForInstallation: {TimeLimit: time.Nanosecond, OnFailure: FailureActionRollback},
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, notification.NewBroadcaster(), policies)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.True(t, failureHandler.called)
+		assert.True(t, failureHandler.rolledBack)
+	})
+}
+
 type mockStep struct {
 	name      model.OperationStage
 	next      model.OperationStage
@@ -254,6 +372,16 @@ func (m *MockFailureHandler) HandleFailure(operation model.Operation, cluster mo
 	return nil
 }
 
+type MockRollbackFailureHandler struct {
+	MockFailureHandler
+	rolledBack bool
+}
+
+func (m *MockRollbackFailureHandler) Rollback(operation model.Operation, cluster model.Cluster) error {
+	m.rolledBack = true
+	return nil
+}
+
 func TestConvertToAppError(t *testing.T) {
 	t.Run("should convert to app error", func(t *testing.T) {
 		//given
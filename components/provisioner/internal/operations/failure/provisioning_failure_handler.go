@@ -0,0 +1,80 @@
+package failure
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=ClusterDeprovisioner
+type ClusterDeprovisioner interface {
+	DeprovisionCluster(cluster model.Cluster, withoutUninstall bool, operationId string) (model.Operation, apperrors.AppError)
+}
+
+//go:generate mockery -name=OperationQueue
+type OperationQueue interface {
+	Add(processId string)
+}
+
+// ProvisioningFailureHandler rolls back failed provisioning by deleting the shoot and deregistering the Runtime from Director.
+// The cleanup is done by the deprovisioning operation linked to the failed one.
+type ProvisioningFailureHandler struct {
+	dbsFactory                   dbsession.Factory
+	deprovisioner                ClusterDeprovisioner
+	deprovisioningNoInstallQueue OperationQueue
+	uuidGenerator                uuid.UUIDGenerator
+}
+
+func NewProvisioningFailureHandler(dbsFactory dbsession.Factory, deprovisioner ClusterDeprovisioner, deprovisioningNoInstallQueue OperationQueue, uuidGenerator uuid.UUIDGenerator) *ProvisioningFailureHandler {
+	return &ProvisioningFailureHandler{
+		dbsFactory:                   dbsFactory,
+		deprovisioner:                deprovisioner,
+		deprovisioningNoInstallQueue: deprovisioningNoInstallQueue,
+		uuidGenerator:                uuidGenerator,
+	}
+}
+
+func (h ProvisioningFailureHandler) HandleFailure(_ model.Operation, _ model.Cluster) error {
+	return nil
+}
+
+func (h ProvisioningFailureHandler) Rollback(operation model.Operation, cluster model.Cluster) error {
+	if operation.RollbackOperationID != nil {
+		log.Infof("Provisioning operation %s already rolled back by operation %s", operation.ID, *operation.RollbackOperationID)
+		return nil
+	}
+
+	deprovisioning, err := h.deprovisioner.DeprovisionCluster(cluster, true, h.uuidGenerator.New())
+	if err != nil {
+		return errors.Wrap(err, "error starting deprovisioning of the cluster")
+	}
+
+	session, dberr := h.dbsFactory.NewSessionWithinTransaction()
+	if dberr != nil {
+		return errors.Wrap(dberr, "error starting db session with transaction")
+	}
+	defer session.RollbackUnlessCommitted()
+
+	dberr = session.InsertOperation(deprovisioning)
+	if dberr != nil {
+		return errors.Wrap(dberr, "error inserting deprovisioning operation")
+	}
+
+	dberr = session.SetOperationRollback(operation.ID, deprovisioning.ID)
+	if dberr != nil {
+		return errors.Wrap(dberr, "error linking deprovisioning operation")
+	}
+
+	dberr = session.Commit()
+	if dberr != nil {
+		return errors.Wrap(dberr, "error commiting transaction")
+	}
+
+	log.Infof("Rolling back provisioning operation %s of runtime %s with deprovisioning operation %s", operation.ID, cluster.ID, deprovisioning.ID)
+	h.deprovisioningNoInstallQueue.Add(deprovisioning.ID)
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package failure
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	dbMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	uuidMocks "github.com/kyma-project/control-plane/components/provisioner/internal/uuid/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operationID       = "operationID"
+	rollbackOperation = "rollbackOperationID"
+	runtimeID         = "runtimeID"
+)
+
+func TestProvisioningFailureHandler_Rollback(t *testing.T) {
+	operation := model.Operation{ID: operationID, Type: model.Provision, ClusterID: runtimeID}
+	cluster := model.Cluster{ID: runtimeID, ClusterConfig: model.GardenerConfig{Name: "shoot"}}
+	deprovisioning := model.Operation{ID: rollbackOperation, Type: model.DeprovisionNoInstall, ClusterID: runtimeID, Stage: model.DeleteCluster}
+
+	t.Run("should start linked deprovisioning operation", func(t *testing.T) {
+		// given
+		deprovisioner := &mocks.ClusterDeprovisioner{}
+		deprovisioner.On("DeprovisionCluster", cluster, true, rollbackOperation).Return(deprovisioning, nil)
+
+		session := &dbMocks.WriteSessionWithinTransaction{}
+		session.On("InsertOperation", deprovisioning).Return(nil)
+		session.On("SetOperationRollback", operationID, rollbackOperation).Return(nil)
+		session.On("Commit").Return(nil)
+		session.On("RollbackUnlessCommitted").Return()
+
+		factory := &dbMocks.Factory{}
+		factory.On("NewSessionWithinTransaction").Return(session, nil)
+
+		queue := &mocks.OperationQueue{}
+		queue.On("Add", rollbackOperation).Return()
+
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		uuidGenerator.On("New").Return(rollbackOperation)
+
+		handler := NewProvisioningFailureHandler(factory, deprovisioner, queue, uuidGenerator)
+
+		// when
+		err := handler.Rollback(operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		deprovisioner.AssertExpectations(t)
+		session.AssertExpectations(t)
+		queue.AssertExpectations(t)
+	})
+
+	t.Run("should not start deprovisioning when operation already rolled back", func(t *testing.T) {
+		// given
+		rolledBack := operation
+		rolledBack.RollbackOperationID = &deprovisioning.ID
+
+		deprovisioner := &mocks.ClusterDeprovisioner{}
+		queue := &mocks.OperationQueue{}
+
+		handler := NewProvisioningFailureHandler(&dbMocks.Factory{}, deprovisioner, queue, &uuidMocks.UUIDGenerator{})
+
+		// when
+		err := handler.Rollback(rolledBack, cluster)
+
+		// then
+		require.NoError(t, err)
+		deprovisioner.AssertNotCalled(t, "DeprovisionCluster")
+		queue.AssertNotCalled(t, "Add")
+	})
+
+	t.Run("should return error and not enqueue operation when linking fails", func(t *testing.T) {
+		// given
+		deprovisioner := &mocks.ClusterDeprovisioner{}
+		deprovisioner.On("DeprovisionCluster", cluster, true, rollbackOperation).Return(deprovisioning, nil)
+
+		session := &dbMocks.WriteSessionWithinTransaction{}
+		session.On("InsertOperation", deprovisioning).Return(nil)
+		session.On("SetOperationRollback", operationID, rollbackOperation).Return(dberrors.Internal("error"))
+		session.On("RollbackUnlessCommitted").Return()
+
+		factory := &dbMocks.Factory{}
+		factory.On("NewSessionWithinTransaction").Return(session, nil)
+
+		queue := &mocks.OperationQueue{}
+
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		uuidGenerator.On("New").Return(rollbackOperation)
+
+		handler := NewProvisioningFailureHandler(factory, deprovisioner, queue, uuidGenerator)
+
+		// when
+		err := handler.Rollback(operation, cluster)
+
+		// then
+		require.Error(t, err)
+		session.AssertNotCalled(t, "Commit")
+		queue.AssertNotCalled(t, "Add")
+	})
+
+	t.Run("should return error when deprovisioning cannot be started", func(t *testing.T) {
+		// given
+		deprovisioner := &mocks.ClusterDeprovisioner{}
+		deprovisioner.On("DeprovisionCluster", cluster, true, rollbackOperation).Return(model.Operation{}, apperrors.Internal("error"))
+
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		uuidGenerator.On("New").Return(rollbackOperation)
+
+		handler := NewProvisioningFailureHandler(&dbMocks.Factory{}, deprovisioner, &mocks.OperationQueue{}, uuidGenerator)
+
+		// when
+		err := handler.Rollback(operation, cluster)
+
+		// then
+		assert.Error(t, err)
+	})
+}
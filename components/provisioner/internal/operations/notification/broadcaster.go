@@ -0,0 +1,59 @@
+package notification
+
+import (
+	"sync"
+)
+
+// Broadcaster notifies subscribers about changes of operations and Runtimes.
+// Notifications carry no payload, subscribers are expected to read the current state on their own.
+type Broadcaster struct {
+	mutex       sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe returns a channel signalled every time the operation or Runtime with the given ID changes
+// and a function cancelling the subscription. Signals are coalesced, so a slow subscriber does not block the notifier.
+func (b *Broadcaster) Subscribe(id string) (<-chan struct{}, func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	signals := make(chan struct{}, 1)
+	if b.subscribers[id] == nil {
+		b.subscribers[id] = make(map[chan struct{}]struct{})
+	}
+	b.subscribers[id][signals] = struct{}{}
+
+	return signals, func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		delete(b.subscribers[id], signals)
+		if len(b.subscribers[id]) == 0 {
+			delete(b.subscribers, id)
+		}
+	}
+}
+
+// OperationChanged notifies the subscribers of the operation and of the Runtime it belongs to
+func (b *Broadcaster) OperationChanged(operationID, runtimeID string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.signal(operationID)
+	b.signal(runtimeID)
+}
+
+func (b *Broadcaster) signal(id string) {
+	for signals := range b.subscribers[id] {
+		select {
+		case signals <- struct{}{}:
+		default:
+		}
+	}
+}
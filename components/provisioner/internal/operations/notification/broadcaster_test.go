@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcaster(t *testing.T) {
+	t.Run("should notify subscribers of operation and runtime", func(t *testing.T) {
+		// given
+		broadcaster := NewBroadcaster()
+		operationSignals, cancelOperation := broadcaster.Subscribe("operation")
+		defer cancelOperation()
+		runtimeSignals, cancelRuntime := broadcaster.Subscribe("runtime")
+		defer cancelRuntime()
+		otherSignals, cancelOther := broadcaster.Subscribe("other")
+		defer cancelOther()
+
+		// when
+		broadcaster.OperationChanged("operation", "runtime")
+
+		// then
+		assert.Len(t, operationSignals, 1)
+		assert.Len(t, runtimeSignals, 1)
+		assert.Len(t, otherSignals, 0)
+	})
+
+	t.Run("should coalesce signals", func(t *testing.T) {
+		// given
+		broadcaster := NewBroadcaster()
+		signals, cancel := broadcaster.Subscribe("operation")
+		defer cancel()
+
+		// when
+		broadcaster.OperationChanged("operation", "runtime")
+		broadcaster.OperationChanged("operation", "runtime")
+
+		// then
+		assert.Len(t, signals, 1)
+	})
+
+	t.Run("should not notify cancelled subscription", func(t *testing.T) {
+		// given
+		broadcaster := NewBroadcaster()
+		signals, cancel := broadcaster.Subscribe("operation")
+
+		// when
+		cancel()
+		broadcaster.OperationChanged("operation", "runtime")
+
+		// then
+		assert.Len(t, signals, 0)
+		assert.Empty(t, broadcaster.subscribers)
+	})
+}
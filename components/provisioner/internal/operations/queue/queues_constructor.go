@@ -56,6 +56,7 @@ type DeprovisioningTimeouts struct {
 
 type HibernationTimeouts struct {
 	WaitingForClusterHibernation time.Duration `envconfig:"default=60m"`
+	WaitingForClusterWakeUp      time.Duration `envconfig:"default=60m"`
 }
 
 func CreateProvisioningQueue(
@@ -68,7 +69,10 @@ func CreateProvisioningQueue(
 	shootClient gardener_apis.ShootInterface,
 	secretsClient v1core.SecretInterface,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
-	k8sClientProvider k8s.K8sClientProvider) OperationQueue {
+	k8sClientProvider k8s.K8sClientProvider,
+	notifier operations.StatusNotifier,
+	failureHandler operations.FailureHandler,
+	policies operations.StagePolicies) OperationQueue {
 
 	waitForAgentToConnectStep := provisioning.NewWaitForAgentToConnectStep(ccClientConstructor, configurator, model.FinishedStage, timeouts.AgentConnection, directorClient)
 	configureAgentStep := provisioning.NewConnectAgentStep(configurator, waitForAgentToConnectStep.Name(), timeouts.AgentConfiguration)
@@ -92,8 +96,10 @@ func CreateProvisioningQueue(
 		factory.NewReadWriteSession(),
 		model.Provision,
 		provisionSteps,
-		failure.NewNoopFailureHandler(),
+		failureHandler,
 		directorClient,
+		notifier,
+		policies,
 	)
 
 	return NewQueue(provisioningExecutor)
@@ -107,7 +113,10 @@ func CreateProvisioningNoInstallQueue(
 	secretsClient v1core.SecretInterface,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
-	configurator runtime.Configurator) OperationQueue {
+	configurator runtime.Configurator,
+	notifier operations.StatusNotifier,
+	failureHandler operations.FailureHandler,
+	policies operations.StagePolicies) OperationQueue {
 
 	configureAgentStep := provisioning.NewConnectAgentStep(configurator, model.FinishedStage, timeouts.AgentConfiguration)
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, configureAgentStep.Name(), timeouts.BindingsCreation)
@@ -125,8 +134,10 @@ func CreateProvisioningNoInstallQueue(
 		factory.NewReadWriteSession(),
 		model.ProvisionNoInstall,
 		provisionNoInstallSteps,
-		failure.NewNoopFailureHandler(),
+		failureHandler,
 		directorClient,
+		notifier,
+		policies,
 	)
 
 	return NewQueue(provisioningExecutor)
@@ -136,7 +147,9 @@ func CreateUpgradeQueue(
 	provisioningTimeouts ProvisioningTimeouts,
 	factory dbsession.Factory,
 	directorClient director.DirectorClient,
-	installationClient installation.Service) OperationQueue {
+	installationClient installation.Service,
+	notifier operations.StatusNotifier,
+	policies operations.StagePolicies) OperationQueue {
 
 	updatingUpgradeStep := upgrade.NewUpdateUpgradeStateStep(factory.NewWriteSession(), model.FinishedStage, 5*time.Minute)
 	waitForInstallStep := provisioning.NewWaitForInstallationStep(installationClient, updatingUpgradeStep.Name(), provisioningTimeouts.Installation, factory.NewWriteSession())
@@ -153,6 +166,8 @@ func CreateUpgradeQueue(
 		upgradeSteps,
 		failure.NewUpgradeFailureHandler(factory.NewWriteSession()),
 		directorClient,
+		notifier,
+		policies,
 	)
 
 	return NewQueue(upgradeExecutor)
@@ -164,7 +179,9 @@ func CreateDeprovisioningQueue(
 	installationClient installation.Service,
 	directorClient director.DirectorClient,
 	shootClient gardener_apis.ShootInterface,
-	deleteDelay time.Duration) OperationQueue {
+	deleteDelay time.Duration,
+	notifier operations.StatusNotifier,
+	policies operations.StagePolicies) OperationQueue {
 
 	waitForClusterDeletion := deprovisioning.NewWaitForClusterDeletionStep(shootClient, factory, directorClient, model.FinishedStage, timeouts.WaitingForClusterDeletion)
 	deleteCluster := deprovisioning.NewDeleteClusterStep(shootClient, waitForClusterDeletion.Name(), timeouts.ClusterDeletion)
@@ -184,6 +201,8 @@ func CreateDeprovisioningQueue(
 		deprovisioningSteps,
 		failure.NewNoopFailureHandler(),
 		directorClient,
+		notifier,
+		policies,
 	)
 
 	return NewQueue(deprovisioningExecutor)
@@ -194,6 +213,8 @@ func CreateDeprovisioningNoInstallQueue(
 	factory dbsession.Factory,
 	directorClient director.DirectorClient,
 	shootClient gardener_apis.ShootInterface,
+	notifier operations.StatusNotifier,
+	policies operations.StagePolicies,
 ) OperationQueue {
 
 	waitForClusterDeletion := deprovisioning.NewWaitForClusterDeletionStep(shootClient, factory, directorClient, model.FinishedStage, timeouts.WaitingForClusterDeletion)
@@ -210,6 +231,8 @@ func CreateDeprovisioningNoInstallQueue(
 		deprovisioningNoInstallSteps,
 		failure.NewNoopFailureHandler(),
 		directorClient,
+		notifier,
+		policies,
 	)
 
 	return NewQueue(deprovisioningExecutor)
@@ -223,6 +246,8 @@ func CreateShootUpgradeQueue(
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
 	secretsClient v1core.SecretInterface,
+	notifier operations.StatusNotifier,
+	policies operations.StagePolicies,
 ) OperationQueue {
 
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, model.FinishedStage, timeouts.BindingsCreation)
@@ -241,6 +266,8 @@ func CreateShootUpgradeQueue(
 		upgradeSteps,
 		failure.NewNoopFailureHandler(),
 		directorClient,
+		notifier,
+		policies,
 	)
 
 	return NewQueue(upgradeClusterExecutor)
@@ -250,7 +277,9 @@ func CreateHibernationQueue(
 	timeouts HibernationTimeouts,
 	factory dbsession.Factory,
 	directorClient director.DirectorClient,
-	shootClient gardener_apis.ShootInterface) OperationQueue {
+	shootClient gardener_apis.ShootInterface,
+	notifier operations.StatusNotifier,
+	policies operations.StagePolicies) OperationQueue {
 
 	waitForHibernation := hibernation.NewWaitForHibernationStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterHibernation)
 
@@ -264,7 +293,36 @@ func CreateHibernationQueue(
 		hibernationSteps,
 		failure.NewNoopFailureHandler(),
 		directorClient,
+		notifier,
+		policies,
 	)
 
 	return NewQueue(hibernateClusterExecutor)
 }
+
+func CreateWakeUpQueue(
+	timeouts HibernationTimeouts,
+	factory dbsession.Factory,
+	directorClient director.DirectorClient,
+	shootClient gardener_apis.ShootInterface,
+	notifier operations.StatusNotifier,
+	policies operations.StagePolicies) OperationQueue {
+
+	waitForWakeUp := hibernation.NewWaitForWakeUpStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterWakeUp)
+
+	wakeUpSteps := map[model.OperationStage]operations.Step{
+		model.WaitForWakeUp: waitForWakeUp,
+	}
+
+	wakeUpClusterExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.WakeUp,
+		wakeUpSteps,
+		failure.NewNoopFailureHandler(),
+		directorClient,
+		notifier,
+		policies,
+	)
+
+	return NewQueue(wakeUpClusterExecutor)
+}
@@ -0,0 +1,127 @@
+package operations
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+type FailureAction string
+
+const (
+	// FailureActionFail fails the operation and runs the failure handler of the operation type
+	FailureActionFail FailureAction = "fail"
+	// FailureActionRollback fails the operation and reverts the changes done by it, if the failure handler supports it
+	FailureActionRollback FailureAction = "rollback"
+	// FailureActionMarkForManual fails the operation and leaves the cluster untouched for manual investigation
+	FailureActionMarkForManual FailureAction = "mark-for-manual"
+)
+
+// StagePolicy overrides the processing settings of an operation stage. Zero values keep the defaults.
+type StagePolicy struct {
+	TimeLimit    time.Duration
+	RetryBackoff time.Duration
+	OnFailure    FailureAction
+}
+
+type StagePolicies map[model.OperationStage]StagePolicy
+
+type stagePolicyConfig struct {
+	TimeLimit    string        `json:"timeLimit"`
+	RetryBackoff string        `json:"retryBackoff"`
+	OnFailure    FailureAction `json:"onFailure"`
+}
+
+// LoadStagePolicies reads the stage policies from the JSON file mapping the operation stage to its policy, for example:
+//
+//	{"WaitingForClusterCreation": {"timeLimit": "90m", "retryBackoff": "10s", "onFailure": "rollback"}}
+//
+// Empty path results in no policies. Unknown stages are rejected, so that a misspelled stage does not silently keep the defaults.
+func LoadStagePolicies(path string) (StagePolicies, error) {
+	policies := StagePolicies{}
+	if path == "" {
+		return policies, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stage policies file: %s", err.Error())
+	}
+	defer file.Close()
+
+	var config map[model.OperationStage]stagePolicyConfig
+	if err := json.NewDecoder(file).Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to decode stage policies: %s", err.Error())
+	}
+
+	for stage, stageConfig := range config {
+		if !stage.IsValid() {
+			return nil, fmt.Errorf("unknown operation stage %q", stage)
+		}
+		policy, err := stageConfig.toPolicy()
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy for stage %s: %s", stage, err.Error())
+		}
+		policies[stage] = policy
+	}
+
+	return policies, nil
+}
+
+func (c stagePolicyConfig) toPolicy() (StagePolicy, error) {
+	policy := StagePolicy{OnFailure: c.OnFailure}
+
+	switch c.OnFailure {
+	case "", FailureActionFail, FailureActionRollback, FailureActionMarkForManual:
+	default:
+		return StagePolicy{}, fmt.Errorf("unknown failure action %q", c.OnFailure)
+	}
+
+	var err error
+	if policy.TimeLimit, err = parsePositiveDuration(c.TimeLimit); err != nil {
+		return StagePolicy{}, fmt.Errorf("invalid time limit: %s", err.Error())
+	}
+	if policy.RetryBackoff, err = parsePositiveDuration(c.RetryBackoff); err != nil {
+		return StagePolicy{}, fmt.Errorf("invalid retry backoff: %s", err.Error())
+	}
+
+	return policy, nil
+}
+
+func parsePositiveDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("duration %s must be positive", value)
+	}
+	return duration, nil
+}
+
+func (p StagePolicies) timeLimit(step Step) time.Duration {
+	if policy, found := p[step.Name()]; found && policy.TimeLimit > 0 {
+		return policy.TimeLimit
+	}
+	return step.TimeLimit()
+}
+
+func (p StagePolicies) retryBackoff(stage model.OperationStage) time.Duration {
+	if policy, found := p[stage]; found && policy.RetryBackoff > 0 {
+		return policy.RetryBackoff
+	}
+	return defaultDelay
+}
+
+func (p StagePolicies) failureAction(stage model.OperationStage) FailureAction {
+	if policy, found := p[stage]; found && policy.OnFailure != "" {
+		return policy.OnFailure
+	}
+	return FailureActionFail
+}
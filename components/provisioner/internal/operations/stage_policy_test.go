@@ -0,0 +1,72 @@
+package operations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadStagePolicies(t *testing.T) {
+	t.Run("should load stage policies", func(t *testing.T) {
+		// given
+		path := writePoliciesFile(t, `{
+			"WaitingForClusterCreation": {"timeLimit": "90m", "retryBackoff": "10s", "onFailure": "rollback"},
+			"WaitingForInstallation": {"onFailure": "mark-for-manual"}
+		}`)
+
+		// when
+		policies, err := LoadStagePolicies(path)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, StagePolicies{
+			model.WaitingForClusterCreation: {TimeLimit: 90 * time.Minute, RetryBackoff: 10 * time.Second, OnFailure: FailureActionRollback},
+			model.WaitingForInstallation:    {OnFailure: FailureActionMarkForManual},
+		}, policies)
+		assert.Equal(t, 10*time.Second, policies.retryBackoff(model.WaitingForClusterCreation))
+		assert.Equal(t, defaultDelay, policies.retryBackoff(model.WaitingForInstallation))
+		assert.Equal(t, FailureActionFail, policies.failureAction(model.StartingInstallation))
+	})
+
+	t.Run("should return no policies when path is empty", func(t *testing.T) {
+		// when
+		policies, err := LoadStagePolicies("")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, policies)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		content     string
+	}{
+		{description: "unknown failure action", content: `{"WaitingForInstallation": {"onFailure": "retry"}}`},
+		{description: "invalid time limit", content: `{"WaitingForInstallation": {"timeLimit": "1 hour"}}`},
+		{description: "negative retry backoff", content: `{"WaitingForInstallation": {"retryBackoff": "-1s"}}`},
+		{description: "unknown stage", content: `{"WaitingForInstalation": {"timeLimit": "1h"}}`},
+		{description: "malformed file", content: `WaitingForInstallation: {}`},
+	} {
+		t.Run("should return error for "+testCase.description, func(t *testing.T) {
+			// given
+			path := writePoliciesFile(t, testCase.content)
+
+			// when
+			_, err := LoadStagePolicies(path)
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+func writePoliciesFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "policies.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
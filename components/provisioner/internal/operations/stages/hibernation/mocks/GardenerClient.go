@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+	types "k8s.io/apimachinery/pkg/types"
+
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -38,3 +40,56 @@ func (_m *GardenerClient) Get(ctx context.Context, name string, options v1.GetOp
 
 	return r0, r1
 }
+
+// Patch provides a mock function with given fields: ctx, name, pt, data, options, subresources
+func (_m *GardenerClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options v1.PatchOptions, subresources ...string) (*v1beta1.Shoot, error) {
+	_va := make([]interface{}, len(subresources))
+	for _i := range subresources {
+		_va[_i] = subresources[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, name, pt, data, options)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *v1beta1.Shoot
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.PatchType, []byte, v1.PatchOptions, ...string) *v1beta1.Shoot); ok {
+		r0 = rf(ctx, name, pt, data, options, subresources...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.Shoot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.PatchType, []byte, v1.PatchOptions, ...string) error); ok {
+		r1 = rf(ctx, name, pt, data, options, subresources...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, shoot, options
+func (_m *GardenerClient) Update(ctx context.Context, shoot *v1beta1.Shoot, options v1.UpdateOptions) (*v1beta1.Shoot, error) {
+	ret := _m.Called(ctx, shoot, options)
+
+	var r0 *v1beta1.Shoot
+	if rf, ok := ret.Get(0).(func(context.Context, *v1beta1.Shoot, v1.UpdateOptions) *v1beta1.Shoot); ok {
+		r0 = rf(ctx, shoot, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.Shoot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *v1beta1.Shoot, v1.UpdateOptions) error); ok {
+		r1 = rf(ctx, shoot, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
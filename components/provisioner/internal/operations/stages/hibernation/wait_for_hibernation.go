@@ -10,11 +10,14 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 //go:generate mockery -name=GardenerClient
 type GardenerClient interface {
 	Get(ctx context.Context, name string, options v1.GetOptions) (*gardener_types.Shoot, error)
+	Update(ctx context.Context, shoot *gardener_types.Shoot, options v1.UpdateOptions) (*gardener_types.Shoot, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options v1.PatchOptions, subresources ...string) (*gardener_types.Shoot, error)
 }
 
 type WaitForHibernation struct {
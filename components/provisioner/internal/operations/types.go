@@ -41,6 +41,15 @@ type FailureHandler interface {
 	HandleFailure(operation model.Operation, cluster model.Cluster) error
 }
 
+// RollbackHandler is implemented by the failure handlers able to revert the changes done by the failed operation
+type RollbackHandler interface {
+	Rollback(operation model.Operation, cluster model.Cluster) error
+}
+
+type StatusNotifier interface {
+	OperationChanged(operationID, runtimeID string)
+}
+
 func ConvertToAppError(err error) apperrors.AppError {
 	if nonRecoverErr := (NonRecoverableError{}); errors.As(err, &nonRecoverErr) {
 		err = nonRecoverErr.error
@@ -2,12 +2,15 @@ package provisioning
 
 import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
 type GraphQLConverter interface {
 	RuntimeStatusToGraphQLStatus(status model.RuntimeStatus) *gqlschema.RuntimeStatus
 	OperationStatusToGQLOperationStatus(operation model.Operation) *gqlschema.OperationStatus
+	RuntimeToGraphQLRuntime(cluster model.Cluster) *gqlschema.Runtime
+	RuntimeDriftToGraphQLDrift(runtimeID string, drift []model.DriftedField) *gqlschema.RuntimeDrift
 }
 
 func NewGraphQLConverter() GraphQLConverter {
@@ -24,6 +27,8 @@ func (c graphQLConverter) RuntimeStatusToGraphQLStatus(status model.RuntimeStatu
 		HibernationStatus: &gqlschema.HibernationStatus{
 			HibernationPossible: &status.HibernationStatus.HibernationPossible,
 			Hibernated:          &status.HibernationStatus.Hibernated,
+			HibernatedAt:        status.HibernationStatus.HibernatedAt,
+			WokenUpAt:           status.HibernationStatus.WokenUpAt,
 		},
 	}
 }
@@ -40,9 +45,40 @@ func (c graphQLConverter) OperationStatusToGQLOperationStatus(operation model.Op
 			Reason:     operation.Reason,
 			Component:  operation.Component,
 		},
+		RollbackOperationID: operation.RollbackOperationID,
 	}
 }
 
+func (c graphQLConverter) RuntimeDriftToGraphQLDrift(runtimeID string, drift []model.DriftedField) *gqlschema.RuntimeDrift {
+	fields := make([]*gqlschema.DriftedField, 0, len(drift))
+	for _, field := range drift {
+		fields = append(fields, &gqlschema.DriftedField{
+			Field:    string(field.Field),
+			Expected: field.Expected,
+			Actual:   field.Actual,
+		})
+	}
+
+	return &gqlschema.RuntimeDrift{
+		RuntimeID: runtimeID,
+		Fields:    fields,
+	}
+}
+
+func (c graphQLConverter) RuntimeToGraphQLRuntime(cluster model.Cluster) *gqlschema.Runtime {
+	runtime := &gqlschema.Runtime{
+		ID:                cluster.ID,
+		Tenant:            &cluster.Tenant,
+		SubAccountID:      cluster.SubAccountId,
+		CreationTimestamp: &cluster.CreationTimestamp,
+		Deleted:           &cluster.Deleted,
+	}
+	if cluster.ClusterConfig.Name != "" {
+		runtime.ShootName = &cluster.ClusterConfig.Name
+	}
+	return runtime
+}
+
 func (c graphQLConverter) runtimeConnectionStatusToGraphQLStatus(status model.RuntimeAgentConnectionStatus) *gqlschema.RuntimeConnectionStatus {
 	return &gqlschema.RuntimeConnectionStatus{Status: c.runtimeAgentConnectionStatusToGraphQLStatus(status)}
 }
@@ -106,7 +142,70 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		ShootNetworkingFilterDisabled:       config.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        config.ControlPlaneFailureTolerance,
 		EuAccess:                            &config.EuAccess,
+		WorkerPools:                         c.workerPoolsToGraphQL(config.WorkerPools),
+		Networking:                          c.networkingToGraphQL(config),
+		MaintenanceWindow:                   c.maintenanceWindowToGraphQL(config),
+	}
+}
+
+func (c graphQLConverter) maintenanceWindowToGraphQL(config model.GardenerConfig) *gqlschema.MaintenanceWindow {
+	if config.MaintenanceWindowBegin == nil || config.MaintenanceWindowEnd == nil {
+		return nil
+	}
+
+	return &gqlschema.MaintenanceWindow{
+		Begin: *config.MaintenanceWindowBegin,
+		End:   *config.MaintenanceWindowEnd,
+	}
+}
+
+func (c graphQLConverter) networkingToGraphQL(config model.GardenerConfig) *gqlschema.Networking {
+	if config.PodsCidr == nil && config.ServicesCidr == nil && config.NodesCidr == nil {
+		return nil
+	}
+
+	return &gqlschema.Networking{
+		Pods:     config.PodsCidr,
+		Services: config.ServicesCidr,
+		Nodes:    config.NodesCidr,
+	}
+}
+
+func (c graphQLConverter) workerPoolsToGraphQL(pools []model.WorkerPool) []*gqlschema.WorkerPool {
+	if pools == nil {
+		return nil
+	}
+
+	gqlPools := make([]*gqlschema.WorkerPool, 0, len(pools))
+	for _, pool := range pools {
+		gqlPool := &gqlschema.WorkerPool{
+			Name:          pool.Name,
+			MachineType:   pool.MachineType,
+			AutoScalerMin: pool.AutoScalerMin,
+			AutoScalerMax: pool.AutoScalerMax,
+			Zones:         pool.Zones,
+			DiskType:      pool.DiskType,
+			VolumeSizeGb:  pool.VolumeSizeGB,
+		}
+		if pool.Labels != nil {
+			gqlPool.Labels = gqlschema.Labels{}
+			for key, value := range pool.Labels {
+				gqlPool.Labels[key] = value
+			}
+		}
+		for _, taint := range pool.Taints {
+			gqlTaint := &gqlschema.Taint{
+				Key:    taint.Key,
+				Effect: gqlschema.TaintEffect(taint.Effect),
+			}
+			if taint.Value != "" {
+				gqlTaint.Value = util.StringPtr(taint.Value)
+			}
+			gqlPool.Taints = append(gqlPool.Taints, gqlTaint)
+		}
+		gqlPools = append(gqlPools, gqlPool)
 	}
+	return gqlPools
 }
 
 func (c graphQLConverter) oidcConfigToGraphQLConfig(config *model.OIDCConfig) *gqlschema.OIDCConfig {
@@ -179,6 +278,8 @@ func (c graphQLConverter) operationTypeToGraphQLType(operationType model.Operati
 		return gqlschema.OperationTypeReconnectRuntime
 	case model.Hibernate:
 		return gqlschema.OperationTypeHibernate
+	case model.WakeUp:
+		return gqlschema.OperationTypeWakeUp
 	default:
 		return ""
 	}
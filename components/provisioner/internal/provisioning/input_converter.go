@@ -1,6 +1,8 @@
 package provisioning
 
 import (
+	"fmt"
+
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/installation/release"
@@ -91,7 +93,7 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 	}
 
 	id := c.uuidGenerator.New()
-	return model.GardenerConfig{
+	config := model.GardenerConfig{
 		ID:                                  id,
 		Name:                                input.Name,
 		ProjectName:                         c.gardenerProject,
@@ -122,7 +124,55 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		ShootNetworkingFilterDisabled:       input.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        input.ControlPlaneFailureTolerance,
 		EuAccess:                            util.UnwrapBoolOrDefault(input.EuAccess, c.defaultEuAccess),
-	}, nil
+		WorkerPools:                         workerPoolsFromInput(input.WorkerPools),
+	}
+
+	if input.Networking != nil {
+		config.PodsCidr = input.Networking.Pods
+		config.ServicesCidr = input.Networking.Services
+		config.NodesCidr = input.Networking.Nodes
+	}
+
+	if input.MaintenanceWindow != nil {
+		config.MaintenanceWindowBegin = &input.MaintenanceWindow.Begin
+		config.MaintenanceWindowEnd = &input.MaintenanceWindow.End
+	}
+
+	return config, nil
+}
+
+func workerPoolsFromInput(input []*gqlschema.WorkerPoolInput) []model.WorkerPool {
+	if input == nil {
+		return nil
+	}
+
+	pools := make([]model.WorkerPool, 0, len(input))
+	for _, poolInput := range input {
+		pool := model.WorkerPool{
+			Name:          poolInput.Name,
+			MachineType:   poolInput.MachineType,
+			AutoScalerMin: poolInput.AutoScalerMin,
+			AutoScalerMax: poolInput.AutoScalerMax,
+			Zones:         poolInput.Zones,
+			DiskType:      poolInput.DiskType,
+			VolumeSizeGB:  poolInput.VolumeSizeGb,
+		}
+		if poolInput.Labels != nil {
+			pool.Labels = make(map[string]string, len(poolInput.Labels))
+			for key, value := range poolInput.Labels {
+				pool.Labels[key] = fmt.Sprint(value)
+			}
+		}
+		for _, taint := range poolInput.Taints {
+			pool.Taints = append(pool.Taints, model.Taint{
+				Key:    taint.Key,
+				Value:  util.UnwrapStr(taint.Value),
+				Effect: string(taint.Effect),
+			})
+		}
+		pools = append(pools, pool)
+	}
+	return pools
 }
 
 func oidcConfigFromInput(config *gqlschema.OIDCConfigInput) *model.OIDCConfig {
@@ -173,6 +223,11 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		providerSpecificConfig = config.GardenerProviderConfig
 	}
 
+	maintenanceWindowBegin, maintenanceWindowEnd := config.MaintenanceWindowBegin, config.MaintenanceWindowEnd
+	if input.MaintenanceWindow != nil {
+		maintenanceWindowBegin, maintenanceWindowEnd = &input.MaintenanceWindow.Begin, &input.MaintenanceWindow.End
+	}
+
 	return model.GardenerConfig{
 		ID:           config.ID,
 		ClusterID:    config.ClusterID,
@@ -184,6 +239,9 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		Region:       config.Region,
 		LicenceType:  config.LicenceType,
 		WorkerCidr:   config.WorkerCidr,
+		PodsCidr:     config.PodsCidr,
+		ServicesCidr: config.ServicesCidr,
+		NodesCidr:    config.NodesCidr,
 
 		Purpose:                             util.DefaultStrIfNil(input.Purpose, config.Purpose),
 		KubernetesVersion:                   util.UnwrapStrOrDefault(input.KubernetesVersion, config.KubernetesVersion),
@@ -202,9 +260,20 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		OIDCConfig:                          oidcConfigFromInput(input.OidcConfig),
 		ExposureClassName:                   util.DefaultStrIfNil(input.ExposureClassName, config.ExposureClassName),
 		ShootNetworkingFilterDisabled:       util.DefaultBoolIfNil(input.ShootNetworkingFilterDisabled, config.ShootNetworkingFilterDisabled),
+		ControlPlaneFailureTolerance:        config.ControlPlaneFailureTolerance,
+		WorkerPools:                         workerPoolsOrDefault(input.WorkerPools, config.WorkerPools),
+		MaintenanceWindowBegin:              maintenanceWindowBegin,
+		MaintenanceWindowEnd:                maintenanceWindowEnd,
 	}, nil
 }
 
+func workerPoolsOrDefault(input []*gqlschema.WorkerPoolInput, defaultPools []model.WorkerPool) []model.WorkerPool {
+	if input == nil {
+		return defaultPools
+	}
+	return workerPoolsFromInput(input)
+}
+
 func (c converter) providerSpecificConfigFromInput(input *gqlschema.ProviderSpecificInput) (model.GardenerProviderConfig, apperrors.AppError) {
 	if input == nil {
 		return nil, apperrors.Internal("provider config not specified")
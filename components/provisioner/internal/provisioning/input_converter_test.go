@@ -75,6 +75,11 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 				ShootNetworkingFilterDisabled: util.BoolPtr(true),
 				ControlPlaneFailureTolerance:  util.StringPtr("zone"),
 				EuAccess:                      util.BoolPtr(true),
+				Networking: &gqlschema.NetworkingInput{
+					Pods:     util.StringPtr("100.64.0.0/12"),
+					Services: util.StringPtr("100.104.0.0/13"),
+				},
+				MaintenanceWindow: &gqlschema.MaintenanceWindowInput{Begin: "220000+0000", End: "230000+0000"},
 			},
 			Administrators: []string{administrator},
 		},
@@ -102,6 +107,10 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 			Seed:                                "gcp-eu1",
 			TargetSecret:                        "secret",
 			WorkerCidr:                          "cidr",
+			PodsCidr:                            util.StringPtr("100.64.0.0/12"),
+			ServicesCidr:                        util.StringPtr("100.104.0.0/13"),
+			MaintenanceWindowBegin:              util.StringPtr("220000+0000"),
+			MaintenanceWindowEnd:                util.StringPtr("230000+0000"),
 			AutoScalerMin:                       1,
 			AutoScalerMax:                       5,
 			MaxSurge:                            1,
@@ -826,6 +835,7 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				OIDCConfig:                    oidcConfig(),
 				ExposureClassName:             util.StringPtr("internet"),
 				ShootNetworkingFilterDisabled: util.BoolPtr(false),
+				ControlPlaneFailureTolerance:  util.StringPtr("zone"),
 			},
 			upgradedConfig: model.GardenerConfig{
 				KubernetesVersion:             "1.19",
@@ -842,6 +852,7 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				OIDCConfig:                    upgradedOidcConfig(),
 				ExposureClassName:             util.StringPtr("internet"),
 				ShootNetworkingFilterDisabled: util.BoolPtr(true),
+				ControlPlaneFailureTolerance:  util.StringPtr("zone"),
 			},
 		},
 		{
@@ -912,6 +923,97 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				ShootNetworkingFilterDisabled: util.BoolPtr(false),
 			},
 		},
+		{
+			description:  "shoot upgrade keeps worker pools and network ranges",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				PodsCidr:          util.StringPtr("100.64.0.0/12"),
+				NodesCidr:         util.StringPtr("10.250.0.0/16"),
+				WorkerPools:       []model.WorkerPool{{Name: "mem-worker", MachineType: "memory", AutoScalerMin: 1, AutoScalerMax: 2}},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				PodsCidr:          util.StringPtr("100.64.0.0/12"),
+				NodesCidr:         util.StringPtr("10.250.0.0/16"),
+				OIDCConfig:        upgradedOidcConfig(),
+				WorkerPools:       []model.WorkerPool{{Name: "mem-worker", MachineType: "memory", AutoScalerMin: 1, AutoScalerMax: 2}},
+			},
+		},
+		{
+			description: "shoot upgrade replaces maintenance window",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.MaintenanceWindow = &gqlschema.MaintenanceWindowInput{Begin: "010000+0100", End: "020000+0100"}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:      "1.20.7",
+				MachineType:            "1",
+				AutoScalerMin:          1,
+				AutoScalerMax:          2,
+				MaintenanceWindowBegin: util.StringPtr("220000+0000"),
+				MaintenanceWindowEnd:   util.StringPtr("230000+0000"),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion:      "1.20.7",
+				MachineType:            "1",
+				AutoScalerMin:          1,
+				AutoScalerMax:          2,
+				OIDCConfig:             upgradedOidcConfig(),
+				MaintenanceWindowBegin: util.StringPtr("010000+0100"),
+				MaintenanceWindowEnd:   util.StringPtr("020000+0100"),
+			},
+		},
+		{
+			description: "shoot upgrade replaces worker pools",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.WorkerPools = []*gqlschema.WorkerPoolInput{
+					{
+						Name:          "gpu-worker",
+						MachineType:   "gpu",
+						AutoScalerMin: 0,
+						AutoScalerMax: 3,
+						Zones:         []string{"europe-west1-b"},
+						Labels:        gqlschema.Labels{"workload": "gpu"},
+						Taints:        []*gqlschema.TaintInput{{Key: "nvidia.com/gpu", Effect: gqlschema.TaintEffectNoSchedule}},
+					},
+				}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				WorkerPools:       []model.WorkerPool{{Name: "mem-worker", MachineType: "memory", AutoScalerMin: 1, AutoScalerMax: 2}},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				OIDCConfig:        upgradedOidcConfig(),
+				WorkerPools: []model.WorkerPool{
+					{
+						Name:          "gpu-worker",
+						MachineType:   "gpu",
+						AutoScalerMin: 0,
+						AutoScalerMax: 3,
+						Zones:         []string{"europe-west1-b"},
+						Labels:        map[string]string{"workload": "gpu"},
+						Taints:        []model.Taint{{Key: "nvidia.com/gpu", Effect: "NoSchedule"}},
+					},
+				},
+			},
+		},
 	}
 
 	casesWithErrors := []struct {
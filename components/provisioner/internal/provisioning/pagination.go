@@ -0,0 +1,148 @@
+package provisioning
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+func newPage(first *int, after *string) (model.Page, apperrors.AppError) {
+	page := model.Page{Limit: defaultPageSize}
+
+	if first != nil {
+		if *first < 1 || *first > maxPageSize {
+			return model.Page{}, apperrors.BadRequest("page size must be between 1 and %d", maxPageSize)
+		}
+		page.Limit = *first
+	}
+
+	if after != nil && *after != "" {
+		cursor, err := decodeCursor(*after)
+		if err != nil {
+			return model.Page{}, apperrors.BadRequest("invalid cursor: %s", err.Error())
+		}
+		page.After = &cursor
+	}
+
+	return page, nil
+}
+
+func encodeCursor(cursor model.PageCursor) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(encoded string) (model.PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return model.PageCursor{}, err
+	}
+
+	var cursor model.PageCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return model.PageCursor{}, err
+	}
+
+	return cursor, nil
+}
+
+func newPageInfo(hasNextPage bool, last *model.PageCursor) *gqlschema.PageInfo {
+	pageInfo := &gqlschema.PageInfo{HasNextPage: hasNextPage}
+	if last != nil {
+		endCursor := encodeCursor(*last)
+		pageInfo.EndCursor = &endCursor
+	}
+	return pageInfo
+}
+
+func runtimeFilterFromGraphQL(filter *gqlschema.RuntimesFilter) model.RuntimeFilter {
+	if filter == nil {
+		return model.RuntimeFilter{}
+	}
+
+	return model.RuntimeFilter{
+		Tenant:        filter.Tenant,
+		ShootName:     filter.ShootName,
+		Deleted:       filter.Deleted,
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+	}
+}
+
+func operationFilterFromGraphQL(filter *gqlschema.OperationsFilter) (model.OperationFilter, apperrors.AppError) {
+	if filter == nil {
+		return model.OperationFilter{}, nil
+	}
+
+	operationFilter := model.OperationFilter{
+		Tenant:        filter.Tenant,
+		RuntimeID:     filter.RuntimeID,
+		ShootName:     filter.ShootName,
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+	}
+
+	if filter.State != nil {
+		state, err := operationStateFromGraphQL(*filter.State)
+		if err != nil {
+			return model.OperationFilter{}, err
+		}
+		operationFilter.State = &state
+	}
+
+	if filter.Type != nil {
+		operationType, err := operationTypeFromGraphQL(*filter.Type)
+		if err != nil {
+			return model.OperationFilter{}, err
+		}
+		operationFilter.Type = &operationType
+	}
+
+	return operationFilter, nil
+}
+
+func operationStateFromGraphQL(state gqlschema.OperationState) (model.OperationState, apperrors.AppError) {
+	switch state {
+	case gqlschema.OperationStateInProgress:
+		return model.InProgress, nil
+	case gqlschema.OperationStateSucceeded:
+		return model.Succeeded, nil
+	case gqlschema.OperationStateFailed:
+		return model.Failed, nil
+	default:
+		return "", apperrors.BadRequest("filtering by %s operation state is not supported", state)
+	}
+}
+
+func operationTypeFromGraphQL(operationType gqlschema.OperationType) (model.OperationType, apperrors.AppError) {
+	switch operationType {
+	case gqlschema.OperationTypeProvision:
+		return model.Provision, nil
+	case gqlschema.OperationTypeProvisionNoInstall:
+		return model.ProvisionNoInstall, nil
+	case gqlschema.OperationTypeDeprovision:
+		return model.Deprovision, nil
+	case gqlschema.OperationTypeDeprovisionNoInstall:
+		return model.DeprovisionNoInstall, nil
+	case gqlschema.OperationTypeUpgrade:
+		return model.Upgrade, nil
+	case gqlschema.OperationTypeUpgradeShoot:
+		return model.UpgradeShoot, nil
+	case gqlschema.OperationTypeReconnectRuntime:
+		return model.ReconnectRuntime, nil
+	case gqlschema.OperationTypeHibernate:
+		return model.Hibernate, nil
+	case gqlschema.OperationTypeWakeUp:
+		return model.WakeUp, nil
+	default:
+		return "", apperrors.BadRequest("unknown operation type %s", operationType)
+	}
+}
@@ -0,0 +1,94 @@
+package provisioning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPage(t *testing.T) {
+	cursor := model.PageCursor{Timestamp: time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC), ID: runtimeID}
+
+	t.Run("should use default page size", func(t *testing.T) {
+		// when
+		page, err := newPage(nil, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, defaultPageSize, page.Limit)
+		assert.Nil(t, page.After)
+	})
+
+	t.Run("should decode cursor", func(t *testing.T) {
+		// given
+		first := 10
+
+		// when
+		page, err := newPage(&first, util.StringPtr(encodeCursor(cursor)))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 10, page.Limit)
+		require.NotNil(t, page.After)
+		assert.True(t, cursor.Timestamp.Equal(page.After.Timestamp))
+		assert.Equal(t, cursor.ID, page.After.ID)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		first       int
+		after       string
+	}{
+		{description: "should fail when page size is zero", first: 0},
+		{description: "should fail when page size exceeds maximum", first: maxPageSize + 1},
+		{description: "should fail when cursor is malformed", first: 1, after: "not a cursor"},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			_, err := newPage(&testCase.first, &testCase.after)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		})
+	}
+}
+
+func TestOperationFilterFromGraphQL(t *testing.T) {
+	t.Run("should convert state and type", func(t *testing.T) {
+		// given
+		state := gqlschema.OperationStateInProgress
+		operationType := gqlschema.OperationTypeWakeUp
+
+		// when
+		filter, err := operationFilterFromGraphQL(&gqlschema.OperationsFilter{
+			Tenant: util.StringPtr(tenant),
+			State:  &state,
+			Type:   &operationType,
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, tenant, *filter.Tenant)
+		assert.Equal(t, model.InProgress, *filter.State)
+		assert.Equal(t, model.WakeUp, *filter.Type)
+	})
+
+	t.Run("should fail for unsupported state", func(t *testing.T) {
+		// given
+		state := gqlschema.OperationStatePending
+
+		// when
+		_, err := operationFilterFromGraphQL(&gqlschema.OperationsFilter{State: &state})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+}
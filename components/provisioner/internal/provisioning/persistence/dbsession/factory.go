@@ -23,9 +23,12 @@ type ReadSession interface {
 	GetCluster(runtimeID string) (model.Cluster, dberrors.Error)
 	GetOperation(operationID string) (model.Operation, dberrors.Error)
 	GetLastOperation(runtimeID string) (model.Operation, dberrors.Error)
+	GetLastSucceededOperation(runtimeID string, operationType model.OperationType) (model.Operation, dberrors.Error)
 	GetGardenerClusterByName(name string) (model.Cluster, dberrors.Error)
 	GetTenant(runtimeID string) (string, dberrors.Error)
 	ListInProgressOperations() ([]model.Operation, dberrors.Error)
+	ListRuntimes(filter model.RuntimeFilter, page model.Page) ([]model.Cluster, dberrors.Error)
+	ListOperations(filter model.OperationFilter, page model.Page) ([]model.Operation, dberrors.Error)
 	GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error)
 	GetTenantForOperation(operationID string) (string, dberrors.Error)
 	InProgressOperationsCount() (model.OperationsCount, dberrors.Error)
@@ -44,6 +47,7 @@ type WriteSession interface {
 	InsertOperation(operation model.Operation) dberrors.Error
 	UpdateOperationState(operationID string, message string, state model.OperationState, endTime time.Time) dberrors.Error
 	UpdateOperationLastError(operationID, msg, reason, component string) dberrors.Error
+	SetOperationRollback(operationID, rollbackOperationID string) dberrors.Error
 	TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error
 	UpdateKubeconfig(runtimeID string, kubeconfig string) dberrors.Error
 	SetActiveKymaConfig(runtimeID string, kymaConfigId string) dberrors.Error
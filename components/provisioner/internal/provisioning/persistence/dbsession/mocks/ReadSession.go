@@ -85,6 +85,29 @@ func (_m *ReadSession) GetLastOperation(runtimeID string) (model.Operation, dber
 	return r0, r1
 }
 
+// GetLastSucceededOperation provides a mock function with given fields: runtimeID, operationType
+func (_m *ReadSession) GetLastSucceededOperation(runtimeID string, operationType model.OperationType) (model.Operation, dberrors.Error) {
+	ret := _m.Called(runtimeID, operationType)
+
+	var r0 model.Operation
+	if rf, ok := ret.Get(0).(func(string, model.OperationType) model.Operation); ok {
+		r0 = rf(runtimeID, operationType)
+	} else {
+		r0 = ret.Get(0).(model.Operation)
+	}
+
+	var r1 dberrors.Error
+	if rf, ok := ret.Get(1).(func(string, model.OperationType) dberrors.Error); ok {
+		r1 = rf(runtimeID, operationType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(dberrors.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetOperation provides a mock function with given fields: operationID
 func (_m *ReadSession) GetOperation(operationID string) (model.Operation, dberrors.Error) {
 	ret := _m.Called(operationID)
@@ -272,3 +295,53 @@ func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, dberrors.E
 
 	return r0, r1
 }
+
+// ListOperations provides a mock function with given fields: filter, page
+func (_m *ReadSession) ListOperations(filter model.OperationFilter, page model.Page) ([]model.Operation, dberrors.Error) {
+	ret := _m.Called(filter, page)
+
+	var r0 []model.Operation
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, model.Page) []model.Operation); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	var r1 dberrors.Error
+	if rf, ok := ret.Get(1).(func(model.OperationFilter, model.Page) dberrors.Error); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(dberrors.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: filter, page
+func (_m *ReadSession) ListRuntimes(filter model.RuntimeFilter, page model.Page) ([]model.Cluster, dberrors.Error) {
+	ret := _m.Called(filter, page)
+
+	var r0 []model.Cluster
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, model.Page) []model.Cluster); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Cluster)
+		}
+	}
+
+	var r1 dberrors.Error
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, model.Page) dberrors.Error); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(dberrors.Error)
+		}
+	}
+
+	return r0, r1
+}
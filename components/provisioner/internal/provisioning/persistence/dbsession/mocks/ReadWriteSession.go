@@ -118,6 +118,29 @@ func (_m *ReadWriteSession) GetLastOperation(runtimeID string) (model.Operation,
 	return r0, r1
 }
 
+// GetLastSucceededOperation provides a mock function with given fields: runtimeID, operationType
+func (_m *ReadWriteSession) GetLastSucceededOperation(runtimeID string, operationType model.OperationType) (model.Operation, apperrors.AppError) {
+	ret := _m.Called(runtimeID, operationType)
+
+	var r0 model.Operation
+	if rf, ok := ret.Get(0).(func(string, model.OperationType) model.Operation); ok {
+		r0 = rf(runtimeID, operationType)
+	} else {
+		r0 = ret.Get(0).(model.Operation)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string, model.OperationType) apperrors.AppError); ok {
+		r1 = rf(runtimeID, operationType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetOperation provides a mock function with given fields: operationID
 func (_m *ReadWriteSession) GetOperation(operationID string) (model.Operation, apperrors.AppError) {
 	ret := _m.Called(operationID)
@@ -418,6 +441,56 @@ func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apper
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: filter, page
+func (_m *ReadWriteSession) ListOperations(filter model.OperationFilter, page model.Page) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(filter, page)
+
+	var r0 []model.Operation
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, model.Page) []model.Operation); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.OperationFilter, model.Page) apperrors.AppError); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: filter, page
+func (_m *ReadWriteSession) ListRuntimes(filter model.RuntimeFilter, page model.Page) ([]model.Cluster, apperrors.AppError) {
+	ret := _m.Called(filter, page)
+
+	var r0 []model.Cluster
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, model.Page) []model.Cluster); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Cluster)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, model.Page) apperrors.AppError); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -450,6 +523,22 @@ func (_m *ReadWriteSession) SetActiveKymaConfig(runtimeID string, kymaConfigId s
 	return r0
 }
 
+// SetOperationRollback provides a mock function with given fields: operationID, rollbackOperationID
+func (_m *ReadWriteSession) SetOperationRollback(operationID string, rollbackOperationID string) apperrors.AppError {
+	ret := _m.Called(operationID, rollbackOperationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, rollbackOperationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // TransitionOperation provides a mock function with given fields: operationID, message, stage, transitionTime
 func (_m *ReadWriteSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) apperrors.AppError {
 	ret := _m.Called(operationID, message, stage, transitionTime)
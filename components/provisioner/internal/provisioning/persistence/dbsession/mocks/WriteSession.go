@@ -193,6 +193,22 @@ func (_m *WriteSession) SetActiveKymaConfig(runtimeID string, kymaConfigId strin
 	return r0
 }
 
+// SetOperationRollback provides a mock function with given fields: operationID, rollbackOperationID
+func (_m *WriteSession) SetOperationRollback(operationID string, rollbackOperationID string) apperrors.AppError {
+	ret := _m.Called(operationID, rollbackOperationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, rollbackOperationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // TransitionOperation provides a mock function with given fields: operationID, message, stage, transitionTime
 func (_m *WriteSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) apperrors.AppError {
 	ret := _m.Called(operationID, message, stage, transitionTime)
@@ -214,6 +214,22 @@ func (_m *WriteSessionWithinTransaction) SetActiveKymaConfig(runtimeID string, k
 	return r0
 }
 
+// SetOperationRollback provides a mock function with given fields: operationID, rollbackOperationID
+func (_m *WriteSessionWithinTransaction) SetOperationRollback(operationID string, rollbackOperationID string) apperrors.AppError {
+	ret := _m.Called(operationID, rollbackOperationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, rollbackOperationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // TransitionOperation provides a mock function with given fields: operationID, message, stage, transitionTime
 func (_m *WriteSessionWithinTransaction) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) apperrors.AppError {
 	ret := _m.Called(operationID, message, stage, transitionTime)
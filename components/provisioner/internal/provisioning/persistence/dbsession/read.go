@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gocraft/dbr/v2"
 
@@ -184,7 +185,8 @@ func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberr
 			"provider", "purpose", "seed", "target_secret", "worker_cidr", "region", "auto_scaler_min",
 			"auto_scaler_max", "max_surge", "max_unavailable", "enable_kubernetes_version_auto_update",
 			"enable_machine_image_version_auto_update", "allow_privileged_containers", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "worker_pools", "pods_cidr", "services_cidr", "nodes_cidr",
+			"maintenance_window_begin", "maintenance_window_end").
 		From("gardener_config").
 		Join("cluster", "gardener_config.cluster_id=cluster.id").
 		Where(dbr.Eq("name", name)).
@@ -348,7 +350,8 @@ func (r readSession) getClusterAdministrators(runtimeID string) ([]model.Cluster
 
 type gardenerConfigRead struct {
 	model.GardenerConfig
-	ProviderSpecificConfig string `db:"provider_specific_config"`
+	ProviderSpecificConfig string  `db:"provider_specific_config"`
+	WorkerPoolsJSON        *string `db:"worker_pools"`
 }
 
 func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
@@ -358,6 +361,13 @@ func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
 	}
 
 	gcr.GardenerProviderConfig = gardenerConfigProviderConfig
+
+	if gcr.WorkerPoolsJSON != nil {
+		decodeErr := json.Unmarshal([]byte(*gcr.WorkerPoolsJSON), &gcr.WorkerPools)
+		if decodeErr != nil {
+			return fmt.Errorf("error decoding worker pools: %s", decodeErr.Error())
+		}
+	}
 	return nil
 }
 
@@ -371,7 +381,8 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable",
 			"enable_kubernetes_version_auto_update", "enable_machine_image_version_auto_update",
 			"allow_privileged_containers", "exposure_class_name", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access", "worker_pools", "pods_cidr", "services_cidr", "nodes_cidr",
+			"maintenance_window_begin", "maintenance_window_end").
 		From("cluster").
 		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
 		Where(dbr.Eq("cluster.id", runtimeID)).
@@ -395,7 +406,7 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 
 var (
 	operationColumns = []string{
-		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component",
+		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component", "rollback_operation_id",
 	}
 )
 
@@ -442,6 +453,31 @@ func (r readSession) GetLastOperation(runtimeID string) (model.Operation, dberro
 	return operation, nil
 }
 
+func (r readSession) GetLastSucceededOperation(runtimeID string, operationType model.OperationType) (model.Operation, dberrors.Error) {
+	var operation model.Operation
+
+	_, err := r.session.
+		Select(operationColumns...).
+		From("operation").
+		Where(dbr.And(
+			dbr.Eq("cluster_id", runtimeID),
+			dbr.Eq("type", operationType),
+			dbr.Eq("state", model.Succeeded),
+		)).
+		OrderDesc("end_timestamp").
+		Limit(1).
+		Load(&operation)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.Operation{}, dberrors.NotFound("Succeeded %s operation not found for runtime: %s", operationType, runtimeID)
+		}
+		return model.Operation{}, dberrors.Internal("Failed to get last succeeded %s operation: %s", operationType, err)
+	}
+
+	return operation, nil
+}
+
 func (r readSession) ListInProgressOperations() ([]model.Operation, dberrors.Error) {
 	var operations []model.Operation
 
@@ -461,6 +497,128 @@ func (r readSession) ListInProgressOperations() ([]model.Operation, dberrors.Err
 	return operations, nil
 }
 
+type runtimeListItem struct {
+	ID                string
+	Tenant            string
+	SubAccountId      *string
+	CreationTimestamp time.Time
+	Deleted           bool
+	Name              *string
+}
+
+func (r readSession) ListRuntimes(filter model.RuntimeFilter, page model.Page) ([]model.Cluster, dberrors.Error) {
+	var conditions []dbr.Builder
+	if filter.Tenant != nil {
+		conditions = append(conditions, dbr.Eq("cluster.tenant", *filter.Tenant))
+	}
+	if filter.ShootName != nil {
+		conditions = append(conditions, dbr.Eq("gardener_config.name", *filter.ShootName))
+	}
+	if filter.Deleted != nil {
+		conditions = append(conditions, dbr.Eq("cluster.deleted", *filter.Deleted))
+	}
+	if filter.CreatedAfter != nil {
+		conditions = append(conditions, dbr.Gte("cluster.creation_timestamp", *filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		conditions = append(conditions, dbr.Lt("cluster.creation_timestamp", *filter.CreatedBefore))
+	}
+	if page.After != nil {
+		conditions = append(conditions, dbr.Expr("(cluster.creation_timestamp, cluster.id) > (?, ?)", page.After.Timestamp, page.After.ID))
+	}
+
+	query := r.session.
+		Select("cluster.id", "cluster.tenant", "cluster.sub_account_id", "cluster.creation_timestamp", "cluster.deleted", "gardener_config.name").
+		From("cluster").
+		LeftJoin("gardener_config", "gardener_config.cluster_id=cluster.id").
+		OrderAsc("cluster.creation_timestamp").
+		OrderAsc("cluster.id").
+		Limit(uint64(page.Limit))
+	for _, condition := range conditions {
+		query.Where(condition)
+	}
+
+	var items []runtimeListItem
+
+	_, err := query.Load(&items)
+
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, dberrors.Internal("Failed to list runtimes: %s", err)
+	}
+
+	clusters := make([]model.Cluster, 0, len(items))
+	for _, item := range items {
+		cluster := model.Cluster{
+			ID:                item.ID,
+			Tenant:            item.Tenant,
+			SubAccountId:      item.SubAccountId,
+			CreationTimestamp: item.CreationTimestamp,
+			Deleted:           item.Deleted,
+		}
+		if item.Name != nil {
+			cluster.ClusterConfig.Name = *item.Name
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, nil
+}
+
+func (r readSession) ListOperations(filter model.OperationFilter, page model.Page) ([]model.Operation, dberrors.Error) {
+	var conditions []dbr.Builder
+	if filter.Tenant != nil {
+		conditions = append(conditions, dbr.Eq("cluster.tenant", *filter.Tenant))
+	}
+	if filter.RuntimeID != nil {
+		conditions = append(conditions, dbr.Eq("operation.cluster_id", *filter.RuntimeID))
+	}
+	if filter.ShootName != nil {
+		conditions = append(conditions, dbr.Eq("gardener_config.name", *filter.ShootName))
+	}
+	if filter.State != nil {
+		conditions = append(conditions, dbr.Eq("operation.state", *filter.State))
+	}
+	if filter.Type != nil {
+		conditions = append(conditions, dbr.Eq("operation.type", *filter.Type))
+	}
+	if filter.StartedAfter != nil {
+		conditions = append(conditions, dbr.Gte("operation.start_timestamp", *filter.StartedAfter))
+	}
+	if filter.StartedBefore != nil {
+		conditions = append(conditions, dbr.Lt("operation.start_timestamp", *filter.StartedBefore))
+	}
+	if page.After != nil {
+		conditions = append(conditions, dbr.Expr("(operation.start_timestamp, operation.id) > (?, ?)", page.After.Timestamp, page.After.ID))
+	}
+
+	columns := make([]string, 0, len(operationColumns))
+	for _, column := range operationColumns {
+		columns = append(columns, "operation."+column)
+	}
+
+	query := r.session.
+		Select(columns...).
+		From("operation").
+		Join("cluster", "cluster.id=operation.cluster_id").
+		LeftJoin("gardener_config", "gardener_config.cluster_id=operation.cluster_id").
+		OrderAsc("operation.start_timestamp").
+		OrderAsc("operation.id").
+		Limit(uint64(page.Limit))
+	for _, condition := range conditions {
+		query.Where(condition)
+	}
+
+	var operations []model.Operation
+
+	_, err := query.Load(&operations)
+
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, dberrors.Internal("Failed to list operations: %s", err)
+	}
+
+	return operations, nil
+}
+
 func (r readSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error) {
 	var runtimeUpgrade model.RuntimeUpgrade
 
@@ -106,7 +106,12 @@ func (ws writeSession) InsertAdministrators(clusterId string, administrators []s
 }
 
 func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
-	_, err := ws.insertInto("gardener_config").
+	workerPools, err := encodeWorkerPools(config.WorkerPools)
+	if err != nil {
+		return dberrors.Internal("Failed to encode worker pools: %s", err)
+	}
+
+	_, err = ws.insertInto("gardener_config").
 		Pair("id", config.ID).
 		Pair("cluster_id", config.ClusterID).
 		Pair("project_name", config.ProjectName).
@@ -136,6 +141,12 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Pair("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
 		Pair("eu_access", config.EuAccess).
+		Pair("worker_pools", workerPools).
+		Pair("pods_cidr", config.PodsCidr).
+		Pair("services_cidr", config.ServicesCidr).
+		Pair("nodes_cidr", config.NodesCidr).
+		Pair("maintenance_window_begin", config.MaintenanceWindowBegin).
+		Pair("maintenance_window_end", config.MaintenanceWindowEnd).
 		Exec()
 
 	if err != nil {
@@ -219,6 +230,11 @@ func (ws writeSession) insertDNSConfig(config model.GardenerConfig) dberrors.Err
 }
 
 func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
+	workerPools, err := encodeWorkerPools(config.WorkerPools)
+	if err != nil {
+		return dberrors.Internal("Failed to encode worker pools: %s", err)
+	}
+
 	res, err := ws.update("gardener_config").
 		Where(dbr.Eq("cluster_id", config.ClusterID)).
 		Set("kubernetes_version", config.KubernetesVersion).
@@ -239,6 +255,9 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 		Set("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Set("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Set("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
+		Set("worker_pools", workerPools).
+		Set("maintenance_window_begin", config.MaintenanceWindowBegin).
+		Set("maintenance_window_end", config.MaintenanceWindowEnd).
 		Exec()
 
 	if config.OIDCConfig != nil {
@@ -417,6 +436,19 @@ func (ws writeSession) UpdateOperationLastError(operationID, msg, reason, compon
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s last error: %s", operationID, err))
 }
 
+func (ws writeSession) SetOperationRollback(operationID, rollbackOperationID string) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.Eq("id", operationID)).
+		Set("rollback_operation_id", rollbackOperationID).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to set operation %s rollback: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to set operation %s rollback: %s", operationID, err))
+}
+
 func (ws writeSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error {
 	res, err := ws.update("operation").
 		Where(dbr.Eq("id", operationID)).
@@ -617,3 +649,16 @@ func (ws writeSession) encryptString(s string) (string, dberrors.Error) {
 	}
 	return string(encrypted), nil
 }
+
+func encodeWorkerPools(pools []model.WorkerPool) (*string, error) {
+	if pools == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(pools)
+	if err != nil {
+		return nil, err
+	}
+	workerPools := string(encoded)
+	return &workerPools, nil
+}
@@ -31,6 +31,10 @@ type Service interface {
 	RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError)
 	RollBackLastUpgrade(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError)
 	HibernateCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError)
+	WakeUpCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError)
+	ListRuntimes(filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimesPage, apperrors.AppError)
+	ListOperations(filter *gqlschema.OperationsFilter, first *int, after *string) (*gqlschema.OperationsPage, apperrors.AppError)
+	RuntimeDrift(id string) (*gqlschema.RuntimeDrift, apperrors.AppError)
 }
 
 //go:generate mockery --name=Provisioner
@@ -65,6 +69,7 @@ type service struct {
 	upgradeQueue                 queue.OperationQueue
 	shootUpgradeQueue            queue.OperationQueue
 	hibernationQueue             queue.OperationQueue
+	wakeUpQueue                  queue.OperationQueue
 }
 
 func NewProvisioningService(
@@ -83,6 +88,7 @@ func NewProvisioningService(
 	upgradeQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
 	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 
 ) Service {
 	return &service{
@@ -99,6 +105,7 @@ func NewProvisioningService(
 		upgradeQueue:                 upgradeQueue,
 		shootUpgradeQueue:            shootUpgradeQueue,
 		hibernationQueue:             hibernationQueue,
+		wakeUpQueue:                  wakeUpQueue,
 		shootProvider:                shootProvider,
 		installationClient:           installationClient,
 	}
@@ -327,6 +334,41 @@ func (r *service) HibernateCluster(runtimeID string) (*gqlschema.OperationStatus
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) WakeUpCluster(runtimeID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting wake up for Runtime '%s'...", runtimeID)
+
+	session := r.dbSessionFactory.NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to find shoot cluster to wake up in database: %s", dberr.Error())
+	}
+
+	hibernationStatus, err := r.provisioner.GetHibernationStatus(cluster.ID, cluster.ClusterConfig)
+	if err != nil {
+		return nil, err.Append("failed to get hibernation status")
+	}
+	if !hibernationStatus.Hibernated {
+		return nil, apperrors.BadRequest("cannot wake up Runtime %s which is not hibernated", runtimeID)
+	}
+
+	writeSession := r.dbSessionFactory.NewWriteSession()
+
+	operation, dberr := r.setOperationStarted(writeSession, cluster.ID, model.WakeUp, model.WaitForWakeUp, time.Now(), "Starting wake up")
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to set wake up started: %s", dberr.Error())
+	}
+
+	r.wakeUpQueue.Add(operation.ID)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
 func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, runtimeId string) apperrors.AppError {
 	lastOperation, dberr := session.GetLastOperation(runtimeId)
 	if dberr != nil {
@@ -437,6 +479,93 @@ func (r *service) RuntimeOperationStatus(operationID string) (*gqlschema.Operati
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) RuntimeDrift(runtimeID string) (*gqlschema.RuntimeDrift, apperrors.AppError) {
+	cluster, dberr := r.dbSessionFactory.NewReadSession().GetCluster(runtimeID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get Runtime Drift")
+	}
+
+	shoot, err := r.shootProvider.Get(runtimeID, cluster.Tenant)
+	if err != nil {
+		return nil, err.Append("failed to get Runtime Drift")
+	}
+
+	return r.graphQLConverter.RuntimeDriftToGraphQLDrift(runtimeID, cluster.ClusterConfig.DetectDrift(shoot)), nil
+}
+
+func (r *service) ListRuntimes(filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimesPage, apperrors.AppError) {
+	page, err := newPage(first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	// one more item is fetched to find out if there is a next page
+	clusters, dberr := r.dbSessionFactory.NewReadSession().ListRuntimes(runtimeFilterFromGraphQL(filter), model.Page{Limit: page.Limit + 1, After: page.After})
+	if dberr != nil {
+		return nil, dberr.Append("failed to list Runtimes")
+	}
+
+	hasNextPage := len(clusters) > page.Limit
+	if hasNextPage {
+		clusters = clusters[:page.Limit]
+	}
+
+	runtimes := make([]*gqlschema.Runtime, 0, len(clusters))
+	for _, cluster := range clusters {
+		runtimes = append(runtimes, r.graphQLConverter.RuntimeToGraphQLRuntime(cluster))
+	}
+
+	var last *model.PageCursor
+	if len(clusters) > 0 {
+		cluster := clusters[len(clusters)-1]
+		last = &model.PageCursor{Timestamp: cluster.CreationTimestamp, ID: cluster.ID}
+	}
+
+	return &gqlschema.RuntimesPage{
+		Data:     runtimes,
+		PageInfo: newPageInfo(hasNextPage, last),
+	}, nil
+}
+
+func (r *service) ListOperations(filter *gqlschema.OperationsFilter, first *int, after *string) (*gqlschema.OperationsPage, apperrors.AppError) {
+	page, err := newPage(first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	operationFilter, err := operationFilterFromGraphQL(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// one more item is fetched to find out if there is a next page
+	operations, dberr := r.dbSessionFactory.NewReadSession().ListOperations(operationFilter, model.Page{Limit: page.Limit + 1, After: page.After})
+	if dberr != nil {
+		return nil, dberr.Append("failed to list operations")
+	}
+
+	hasNextPage := len(operations) > page.Limit
+	if hasNextPage {
+		operations = operations[:page.Limit]
+	}
+
+	statuses := make([]*gqlschema.OperationStatus, 0, len(operations))
+	for _, operation := range operations {
+		statuses = append(statuses, r.graphQLConverter.OperationStatusToGQLOperationStatus(operation))
+	}
+
+	var last *model.PageCursor
+	if len(operations) > 0 {
+		operation := operations[len(operations)-1]
+		last = &model.PageCursor{Timestamp: operation.StartTimestamp, ID: operation.ID}
+	}
+
+	return &gqlschema.OperationsPage{
+		Data:     statuses,
+		PageInfo: newPageInfo(hasNextPage, last),
+	}, nil
+}
+
 func (r *service) RollBackLastUpgrade(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
 
 	readSession := r.dbSessionFactory.NewReadSession()
@@ -519,6 +648,16 @@ func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apper
 		return model.RuntimeStatus{}, apperr
 	}
 
+	hibernationStatus.HibernatedAt, err = lastSucceededOperationEnd(session, runtimeID, model.Hibernate)
+	if err != nil {
+		return model.RuntimeStatus{}, err
+	}
+
+	hibernationStatus.WokenUpAt, err = lastSucceededOperationEnd(session, runtimeID, model.WakeUp)
+	if err != nil {
+		return model.RuntimeStatus{}, err
+	}
+
 	return model.RuntimeStatus{
 		LastOperationStatus:  operation,
 		RuntimeConfiguration: cluster,
@@ -526,6 +665,18 @@ func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apper
 	}, nil
 }
 
+func lastSucceededOperationEnd(session dbsession.ReadSession, runtimeID string, operationType model.OperationType) (*time.Time, dberrors.Error) {
+	operation, err := session.GetLastSucceededOperation(runtimeID, operationType)
+	if err != nil {
+		if err.Code() == dberrors.CodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return operation.EndTimestamp, nil
+}
+
 func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runtimeID string, cluster model.Cluster, withKymaConfig bool) (model.Operation, dberrors.Error) {
 	timestamp := time.Now()
 	cluster.CreationTimestamp = timestamp
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	directormock "github.com/kyma-project/control-plane/components/provisioner/internal/director/mocks"
@@ -136,7 +137,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -182,7 +183,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningNoInstallQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningNoInstallQueue, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningNoInstallQueue, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
@@ -218,7 +219,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 		directorServiceMock.On("DeleteRuntime", runtimeID, tenant).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -251,7 +252,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(apperrors.Internal("error"))
 		directorServiceMock.On("DeleteRuntime", runtimeID, tenant).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -273,7 +274,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Return("", apperrors.Internal("registering error"))
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -308,7 +309,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -382,7 +383,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		installationClient.On("CheckInstallationState", mock.Anything).Return(installedState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -415,7 +416,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		installationClient.On("CheckInstallationState", mock.Anything).Return(errorEmptyState, errors.New("Installation error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -453,7 +454,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), false, mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -496,7 +497,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 
 		installationClient.On("CheckInstallationState", mock.Anything).Return(notInstalledState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -543,7 +544,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), true, mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -570,7 +571,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), false, mock.MatchedBy(notEmptyUUIDMatcher)).Return(model.Operation{}, apperrors.Internal("some error"))
 		installationClient.On("CheckInstallationState", mock.Anything).Return(installedState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -595,7 +596,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -619,7 +620,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -642,7 +643,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(model.Operation{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -678,7 +679,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeOperationStatus(operationID)
@@ -701,7 +702,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeOperationStatus(operationID)
@@ -713,6 +714,82 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 	})
 }
 
+func TestService_RuntimeDrift(t *testing.T) {
+	uuidGenerator := &uuidMocks.UUIDGenerator{}
+	inputConverter := NewInputConverter(uuidGenerator, nil, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			KubernetesVersion: "1.24",
+			MachineType:       "small",
+			AutoScalerMin:     1,
+			AutoScalerMax:     3,
+			MaxSurge:          1,
+			MaxUnavailable:    0,
+		},
+	}
+
+	shoot := gardener_Types.Shoot{
+		Spec: gardener_Types.ShootSpec{
+			Kubernetes: gardener_Types.Kubernetes{Version: "1.24"},
+			Provider: gardener_Types.Provider{
+				Workers: []gardener_Types.Worker{{
+					Machine:        gardener_Types.Machine{Type: "large"},
+					Minimum:        1,
+					Maximum:        3,
+					MaxSurge:       util.IntOrStringPtr(intstr.FromInt(1)),
+					MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(0)),
+				}},
+			},
+		},
+	}
+
+	t.Run("Should return shoot fields modified outside of Provisioner", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		shootProvider := &mocks2.ShootProvider{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(shoot, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		drift, err := service.RuntimeDrift(runtimeID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gqlschema.RuntimeDrift{
+			RuntimeID: runtimeID,
+			Fields:    []*gqlschema.DriftedField{{Field: "machineType", Expected: "small", Actual: "large"}},
+		}, drift)
+	})
+
+	t.Run("Should return error when failed to get shoot", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		shootProvider := &mocks2.ShootProvider{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(gardener_Types.Shoot{}, apperrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.RuntimeDrift(runtimeID)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
 func TestService_RuntimeStatus(t *testing.T) {
 	uuidGenerator := &uuidMocks.UUIDGenerator{}
 	inputConverter := NewInputConverter(uuidGenerator, nil, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
@@ -731,6 +808,8 @@ func TestService_RuntimeStatus(t *testing.T) {
 		Kubeconfig: util.StringPtr("kubeconfig"),
 	}
 
+	hibernatedAt := time.Now()
+
 	t.Run("Should return runtime status", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
@@ -739,6 +818,8 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(cluster, nil)
+		readSession.On("GetLastSucceededOperation", operationID, model.Hibernate).Return(model.Operation{EndTimestamp: &hibernatedAt}, nil)
+		readSession.On("GetLastSucceededOperation", operationID, model.WakeUp).Return(model.Operation{}, dberrors.NotFound("error"))
 
 		provisioner := &mocks2.Provisioner{}
 
@@ -747,7 +828,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 			Hibernated:          true,
 		}, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeStatus(operationID)
@@ -756,6 +837,8 @@ func TestService_RuntimeStatus(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, cluster.ID, *status.LastOperationStatus.RuntimeID)
 		assert.Equal(t, cluster.Kubeconfig, status.RuntimeConfiguration.Kubeconfig)
+		assert.Equal(t, &hibernatedAt, status.HibernationStatus.HibernatedAt)
+		assert.Nil(t, status.HibernationStatus.WokenUpAt)
 		sessionFactoryMock.AssertExpectations(t)
 		readSession.AssertExpectations(t)
 	})
@@ -769,7 +852,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(model.Cluster{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -788,7 +871,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -810,7 +893,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetCluster", operationID).Return(cluster, nil)
 		provisioner.On("GetHibernationStatus", mock.AnythingOfType("string"), cluster.ClusterConfig).Return(model.HibernationStatus{}, apperrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -939,7 +1022,7 @@ func TestService_UpgradeRuntime(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, writeSession, readSession, shootProvider, upgradeQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil, nil)
 
 			// when
 			operationStatus, err := service.UpgradeRuntime(runtimeID, upgradeInput)
@@ -1029,7 +1112,7 @@ func TestService_UpgradeRuntime(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, writeSession, readSession, shootProvider)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil, nil)
 
 			// when
 			_, err := service.UpgradeRuntime(runtimeID, upgradeInput)
@@ -1143,7 +1226,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider, upgradeShootQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil, nil)
 
 			// when
 			operationStatus, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
@@ -1263,7 +1346,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil, nil)
 
 			// when
 			_, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
@@ -1312,6 +1395,7 @@ func TestService_RollBackLastUpgrade(t *testing.T) {
 		readSessionMock.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readSessionMock.On("GetRuntimeUpgrade", operationID).Return(runtimeUpgrade, nil)
 		readSessionMock.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSessionMock.On("GetLastSucceededOperation", runtimeID, mock.AnythingOfType("model.OperationType")).Return(model.Operation{}, dberrors.NotFound("error"))
 		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
 		writeSessionWithinTransactionMock.On("SetActiveKymaConfig", runtimeID, oldKymaConfigId).Return(nil)
 		writeSessionWithinTransactionMock.On("UpdateUpgradeState", operationID, model.UpgradeRolledBack).Return(nil)
@@ -1325,7 +1409,7 @@ func TestService_RollBackLastUpgrade(t *testing.T) {
 			Hibernated:          true,
 		}, nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		runtimeStatus, err := service.RollBackLastUpgrade(runtimeID)
@@ -1371,7 +1455,7 @@ func TestService_RollBackLastUpgrade(t *testing.T) {
 
 			testCase.mockFunc(sessionFactoryMock, writeSessionWithinTransactionMock, readSessionMock)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			_, err := service.RollBackLastUpgrade(runtimeID)
@@ -1490,7 +1574,7 @@ func TestService_HibernateShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactoryMock, writeSessionWithinTransactionMock, readSessionMock, provisioner)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			_, err := service.HibernateCluster(runtimeID)
@@ -1522,7 +1606,7 @@ func TestService_HibernateShoot(t *testing.T) {
 		writeSessionWithinTransactionMock.On("Commit").Return(nil)
 		hibernationQueue.On("Add", mock.AnythingOfType("string")).Return()
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, hibernationQueue)
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, hibernationQueue, nil)
 
 		// when
 		runtimeStatus, err := service.HibernateCluster(runtimeID)
@@ -1537,6 +1621,267 @@ func TestService_HibernateShoot(t *testing.T) {
 	})
 }
 
+func TestService_WakeUpShoot(t *testing.T) {
+	releaseProvider := &releaseMocks.Provider{}
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), releaseProvider, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	uuidGenerator := uuid.NewUUIDGenerator()
+	graphQLConverter := NewGraphQLConverter()
+
+	lastOperation := model.Operation{ID: operationID, State: model.Succeeded, Type: model.Hibernate}
+
+	cluster := model.Cluster{
+		ID: runtimeID,
+	}
+
+	wakeUpOperation := model.Operation{
+		ID:        operationID,
+		Type:      model.WakeUp,
+		State:     model.InProgress,
+		ClusterID: runtimeID,
+		Stage:     model.WaitForWakeUp,
+	}
+
+	for _, testCase := range []struct {
+		description string
+		mockFunc    func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSession, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner)
+	}{
+		{
+			description: "should fail when operation in progress",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSession, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner) {
+				sessionFactory.On("NewReadSession").Return(readSession, nil)
+				readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: operationID, State: model.InProgress, Type: model.Hibernate}, nil)
+			},
+		},
+		{
+			description: "should fail when failed to get cluster",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSession, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner) {
+				sessionFactory.On("NewReadSession").Return(readSession, nil)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("error"))
+			},
+		},
+		{
+			description: "should fail when runtime is not hibernated",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSession, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner) {
+				sessionFactory.On("NewReadSession").Return(readSession, nil)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				provisioner.On("GetHibernationStatus", runtimeID, cluster.ClusterConfig).Return(model.HibernationStatus{Hibernated: false}, nil)
+			},
+		},
+		{
+			description: "should fail when failed to set operation started",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSession, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner) {
+				sessionFactory.On("NewReadSession").Return(readSession, nil)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				provisioner.On("GetHibernationStatus", runtimeID, cluster.ClusterConfig).Return(model.HibernationStatus{Hibernated: true}, nil)
+				sessionFactory.On("NewWriteSession").Return(writeSession)
+				writeSession.On("InsertOperation", mock.MatchedBy(getOperationMatcher(wakeUpOperation))).Return(dberrors.Internal("error"))
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			sessionFactoryMock := &sessionMocks.Factory{}
+			writeSessionMock := &sessionMocks.WriteSession{}
+			readSessionMock := &sessionMocks.ReadSession{}
+			provisionerMock := &mocks2.Provisioner{}
+
+			testCase.mockFunc(sessionFactoryMock, writeSessionMock, readSessionMock, provisionerMock)
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			_, err := service.WakeUpCluster(runtimeID)
+			require.Error(t, err)
+
+			// then
+			sessionFactoryMock.AssertExpectations(t)
+			writeSessionMock.AssertExpectations(t)
+			readSessionMock.AssertExpectations(t)
+			provisionerMock.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Should start cluster wake up and return operation ID", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionMock := &sessionMocks.WriteSession{}
+		readSessionMock := &sessionMocks.ReadSession{}
+		wakeUpQueue := &mocks.OperationQueue{}
+		provisionerMock := &mocks2.Provisioner{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSessionMock, nil)
+		readSessionMock.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSessionMock.On("GetCluster", runtimeID).Return(cluster, nil)
+		provisionerMock.On("GetHibernationStatus", runtimeID, cluster.ClusterConfig).Return(model.HibernationStatus{Hibernated: true}, nil)
+		sessionFactoryMock.On("NewWriteSession").Return(writeSessionMock)
+		writeSessionMock.On("InsertOperation", mock.MatchedBy(getOperationMatcher(wakeUpOperation))).Return(nil)
+		wakeUpQueue.On("Add", mock.AnythingOfType("string")).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, wakeUpQueue)
+
+		// when
+		operationStatus, err := service.WakeUpCluster(runtimeID)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, gqlschema.OperationTypeWakeUp, operationStatus.Operation)
+		sessionFactoryMock.AssertExpectations(t)
+		writeSessionMock.AssertExpectations(t)
+		readSessionMock.AssertExpectations(t)
+		wakeUpQueue.AssertExpectations(t)
+	})
+}
+
+func TestService_ListRuntimes(t *testing.T) {
+	graphQLConverter := NewGraphQLConverter()
+	creationTimestamp := time.Now()
+
+	clusters := []model.Cluster{
+		{ID: "runtime-1", Tenant: tenant, CreationTimestamp: creationTimestamp, ClusterConfig: model.GardenerConfig{Name: "shoot-1"}},
+		{ID: "runtime-2", Tenant: tenant, CreationTimestamp: creationTimestamp},
+		{ID: "runtime-3", Tenant: tenant, CreationTimestamp: creationTimestamp},
+	}
+
+	t.Run("should return first page with cursor", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		first := 2
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListRuntimes", model.RuntimeFilter{Tenant: util.StringPtr(tenant)}, model.Page{Limit: 3}).Return(clusters, nil)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListRuntimes(&gqlschema.RuntimesFilter{Tenant: util.StringPtr(tenant)}, &first, nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 2)
+		assert.Equal(t, "runtime-1", page.Data[0].ID)
+		assert.Equal(t, "shoot-1", *page.Data[0].ShootName)
+		assert.Nil(t, page.Data[1].ShootName)
+		assert.True(t, page.PageInfo.HasNextPage)
+		require.NotNil(t, page.PageInfo.EndCursor)
+
+		cursor, cursorErr := decodeCursor(*page.PageInfo.EndCursor)
+		require.NoError(t, cursorErr)
+		assert.Equal(t, "runtime-2", cursor.ID)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("should return last page", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		after := encodeCursor(model.PageCursor{Timestamp: creationTimestamp, ID: "runtime-0"})
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListRuntimes", model.RuntimeFilter{}, mock.MatchedBy(func(page model.Page) bool {
+			return page.Limit == defaultPageSize+1 && page.After != nil && page.After.ID == "runtime-0"
+		})).Return(clusters, nil)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListRuntimes(nil, nil, &after)
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, page.Data, 3)
+		assert.False(t, page.PageInfo.HasNextPage)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to list runtimes", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListRuntimes", mock.Anything, mock.Anything).Return(nil, dberrors.Internal("error"))
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ListRuntimes(nil, nil, nil)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestService_ListOperations(t *testing.T) {
+	graphQLConverter := NewGraphQLConverter()
+	startTimestamp := time.Now()
+
+	operations := []model.Operation{
+		{ID: "operation-1", Type: model.Provision, State: model.InProgress, ClusterID: runtimeID, StartTimestamp: startTimestamp},
+		{ID: "operation-2", Type: model.Provision, State: model.InProgress, ClusterID: runtimeID, StartTimestamp: startTimestamp},
+	}
+
+	t.Run("should list operations", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		state := gqlschema.OperationStateInProgress
+		inProgress := model.InProgress
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListOperations", model.OperationFilter{State: &inProgress}, model.Page{Limit: defaultPageSize + 1}).Return(operations, nil)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListOperations(&gqlschema.OperationsFilter{State: &state}, nil, nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 2)
+		assert.Equal(t, gqlschema.OperationTypeProvision, page.Data[0].Operation)
+		assert.False(t, page.PageInfo.HasNextPage)
+		require.NotNil(t, page.PageInfo.EndCursor)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("should return empty page", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListOperations", model.OperationFilter{}, model.Page{Limit: defaultPageSize + 1}).Return([]model.Operation{}, nil)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListOperations(nil, nil, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, page.Data)
+		assert.False(t, page.PageInfo.HasNextPage)
+		assert.Nil(t, page.PageInfo.EndCursor)
+	})
+
+	t.Run("should return error for invalid page size", func(t *testing.T) {
+		// given
+		first := -1
+		service := NewProvisioningService(nil, graphQLConverter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ListOperations(nil, &first, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+}
+
 func getOperationMatcher(expected model.Operation) func(model.Operation) bool {
 	return func(op model.Operation) bool {
 		return op.Type == expected.Type && op.ClusterID == expected.ClusterID &&
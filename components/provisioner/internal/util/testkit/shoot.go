@@ -180,6 +180,12 @@ func (ts *TestShoot) WithHibernationState(hibernationPossible bool, hibernated b
 	return ts
 }
 
+// WithHibernationEnabled sets shoot.Spec.Hibernation.Enabled
+func (ts *TestShoot) WithHibernationEnabled(enabled bool) *TestShoot {
+	ts.shoot.Spec.Hibernation = &v1beta1.Hibernation{Enabled: &enabled}
+	return ts
+}
+
 // WithPSPAdmissionPluginDisabled sets shoot.Status.LastOperation to nil
 func (ts *TestShoot) WithPSPAdmissionPluginDisabled() *TestShoot {
 	disable := true
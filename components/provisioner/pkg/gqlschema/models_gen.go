@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type ProviderSpecificConfig interface {
@@ -122,6 +123,12 @@ type DNSProviderInput struct {
 	Type           string   `json:"type"`
 }
 
+type DriftedField struct {
+	Field    string `json:"field"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
 type Error struct {
 	Message *string `json:"message"`
 }
@@ -165,62 +172,72 @@ type GardenerConfig struct {
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
 	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance"`
 	EuAccess                            *bool                  `json:"euAccess"`
+	WorkerPools                         []*WorkerPool          `json:"workerPools"`
+	Networking                          *Networking            `json:"networking"`
+	MaintenanceWindow                   *MaintenanceWindow     `json:"maintenanceWindow"`
 }
 
 type GardenerConfigInput struct {
-	Name                                string                 `json:"name"`
-	KubernetesVersion                   string                 `json:"kubernetesVersion"`
-	Provider                            string                 `json:"provider"`
-	TargetSecret                        string                 `json:"targetSecret"`
-	Region                              string                 `json:"region"`
-	MachineType                         string                 `json:"machineType"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	WorkerCidr                          string                 `json:"workerCidr"`
-	AutoScalerMin                       int                    `json:"autoScalerMin"`
-	AutoScalerMax                       int                    `json:"autoScalerMax"`
-	MaxSurge                            int                    `json:"maxSurge"`
-	MaxUnavailable                      int                    `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	LicenceType                         *string                `json:"licenceType"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	AllowPrivilegedContainers           *bool                  `json:"allowPrivilegedContainers"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	DNSConfig                           *DNSConfigInput        `json:"dnsConfig"`
-	Seed                                *string                `json:"seed"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
-	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance"`
-	EuAccess                            *bool                  `json:"euAccess"`
+	Name                                string                  `json:"name"`
+	KubernetesVersion                   string                  `json:"kubernetesVersion"`
+	Provider                            string                  `json:"provider"`
+	TargetSecret                        string                  `json:"targetSecret"`
+	Region                              string                  `json:"region"`
+	MachineType                         string                  `json:"machineType"`
+	MachineImage                        *string                 `json:"machineImage"`
+	MachineImageVersion                 *string                 `json:"machineImageVersion"`
+	DiskType                            *string                 `json:"diskType"`
+	VolumeSizeGb                        *int                    `json:"volumeSizeGB"`
+	WorkerCidr                          string                  `json:"workerCidr"`
+	AutoScalerMin                       int                     `json:"autoScalerMin"`
+	AutoScalerMax                       int                     `json:"autoScalerMax"`
+	MaxSurge                            int                     `json:"maxSurge"`
+	MaxUnavailable                      int                     `json:"maxUnavailable"`
+	Purpose                             *string                 `json:"purpose"`
+	LicenceType                         *string                 `json:"licenceType"`
+	EnableKubernetesVersionAutoUpdate   *bool                   `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                   `json:"enableMachineImageVersionAutoUpdate"`
+	AllowPrivilegedContainers           *bool                   `json:"allowPrivilegedContainers"`
+	ProviderSpecificConfig              *ProviderSpecificInput  `json:"providerSpecificConfig"`
+	DNSConfig                           *DNSConfigInput         `json:"dnsConfig"`
+	Seed                                *string                 `json:"seed"`
+	OidcConfig                          *OIDCConfigInput        `json:"oidcConfig"`
+	ExposureClassName                   *string                 `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                   `json:"shootNetworkingFilterDisabled"`
+	ControlPlaneFailureTolerance        *string                 `json:"controlPlaneFailureTolerance"`
+	EuAccess                            *bool                   `json:"euAccess"`
+	WorkerPools                         []*WorkerPoolInput      `json:"workerPools"`
+	Networking                          *NetworkingInput        `json:"networking"`
+	MaintenanceWindow                   *MaintenanceWindowInput `json:"maintenanceWindow"`
 }
 
 type GardenerUpgradeInput struct {
-	KubernetesVersion                   *string                `json:"kubernetesVersion"`
-	MachineType                         *string                `json:"machineType"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	AutoScalerMin                       *int                   `json:"autoScalerMin"`
-	AutoScalerMax                       *int                   `json:"autoScalerMax"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	MaxSurge                            *int                   `json:"maxSurge"`
-	MaxUnavailable                      *int                   `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
+	KubernetesVersion                   *string                 `json:"kubernetesVersion"`
+	MachineType                         *string                 `json:"machineType"`
+	DiskType                            *string                 `json:"diskType"`
+	VolumeSizeGb                        *int                    `json:"volumeSizeGB"`
+	AutoScalerMin                       *int                    `json:"autoScalerMin"`
+	AutoScalerMax                       *int                    `json:"autoScalerMax"`
+	MachineImage                        *string                 `json:"machineImage"`
+	MachineImageVersion                 *string                 `json:"machineImageVersion"`
+	MaxSurge                            *int                    `json:"maxSurge"`
+	MaxUnavailable                      *int                    `json:"maxUnavailable"`
+	Purpose                             *string                 `json:"purpose"`
+	EnableKubernetesVersionAutoUpdate   *bool                   `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                   `json:"enableMachineImageVersionAutoUpdate"`
+	ProviderSpecificConfig              *ProviderSpecificInput  `json:"providerSpecificConfig"`
+	OidcConfig                          *OIDCConfigInput        `json:"oidcConfig"`
+	ExposureClassName                   *string                 `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                   `json:"shootNetworkingFilterDisabled"`
+	WorkerPools                         []*WorkerPoolInput      `json:"workerPools"`
+	MaintenanceWindow                   *MaintenanceWindowInput `json:"maintenanceWindow"`
 }
 
 type HibernationStatus struct {
-	Hibernated          *bool `json:"hibernated"`
-	HibernationPossible *bool `json:"hibernationPossible"`
+	Hibernated          *bool      `json:"hibernated"`
+	HibernationPossible *bool      `json:"hibernationPossible"`
+	HibernatedAt        *time.Time `json:"hibernatedAt"`
+	WokenUpAt           *time.Time `json:"wokenUpAt"`
 }
 
 type KymaConfig struct {
@@ -244,6 +261,28 @@ type LastError struct {
 	Component  string `json:"component"`
 }
 
+type MaintenanceWindow struct {
+	Begin string `json:"begin"`
+	End   string `json:"end"`
+}
+
+type MaintenanceWindowInput struct {
+	Begin string `json:"begin"`
+	End   string `json:"end"`
+}
+
+type Networking struct {
+	Pods     *string `json:"pods"`
+	Services *string `json:"services"`
+	Nodes    *string `json:"nodes"`
+}
+
+type NetworkingInput struct {
+	Pods     *string `json:"pods"`
+	Services *string `json:"services"`
+	Nodes    *string `json:"nodes"`
+}
+
 type OIDCConfig struct {
 	ClientID       string   `json:"clientID"`
 	GroupsClaim    string   `json:"groupsClaim"`
@@ -279,12 +318,33 @@ type OpenStackProviderConfigInput struct {
 }
 
 type OperationStatus struct {
-	ID        *string        `json:"id"`
-	Operation OperationType  `json:"operation"`
-	State     OperationState `json:"state"`
-	Message   *string        `json:"message"`
-	RuntimeID *string        `json:"runtimeID"`
-	LastError *LastError     `json:"lastError"`
+	ID                  *string        `json:"id"`
+	Operation           OperationType  `json:"operation"`
+	State               OperationState `json:"state"`
+	Message             *string        `json:"message"`
+	RuntimeID           *string        `json:"runtimeID"`
+	LastError           *LastError     `json:"lastError"`
+	RollbackOperationID *string        `json:"rollbackOperationID"`
+}
+
+type OperationsFilter struct {
+	Tenant        *string         `json:"tenant"`
+	RuntimeID     *string         `json:"runtimeID"`
+	ShootName     *string         `json:"shootName"`
+	State         *OperationState `json:"state"`
+	Type          *OperationType  `json:"type"`
+	StartedAfter  *time.Time      `json:"startedAfter"`
+	StartedBefore *time.Time      `json:"startedBefore"`
+}
+
+type OperationsPage struct {
+	Data     []*OperationStatus `json:"data"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
 }
 
 type ProviderSpecificInput struct {
@@ -300,6 +360,15 @@ type ProvisionRuntimeInput struct {
 	KymaConfig    *KymaConfigInput    `json:"kymaConfig"`
 }
 
+type Runtime struct {
+	ID                string     `json:"id"`
+	Tenant            *string    `json:"tenant"`
+	SubAccountID      *string    `json:"subAccountID"`
+	ShootName         *string    `json:"shootName"`
+	CreationTimestamp *time.Time `json:"creationTimestamp"`
+	Deleted           *bool      `json:"deleted"`
+}
+
 type RuntimeConfig struct {
 	ClusterConfig *GardenerConfig `json:"clusterConfig"`
 	KymaConfig    *KymaConfig     `json:"kymaConfig"`
@@ -311,6 +380,11 @@ type RuntimeConnectionStatus struct {
 	Errors []*Error                     `json:"errors"`
 }
 
+type RuntimeDrift struct {
+	RuntimeID string          `json:"runtimeID"`
+	Fields    []*DriftedField `json:"fields"`
+}
+
 type RuntimeInput struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
@@ -324,6 +398,31 @@ type RuntimeStatus struct {
 	HibernationStatus       *HibernationStatus       `json:"hibernationStatus"`
 }
 
+type RuntimesFilter struct {
+	Tenant        *string    `json:"tenant"`
+	ShootName     *string    `json:"shootName"`
+	Deleted       *bool      `json:"deleted"`
+	CreatedAfter  *time.Time `json:"createdAfter"`
+	CreatedBefore *time.Time `json:"createdBefore"`
+}
+
+type RuntimesPage struct {
+	Data     []*Runtime `json:"data"`
+	PageInfo *PageInfo  `json:"pageInfo"`
+}
+
+type Taint struct {
+	Key    string      `json:"key"`
+	Value  *string     `json:"value"`
+	Effect TaintEffect `json:"effect"`
+}
+
+type TaintInput struct {
+	Key    string      `json:"key"`
+	Value  *string     `json:"value"`
+	Effect TaintEffect `json:"effect"`
+}
+
 type UpgradeRuntimeInput struct {
 	KymaConfig *KymaConfigInput `json:"kymaConfig"`
 }
@@ -333,6 +432,30 @@ type UpgradeShootInput struct {
 	Administrators []string              `json:"administrators"`
 }
 
+type WorkerPool struct {
+	Name          string   `json:"name"`
+	MachineType   string   `json:"machineType"`
+	AutoScalerMin int      `json:"autoScalerMin"`
+	AutoScalerMax int      `json:"autoScalerMax"`
+	Zones         []string `json:"zones"`
+	DiskType      *string  `json:"diskType"`
+	VolumeSizeGb  *int     `json:"volumeSizeGB"`
+	Labels        Labels   `json:"labels"`
+	Taints        []*Taint `json:"taints"`
+}
+
+type WorkerPoolInput struct {
+	Name          string        `json:"name"`
+	MachineType   string        `json:"machineType"`
+	AutoScalerMin int           `json:"autoScalerMin"`
+	AutoScalerMax int           `json:"autoScalerMax"`
+	Zones         []string      `json:"zones"`
+	DiskType      *string       `json:"diskType"`
+	VolumeSizeGb  *int          `json:"volumeSizeGB"`
+	Labels        Labels        `json:"labels"`
+	Taints        []*TaintInput `json:"taints"`
+}
+
 type ConflictStrategy string
 
 const (
@@ -471,6 +594,7 @@ const (
 	OperationTypeDeprovisionNoInstall OperationType = "DeprovisionNoInstall"
 	OperationTypeReconnectRuntime     OperationType = "ReconnectRuntime"
 	OperationTypeHibernate            OperationType = "Hibernate"
+	OperationTypeWakeUp               OperationType = "WakeUp"
 )
 
 var AllOperationType = []OperationType{
@@ -482,11 +606,12 @@ var AllOperationType = []OperationType{
 	OperationTypeDeprovisionNoInstall,
 	OperationTypeReconnectRuntime,
 	OperationTypeHibernate,
+	OperationTypeWakeUp,
 }
 
 func (e OperationType) IsValid() bool {
 	switch e {
-	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate:
+	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate, OperationTypeWakeUp:
 		return true
 	}
 	return false
@@ -555,3 +680,46 @@ func (e *RuntimeAgentConnectionStatus) UnmarshalGQL(v interface{}) error {
 func (e RuntimeAgentConnectionStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type TaintEffect string
+
+const (
+	TaintEffectNoSchedule       TaintEffect = "NoSchedule"
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+	TaintEffectNoExecute        TaintEffect = "NoExecute"
+)
+
+var AllTaintEffect = []TaintEffect{
+	TaintEffectNoSchedule,
+	TaintEffectPreferNoSchedule,
+	TaintEffectNoExecute,
+}
+
+func (e TaintEffect) IsValid() bool {
+	switch e {
+	case TaintEffectNoSchedule, TaintEffectPreferNoSchedule, TaintEffectNoExecute:
+		return true
+	}
+	return false
+}
+
+func (e TaintEffect) String() string {
+	return string(e)
+}
+
+func (e *TaintEffect) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaintEffect(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaintEffect", str)
+	}
+	return nil
+}
+
+func (e TaintEffect) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
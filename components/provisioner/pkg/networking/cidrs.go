@@ -0,0 +1,68 @@
+// Package networking validates the custom network ranges of the clusters, it is used by Provisioner and
+// Kyma Environment Broker, so that both reject the same ranges
+package networking
+
+import (
+	"fmt"
+	"net"
+)
+
+// CIDRs holds the custom network ranges of the cluster, the ranges which are not provided are chosen by Gardener
+type CIDRs struct {
+	Pods     *string
+	Services *string
+	Nodes    *string
+}
+
+// Validate checks if the ranges are valid CIDRs which do not overlap with each other. The nodes range must contain
+// the workers range; if it is not provided, the defaultNodes range of the provider is used for the overlap checks.
+func (c CIDRs) Validate(workers, defaultNodes string) error {
+	names := []string{"pods", "services", "nodes"}
+	cidrs := []*string{c.Pods, c.Services, c.Nodes}
+
+	ranges := make(map[string]*net.IPNet)
+	for i, cidr := range cidrs {
+		if cidr == nil {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(*cidr)
+		if err != nil {
+			return fmt.Errorf("%s CIDR %s is invalid: %w", names[i], *cidr, err)
+		}
+		ranges[names[i]] = ipNet
+	}
+
+	if nodes, ok := ranges["nodes"]; ok {
+		_, workersNet, err := net.ParseCIDR(workers)
+		if err != nil {
+			return fmt.Errorf("workers CIDR %s is invalid: %w", workers, err)
+		}
+		if !contains(nodes, workersNet) {
+			return fmt.Errorf("nodes CIDR %s must contain the workers CIDR %s", nodes, workersNet)
+		}
+	} else if defaultNodes != "" {
+		_, ipNet, err := net.ParseCIDR(defaultNodes)
+		if err != nil {
+			return fmt.Errorf("default nodes CIDR %s is invalid: %w", defaultNodes, err)
+		}
+		ranges["nodes"] = ipNet
+	}
+
+	for i, first := range names {
+		for _, second := range names[i+1:] {
+			a, aOk := ranges[first]
+			b, bOk := ranges[second]
+			if aOk && bOk && (a.Contains(b.IP) || b.Contains(a.IP)) {
+				return fmt.Errorf("%s CIDR %s overlaps with %s CIDR %s", first, a, second, b)
+			}
+		}
+	}
+
+	return nil
+}
+
+func contains(outer, inner *net.IPNet) bool {
+	outerSize, _ := outer.Mask.Size()
+	innerSize, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && outerSize <= innerSize
+}
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE audit_log;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id               varchar(255) NOT NULL PRIMARY KEY,
+    caller           varchar(255) NOT NULL,
+    caller_type      varchar(64) NOT NULL,
+    action           varchar(64) NOT NULL,
+    instance_id      varchar(255) NOT NULL DEFAULT '',
+    orchestration_id varchar(255) NOT NULL DEFAULT '',
+    parameters       text NOT NULL DEFAULT '',
+    status_code      integer NOT NULL,
+    outcome          varchar(32) NOT NULL,
+    error            text NOT NULL DEFAULT '',
+    created_at       timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_instance_id ON audit_log (instance_id);
+CREATE INDEX IF NOT EXISTS audit_log_orchestration_id ON audit_log (orchestration_id);
+CREATE INDEX IF NOT EXISTS audit_log_created_at ON audit_log (created_at);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE job_runs;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS job_runs (
+    id           varchar(255) NOT NULL PRIMARY KEY,
+    job_name     varchar(255) NOT NULL,
+    state        varchar(32) NOT NULL,
+    triggered_by varchar(255) NOT NULL,
+    affected     text NOT NULL DEFAULT '',
+    error        text NOT NULL DEFAULT '',
+    created_at   timestamp with time zone NOT NULL,
+    started_at   timestamp with time zone,
+    finished_at  timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS job_runs_job_name_created_at ON job_runs (job_name, created_at);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE plan_visibility_rules;
+
+COMMIT;
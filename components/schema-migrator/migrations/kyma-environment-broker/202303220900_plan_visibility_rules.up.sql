@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS plan_visibility_rules (
+    plan_name   varchar(255) NOT NULL,
+    scope_type  varchar(32) NOT NULL,
+    scope_value varchar(255) NOT NULL,
+    visible     boolean NOT NULL DEFAULT false,
+    features    text NOT NULL DEFAULT '',
+    updated_by  varchar(255) NOT NULL,
+    created_at  timestamp with time zone NOT NULL,
+    updated_at  timestamp with time zone NOT NULL,
+    PRIMARY KEY (plan_name, scope_type, scope_value)
+);
+
+COMMIT;
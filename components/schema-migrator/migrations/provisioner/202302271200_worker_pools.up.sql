@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE gardener_config ADD COLUMN worker_pools jsonb;
+
+COMMIT;
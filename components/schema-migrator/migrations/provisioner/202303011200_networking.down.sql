@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE gardener_config DROP COLUMN pods_cidr;
+ALTER TABLE gardener_config DROP COLUMN services_cidr;
+ALTER TABLE gardener_config DROP COLUMN nodes_cidr;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE operation DROP COLUMN rollback_operation_id;
+
+COMMIT;
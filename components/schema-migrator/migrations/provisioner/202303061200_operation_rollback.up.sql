@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE operation ADD COLUMN rollback_operation_id uuid;
+
+COMMIT;
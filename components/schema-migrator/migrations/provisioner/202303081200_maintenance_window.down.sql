@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE gardener_config DROP COLUMN maintenance_window_begin;
+ALTER TABLE gardener_config DROP COLUMN maintenance_window_end;
+
+COMMIT;
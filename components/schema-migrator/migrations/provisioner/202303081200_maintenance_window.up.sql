@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE gardener_config ADD COLUMN maintenance_window_begin varchar(256);
+ALTER TABLE gardener_config ADD COLUMN maintenance_window_end varchar(256);
+
+COMMIT;
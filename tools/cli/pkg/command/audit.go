@@ -0,0 +1,170 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+)
+
+// AuditCommand represents an execution of the kcp audit command
+type AuditCommand struct {
+	cobraCmd *cobra.Command
+	log      logger.Logger
+	output   string
+	params   audit.ListParameters
+	actions  []string
+	outcomes []string
+	since    time.Duration
+}
+
+var auditColumns = []printer.Column{
+	{
+		Header:         "TIME",
+		FieldFormatter: auditCreatedAt,
+	},
+	{
+		Header:    "CALLER",
+		FieldSpec: "{.Caller}",
+	},
+	{
+		Header:    "ACTION",
+		FieldSpec: "{.Action}",
+	},
+	{
+		Header:         "TARGET",
+		FieldFormatter: auditTarget,
+	},
+	{
+		Header:         "OUTCOME",
+		FieldFormatter: auditOutcome,
+	},
+}
+
+// NewAuditCmd constructs a new instance of AuditCommand and configures it in terms of a cobra.Command
+func NewAuditCmd() *cobra.Command {
+	cmd := AuditCommand{}
+	cobraCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Displays the audit log of Kyma Environment Broker.",
+		Long: `Displays who called the state-changing Kyma Environment Broker APIs, such as provisioning, deprovisioning, orchestration management, and kubeconfig downloads.
+The newest entries are displayed first. The request parameters are stored with secret values redacted and are displayed in the JSON output.`,
+		Example: `  kcp audit -i 2b7bde3c-ea2d-4bfd-99dc-3f6d1f9e8b36   Display the audit log of the given instance.
+  kcp audit --action deprovision --since 24h        Display all deprovisioning calls from the last day.
+  kcp audit --caller john@example.com -o json       Display all calls of the given caller with the request parameters.`,
+		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	SetOutputOpt(cobraCmd, &cmd.output)
+	cobraCmd.Flags().StringSliceVarP(&cmd.params.InstanceIDs, "instance-id", "i", nil, "Filter by instance ID. You can provide multiple values, either separated by a comma (e.g. ID1,ID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVar(&cmd.params.OrchestrationIDs, "orchestration-id", nil, "Filter by orchestration ID. You can provide multiple values, either separated by a comma (e.g. ID1,ID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVar(&cmd.params.Callers, "caller", nil, "Filter by caller identity. You can provide multiple values, either separated by a comma (e.g. user1,user2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVar(&cmd.actions, "action", nil, "Filter by action. The possible values are: provision, update, deprovision, bind, unbind, createKymaOrchestration, createClusterOrchestration, cancelOrchestration, retryOrchestration, downloadKubeconfig, triggerJob.")
+	cobraCmd.Flags().StringSliceVar(&cmd.outcomes, "outcome", nil, "Filter by outcome. The possible values are: succeeded, failed.")
+	cobraCmd.Flags().DurationVar(&cmd.since, "since", 0, "Display only the entries recorded within the given duration (e.g. 1h, 72h).")
+	cobraCmd.Flags().IntVar(&cmd.params.PageSize, "limit", 100, "Maximum number of entries to display.")
+
+	return cobraCmd
+}
+
+// Run executes the audit command
+func (cmd *AuditCommand) Run() error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	client := audit.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	page, err := client.ListEntries(cmd.params)
+	if err != nil {
+		return errors.Wrap(err, "while listing audit log entries")
+	}
+
+	switch {
+	case cmd.output == tableOutput:
+		tp, err := printer.NewTablePrinter(auditColumns, false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(page.Data)
+	case cmd.output == jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		jp.PrintObj(page)
+	case strings.HasPrefix(cmd.output, customOutput):
+		_, templateFile := printer.ParseOutputToTemplateTypeAndElement(cmd.output)
+		column, err := printer.ParseColumnToHeaderAndFieldSpec(templateFile)
+		if err != nil {
+			return err
+		}
+		ccp, err := printer.NewTablePrinter(column, false)
+		if err != nil {
+			return err
+		}
+		return ccp.PrintObj(page.Data)
+	}
+	return nil
+}
+
+// Validate checks the input parameters of the audit command
+func (cmd *AuditCommand) Validate() error {
+	err := ValidateOutputOpt(cmd.output)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range cmd.actions {
+		switch action := audit.Action(a); action {
+		case audit.ActionProvision, audit.ActionUpdate, audit.ActionDeprovision, audit.ActionBind, audit.ActionUnbind,
+			audit.ActionCreateKymaOrchestration, audit.ActionCreateClusterOrchestration, audit.ActionCancelOrchestration,
+			audit.ActionRetryOrchestration, audit.ActionDownloadKubeconfig, audit.ActionTriggerJob:
+			cmd.params.Actions = append(cmd.params.Actions, action)
+		default:
+			return fmt.Errorf("invalid value for action: %s", a)
+		}
+	}
+	for _, o := range cmd.outcomes {
+		switch outcome := audit.Outcome(o); outcome {
+		case audit.OutcomeSucceeded, audit.OutcomeFailed:
+			cmd.params.Outcomes = append(cmd.params.Outcomes, outcome)
+		default:
+			return fmt.Errorf("invalid value for outcome: %s", o)
+		}
+	}
+
+	if cmd.params.PageSize < 1 {
+		return fmt.Errorf("limit must be greater than 0")
+	}
+	cmd.params.Page = 1
+	if cmd.since > 0 {
+		cmd.params.From = time.Now().Add(-cmd.since)
+	}
+
+	return nil
+}
+
+func auditCreatedAt(obj interface{}) string {
+	entry := obj.(audit.EntryDTO)
+	return entry.CreatedAt.Format("2006/01/02 15:04:05")
+}
+
+func auditTarget(obj interface{}) string {
+	entry := obj.(audit.EntryDTO)
+	if entry.OrchestrationID != "" {
+		return fmt.Sprintf("orchestration %s", entry.OrchestrationID)
+	}
+	return entry.InstanceID
+}
+
+func auditOutcome(obj interface{}) string {
+	entry := obj.(audit.EntryDTO)
+	if entry.Outcome == audit.OutcomeFailed {
+		return fmt.Sprintf("%s (%d: %s)", entry.Outcome, entry.StatusCode, entry.Error)
+	}
+	return string(entry.Outcome)
+}
@@ -0,0 +1,261 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+)
+
+const runCommand = "run"
+
+// JobsCommand represents an execution of the kcp jobs command
+type JobsCommand struct {
+	cobraCmd   *cobra.Command
+	log        logger.Logger
+	client     jobs.Client
+	output     string
+	subCommand string
+	states     []string
+	params     jobs.ListParameters
+}
+
+var jobsColumns = []printer.Column{
+	{
+		Header:    "NAME",
+		FieldSpec: "{.Name}",
+	},
+	{
+		Header:    "SCHEDULE",
+		FieldSpec: "{.Schedule}",
+	},
+	{
+		Header:         "NEXT RUN",
+		FieldFormatter: jobNextRun,
+	},
+	{
+		Header:         "LAST RUN",
+		FieldFormatter: jobLastRun,
+	},
+	{
+		Header:         "LAST STATE",
+		FieldFormatter: jobLastState,
+	},
+}
+
+var jobRunsColumns = []printer.Column{
+	{
+		Header:    "RUN ID",
+		FieldSpec: "{.ID}",
+	},
+	{
+		Header:         "CREATED AT",
+		FieldFormatter: jobRunCreatedAt,
+	},
+	{
+		Header:    "TRIGGERED BY",
+		FieldSpec: "{.TriggeredBy}",
+	},
+	{
+		Header:         "DURATION",
+		FieldFormatter: jobRunDuration,
+	},
+	{
+		Header:         "AFFECTED",
+		FieldFormatter: jobRunAffected,
+	},
+	{
+		Header:         "STATE",
+		FieldFormatter: jobRunState,
+	},
+}
+
+// NewJobsCmd constructs a new instance of JobsCommand and configures it in terms of a cobra.Command
+func NewJobsCmd() *cobra.Command {
+	cmd := JobsCommand{}
+	cobraCmd := &cobra.Command{
+		Use:     "jobs [name] [run]",
+		Aliases: []string{"job"},
+		Short:   "Displays and triggers the periodic jobs of Kyma Environment Broker.",
+		Long: `Displays the periodic jobs of Kyma Environment Broker, such as the trial cleanup or the deprovisioning retrigger, and their run history.
+The command has the following modes:
+  - Without specifying a job name as an argument. In this mode, the command lists all jobs with their schedule and the latest run.
+  - When specifying a job name as an argument. In this mode, the command displays the runs of the job, the newest runs come first.
+  - When specifying a job name and ` + "`run`" + ` as arguments. In this mode, the command triggers a run of the job. The run is executed asynchronously, usually within a minute.`,
+		Example: `  kcp jobs                                Display all jobs.
+  kcp jobs trial-cleanup                  Display the runs of the trial cleanup job.
+  kcp jobs trial-cleanup --state failed   Display the failed runs of the trial cleanup job.
+  kcp jobs trial-cleanup -o json          Display the runs of the trial cleanup job with the affected instance IDs.
+  kcp jobs trial-cleanup run              Trigger a run of the trial cleanup job.`,
+		Args:    cobra.MaximumNArgs(2),
+		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
+		RunE:    func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	SetOutputOpt(cobraCmd, &cmd.output)
+	cobraCmd.Flags().StringSliceVarP(&cmd.states, "state", "s", nil, "Filter runs by state. You can provide multiple values, either separated by a comma (e.g. failed,running), or by specifying the option multiple times. The possible values are: pending, running, succeeded, failed.")
+	cobraCmd.Flags().IntVar(&cmd.params.PageSize, "limit", 20, "Maximum number of runs to display.")
+
+	return cobraCmd
+}
+
+// Run executes the jobs command
+func (cmd *JobsCommand) Run(args []string) error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	cmd.client = jobs.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	switch len(args) {
+	case 0:
+		return cmd.showJobs()
+	case 1:
+		return cmd.showRuns(args[0])
+	default:
+		return cmd.triggerRun(args[0])
+	}
+}
+
+// Validate checks the input parameters of the jobs command
+func (cmd *JobsCommand) Validate(args []string) error {
+	err := ValidateOutputOpt(cmd.output)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range cmd.states {
+		switch state := jobs.RunState(s); state {
+		case jobs.Pending, jobs.Running, jobs.Succeeded, jobs.Failed:
+			cmd.params.States = append(cmd.params.States, state)
+		default:
+			return fmt.Errorf("invalid value for state: %s", s)
+		}
+	}
+	if len(cmd.states) > 0 && len(args) != 1 {
+		return errors.New("--state should only be used when job name is given as the only argument")
+	}
+
+	if len(args) == 2 {
+		cmd.subCommand = args[1]
+		if cmd.subCommand != runCommand {
+			return fmt.Errorf("invalid subcommand: %s", cmd.subCommand)
+		}
+	}
+
+	if cmd.params.PageSize < 1 {
+		return fmt.Errorf("limit must be greater than 0")
+	}
+	cmd.params.Page = 1
+
+	return nil
+}
+
+func (cmd *JobsCommand) showJobs() error {
+	list, err := cmd.client.ListJobs()
+	if err != nil {
+		return errors.Wrap(err, "while listing jobs")
+	}
+	return cmd.print(list, jobsColumns)
+}
+
+func (cmd *JobsCommand) showRuns(jobName string) error {
+	page, err := cmd.client.ListRuns(jobName, cmd.params)
+	if err != nil {
+		return errors.Wrapf(err, "while listing runs of the %s job", jobName)
+	}
+	if cmd.output == jsonOutput {
+		return cmd.print(page, jobRunsColumns)
+	}
+	return cmd.print(page.Data, jobRunsColumns)
+}
+
+func (cmd *JobsCommand) triggerRun(jobName string) error {
+	run, err := cmd.client.TriggerRun(jobName)
+	if err != nil {
+		return errors.Wrapf(err, "while triggering run of the %s job", jobName)
+	}
+	fmt.Printf("Run %s of the %s job triggered.\n", run.ID, jobName)
+	return nil
+}
+
+func (cmd *JobsCommand) print(obj interface{}, columns []printer.Column) error {
+	switch {
+	case cmd.output == tableOutput:
+		tp, err := printer.NewTablePrinter(columns, false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(obj)
+	case cmd.output == jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		jp.PrintObj(obj)
+	case strings.HasPrefix(cmd.output, customOutput):
+		_, templateFile := printer.ParseOutputToTemplateTypeAndElement(cmd.output)
+		column, err := printer.ParseColumnToHeaderAndFieldSpec(templateFile)
+		if err != nil {
+			return err
+		}
+		ccp, err := printer.NewTablePrinter(column, false)
+		if err != nil {
+			return err
+		}
+		return ccp.PrintObj(obj)
+	}
+	return nil
+}
+
+func jobNextRun(obj interface{}) string {
+	job := obj.(jobs.JobDTO)
+	if job.NextRun.IsZero() {
+		return "-"
+	}
+	return job.NextRun.Format("2006/01/02 15:04:05")
+}
+
+func jobLastRun(obj interface{}) string {
+	job := obj.(jobs.JobDTO)
+	if job.LastRun == nil {
+		return "-"
+	}
+	return job.LastRun.CreatedAt.Format("2006/01/02 15:04:05")
+}
+
+func jobLastState(obj interface{}) string {
+	job := obj.(jobs.JobDTO)
+	if job.LastRun == nil {
+		return "-"
+	}
+	return jobRunState(*job.LastRun)
+}
+
+func jobRunCreatedAt(obj interface{}) string {
+	run := obj.(jobs.RunDTO)
+	return run.CreatedAt.Format("2006/01/02 15:04:05")
+}
+
+func jobRunDuration(obj interface{}) string {
+	run := obj.(jobs.RunDTO)
+	if run.StartedAt == nil || run.FinishedAt == nil {
+		return "-"
+	}
+	return run.FinishedAt.Sub(*run.StartedAt).Round(time.Second).String()
+}
+
+func jobRunAffected(obj interface{}) string {
+	run := obj.(jobs.RunDTO)
+	return fmt.Sprintf("%d", len(run.Affected))
+}
+
+func jobRunState(obj interface{}) string {
+	run := obj.(jobs.RunDTO)
+	if run.State == jobs.Failed {
+		return fmt.Sprintf("%s (%s)", run.State, run.Error)
+	}
+	return string(run.State)
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobsCommand_Validate(t *testing.T) {
+	t.Run("should convert states to list parameters", func(t *testing.T) {
+		// given
+		cmd := JobsCommand{output: tableOutput, states: []string{"failed", "running"}, params: jobs.ListParameters{PageSize: 20}}
+
+		// when
+		err := cmd.Validate([]string{"trial-cleanup"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []jobs.RunState{jobs.Failed, jobs.Running}, cmd.params.States)
+		assert.Equal(t, 1, cmd.params.Page)
+	})
+
+	t.Run("should accept run subcommand", func(t *testing.T) {
+		// given
+		cmd := JobsCommand{output: tableOutput, params: jobs.ListParameters{PageSize: 20}}
+
+		// when
+		err := cmd.Validate([]string{"trial-cleanup", "run"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, runCommand, cmd.subCommand)
+	})
+
+	for name, testCase := range map[string]struct {
+		cmd  JobsCommand
+		args []string
+	}{
+		"invalid state":          {cmd: JobsCommand{states: []string{"done"}, params: jobs.ListParameters{PageSize: 20}}, args: []string{"trial-cleanup"}},
+		"state without job name": {cmd: JobsCommand{states: []string{"failed"}, params: jobs.ListParameters{PageSize: 20}}},
+		"invalid subcommand":     {cmd: JobsCommand{params: jobs.ListParameters{PageSize: 20}}, args: []string{"trial-cleanup", "stop"}},
+		"limit smaller than one": {cmd: JobsCommand{params: jobs.ListParameters{PageSize: 0}}},
+		"state with run command": {cmd: JobsCommand{states: []string{"failed"}, params: jobs.ListParameters{PageSize: 20}}, args: []string{"trial-cleanup", "run"}},
+	} {
+		t.Run("should fail for "+name, func(t *testing.T) {
+			// given
+			cmd := testCase.cmd
+			cmd.output = tableOutput
+
+			// when
+			err := cmd.Validate(testCase.args)
+
+			// then
+			assert.Error(t, err)
+		})
+	}
+}
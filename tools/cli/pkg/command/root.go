@@ -58,6 +58,8 @@ See the **Global Options** section of each command for the description of these
 		NewCompletionCommand(),
 		NewReconciliationCmd(),
 		NewDeprovisionCmd(),
+		NewAuditCmd(),
+		NewJobsCmd(),
 	)
 	return cmd
 }
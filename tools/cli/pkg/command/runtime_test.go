@@ -0,0 +1,43 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeCommand_Validate(t *testing.T) {
+	t.Run("should request only deleted runtimes of the given instances", func(t *testing.T) {
+		// given
+		cmd := RuntimeCommand{output: tableOutput, params: runtime.ListParameters{
+			InstanceIDs: []string{"instance-id"},
+			OnlyDeleted: true,
+			Events:      "none",
+		}}
+
+		// when
+		err := cmd.Validate()
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, cmd.params.OnlyDeleted)
+		assert.Empty(t, cmd.params.States)
+		assert.Equal(t, []string{"instance-id"}, cmd.params.InstanceIDs)
+	})
+
+	t.Run("should fail for only deleted runtimes without instance IDs", func(t *testing.T) {
+		// given
+		cmd := RuntimeCommand{output: tableOutput, params: runtime.ListParameters{
+			OnlyDeleted: true,
+			Events:      "none",
+		}}
+
+		// when
+		err := cmd.Validate()
+
+		// then
+		assert.Error(t, err)
+	})
+}
@@ -1,6 +1,7 @@
 package metadata
 
 import (
+	"os"
 	"testing"
 
 	"github.com/kyma-project/control-plane/tools/cli/pkg/ers"
@@ -10,6 +11,7 @@ import (
 
 func TestSaveGet(t *testing.T) {
 	// given
+	workDir(t, t.TempDir())
 	m := ers.MigrationMetadata{
 		Id:           "1234",
 		KymaMigrated: true,
@@ -26,3 +28,13 @@ func TestSaveGet(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, m, stored)
 }
+
+// workDir switches to the given directory for the time of the test, the storage writes relative to it
+func workDir(t *testing.T, dir string) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() {
+		require.NoError(t, os.Chdir(wd))
+	})
+}